@@ -2,102 +2,196 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"go-dc-wallet/model"
 	"go-dc-wallet/xenv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/moremorefun/mcommon"
 )
 
-// GetLock 获取运行锁
-func GetLock(ctx context.Context, tx mcommon.DbExeAble, k string) (bool, error) {
-	genLock := func() error {
-		_, err := SQLCreateTAppLockUpdate(
-			ctx,
-			tx,
-			&model.DBTAppLock{
-				K:          k,
-				V:          1,
-				CreateTime: time.Now().Unix(),
-			},
-		)
-		if err != nil {
-			return err
-		}
-		return nil
-	}
+// NewLockOwner 生成锁持有者标识 主机名-进程号-随机串
+func NewLockOwner() string {
+	return fmt.Sprintf("%s-%s", InstanceID, mcommon.GetUUIDStr())
+}
 
-	lockRow, err := SQLGetTAppLockColByK(
+// GetLock 获取运行锁
+func GetLock(ctx context.Context, tx mcommon.DbExeAble, k string, owner string) (bool, error) {
+	err := SQLCreateTAppLockIgnore(
 		ctx,
 		tx,
-		[]string{
-			model.DBColTAppLockCreateTime,
-		},
 		k,
 	)
 	if err != nil {
 		return false, err
 	}
-	if lockRow == nil {
-		err = genLock()
-		if err != nil {
-			return false, err
-		}
-		return true, nil
+	now := time.Now().Unix()
+	count, err := SQLUpdateTAppLockAcquire(
+		ctx,
+		tx,
+		&model.DBTAppLock{
+			K:          k,
+			Owner:      owner,
+			CreateTime: now,
+			ExpireTime: now + LockLeaseSeconds,
+		},
+	)
+	if err != nil {
+		return false, err
 	}
-	if time.Now().Unix()-lockRow.CreateTime > 60*30 {
-		err = genLock()
-		if err != nil {
-			return false, err
-		}
-		return true, nil
+	return count > 0, nil
+}
+
+// RenewLock 续约运行锁，返回是否仍持有
+func RenewLock(ctx context.Context, tx mcommon.DbExeAble, k string, owner string) (bool, error) {
+	now := time.Now().Unix()
+	count, err := SQLUpdateTAppLockRenew(
+		ctx,
+		tx,
+		&model.DBTAppLock{
+			K:          k,
+			Owner:      owner,
+			ExpireTime: now + LockLeaseSeconds,
+			UpdateTime: now,
+		},
+	)
+	if err != nil {
+		return false, err
 	}
-	return false, nil
+	return count > 0, nil
 }
 
-// ReleaseLock 释放运行锁
-func ReleaseLock(ctx context.Context, tx mcommon.DbExeAble, k string) error {
-	_, err := SQLUpdateTAppLockByK(
+// ReleaseLock 释放运行锁，只有持有者可以释放
+func ReleaseLock(ctx context.Context, tx mcommon.DbExeAble, k string, owner string) error {
+	count, err := SQLUpdateTAppLockRelease(
 		ctx,
 		tx,
 		&model.DBTAppLock{
 			K:          k,
-			V:          0,
-			CreateTime: time.Now().Unix(),
+			Owner:      owner,
+			UpdateTime: time.Now().Unix(),
 		},
 	)
 	if err != nil {
 		return err
 	}
+	if count == 0 {
+		mcommon.Log.Warnf("lock %s not held by %s when release", k, owner)
+	}
 	return nil
 }
 
-// LockWrap 包装被lock的函数
-func LockWrap(name string, f func()) {
+// GetLockHolders 获取当前锁定中的锁
+func GetLockHolders(ctx context.Context, tx mcommon.DbExeAble) ([]*model.DBTAppLock, error) {
+	return SQLSelectTAppLockColHeld(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTAppLockK,
+			model.DBColTAppLockOwner,
+			model.DBColTAppLockCreateTime,
+			model.DBColTAppLockExpireTime,
+			model.DBColTAppLockUpdateTime,
+		},
+	)
+}
+
+// heldLocks 当前进程持有的锁 name => owner
+var heldLocks sync.Map
+
+// lockHeartbeatInterval 持有锁期间的续约间隔
+var lockHeartbeatInterval = time.Second * LockHeartbeatSeconds
+
+// ReleaseHeldLocks 释放当前进程仍持有的锁，用于退出时清理
+func ReleaseHeldLocks() {
+	heldLocks.Range(func(key, value interface{}) bool {
+		name := key.(string)
+		owner := value.(string)
+		err := ReleaseLock(
+			context.Background(),
+			xenv.DbCon,
+			name,
+			owner,
+		)
+		if err != nil {
+			mcommon.Log.Warnf("ReleaseLock err: [%T] %s", err, err.Error())
+			return true
+		}
+		heldLocks.Delete(name)
+		mcommon.Log.Infof("release held lock %s", name)
+		return true
+	})
+}
+
+// LockWrap 包装被lock的函数，运行期间定时续约
+// ctx 取消后不再获取新锁，锁的续约和释放不受 ctx 影响
+// f 的 ctx 在续约发现锁已失去时取消，避免与新的持有者同时运行
+func LockWrap(ctx context.Context, name string, f func(ctx context.Context)) {
+	if ctx.Err() != nil {
+		return
+	}
+	owner := NewLockOwner()
 	ok, err := GetLock(
 		context.Background(),
 		xenv.DbCon,
 		name,
+		owner,
 	)
 	if err != nil {
 		mcommon.Log.Warnf("GetLock err: [%T] %s", err, err.Error())
 		return
 	}
 	if !ok {
+		jobSetLockSkipped(ctx)
 		return
 	}
+	heldLocks.Store(name, owner)
+	lockCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(lockHeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				ok, err := RenewLock(
+					context.Background(),
+					xenv.DbCon,
+					name,
+					owner,
+				)
+				if err != nil {
+					mcommon.Log.Warnf("RenewLock err: [%T] %s", err, err.Error())
+					continue
+				}
+				if !ok {
+					mcommon.Log.Errorf("lock %s lost by %s", name, owner)
+					cancel()
+					return
+				}
+			}
+		}
+	}()
 	defer func() {
+		close(done)
 		err := ReleaseLock(
 			context.Background(),
 			xenv.DbCon,
 			name,
+			owner,
 		)
 		if err != nil {
 			mcommon.Log.Warnf("ReleaseLock err: [%T] %s", err, err.Error())
 			return
 		}
+		heldLocks.Delete(name)
 	}()
-	f()
+	f(lockCtx)
 }
 
 // SQLGetWithdrawMap 获取提币map
@@ -169,6 +263,29 @@ func SQLGetAppConfigTokenMap(ctx context.Context, tx mcommon.DbExeAble, cols []s
 	return itemMap, nil
 }
 
+// SQLGetAppConfigNftMap 获取nft map
+func SQLGetAppConfigNftMap(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64) (map[int64]*model.DBTAppConfigNft, error) {
+	if !mcommon.IsStringInSlice(cols, model.DBColTAppConfigNftID) {
+		cols = append(cols, model.DBColTAppConfigNftID)
+	}
+	itemMap := make(map[int64]*model.DBTAppConfigNft)
+	itemRows, err := model.SQLSelectTAppConfigNftCol(
+		ctx,
+		tx,
+		cols,
+		ids,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, itemRow := range itemRows {
+		itemMap[itemRow.ID] = itemRow
+	}
+	return itemMap, nil
+}
+
 // SQLGetAddressKeyMap 获取地址map
 func SQLGetAddressKeyMap(ctx context.Context, tx mcommon.DbExeAble, cols []string, addresses []string) (map[string]*model.DBTAddressKey, error) {
 	if !mcommon.IsStringInSlice(cols, model.DBColTAddressKeyAddress) {
@@ -189,3 +306,132 @@ func SQLGetAddressKeyMap(ctx context.Context, tx mcommon.DbExeAble, cols []strin
 	}
 	return itemMap, nil
 }
+
+// SQLGetEthForwarderMap 获取forwarder地址map
+func SQLGetEthForwarderMap(ctx context.Context, tx mcommon.DbExeAble, cols []string, addresses []string) (map[string]*model.DBTEthForwarder, error) {
+	if !mcommon.IsStringInSlice(cols, model.DBColTEthForwarderAddress) {
+		cols = append(cols, model.DBColTEthForwarderAddress)
+	}
+	itemMap := make(map[string]*model.DBTEthForwarder)
+	itemRows, err := SQLSelectTEthForwarderColByAddresses(
+		ctx,
+		tx,
+		cols,
+		addresses,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, itemRow := range itemRows {
+		itemMap[itemRow.Address] = itemRow
+	}
+	return itemMap, nil
+}
+
+// GetConfigInt 获取数值配置，不存在时返回默认值
+func GetConfigInt(ctx context.Context, tx mcommon.DbExeAble, k string, defaultValue int64) (int64, error) {
+	row, err := model.SQLGetTAppConfigIntColKV(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTAppConfigIntV,
+		},
+		[]string{
+			model.DBColShortTAppConfigIntK,
+		},
+		[]interface{}{
+			k,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	if row == nil {
+		return defaultValue, nil
+	}
+	return row.V, nil
+}
+
+// GetConfigStr 获取字符串配置，不存在时返回空字符串
+func GetConfigStr(ctx context.Context, tx mcommon.DbExeAble, k string) (string, error) {
+	row, err := model.SQLGetTAppConfigStrColKV(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTAppConfigStrV,
+		},
+		[]string{
+			model.DBColShortTAppConfigStrK,
+		},
+		[]interface{}{
+			k,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	if row == nil {
+		return "", nil
+	}
+	return strings.TrimSpace(row.V), nil
+}
+
+// WithdrawFailed 标记提币失败并创建失败通知
+func WithdrawFailed(ctx context.Context, tx mcommon.DbExeAble, withdrawRow *model.DBTWithdraw, productRow *model.DBTProduct, txHash string, reason string) error {
+	now := time.Now().Unix()
+	// varchar(128) 按字符计算长度，按 rune 截断避免截断多字节字符
+	handleMsg := reason
+	if msgRunes := []rune(handleMsg); len(msgRunes) > 128 {
+		handleMsg = string(msgRunes[:128])
+	}
+	_, err := SQLUpdateTWithdrawStatusByIDs(
+		ctx,
+		tx,
+		[]int64{withdrawRow.ID},
+		&model.DBTWithdraw{
+			HandleStatus: WithdrawStatusFailed,
+			HandleMsg:    handleMsg,
+			HandleTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if productRow == nil {
+		return nil
+	}
+	notifyRow, err := GetNotifyRow(
+		productRow,
+		&StNotifyData{
+			ProductID:   withdrawRow.ProductID,
+			ItemType:    SendRelationTypeWithdraw,
+			ItemID:      withdrawRow.ID,
+			NotifyType:  NotifyTypeWithdrawFailed,
+			Symbol:      withdrawRow.Symbol,
+			TxHash:      txHash,
+			Address:     withdrawRow.ToAddress,
+			Balance:     withdrawRow.BalanceReal,
+			TokenID:     withdrawRow.TokenID,
+			OutSerial:   withdrawRow.OutSerial,
+			Memo:        withdrawRow.Memo,
+			BlockNumber: withdrawRow.BlockNumber,
+			BlockHash:   withdrawRow.BlockHash,
+			Fee:         withdrawRow.Fee,
+			Reason:      reason,
+		},
+		now,
+	)
+	if err != nil {
+		return err
+	}
+	_, err = model.SQLCreateTProductNotify(
+		ctx,
+		tx,
+		notifyRow,
+		true,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"context"
+	"go-dc-wallet/xenv"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestLockWrapCancelOnLost(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock err: %s", err.Error())
+	}
+	oldDbCon := xenv.DbCon
+	oldInterval := lockHeartbeatInterval
+	xenv.DbCon = sqlx.NewDb(db, "mysql")
+	lockHeartbeatInterval = time.Millisecond * 10
+	t.Cleanup(func() {
+		xenv.DbCon = oldDbCon
+		lockHeartbeatInterval = oldInterval
+		_ = db.Close()
+	})
+	mock.ExpectExec("INSERT IGNORE INTO t_app_lock").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE t_app_lock SET v=1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// 租约过期后被其他实例获取，续约失败
+	mock.ExpectExec("UPDATE t_app_lock SET expire_time").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("UPDATE t_app_lock SET v=0").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	isRun := false
+	LockWrap(context.Background(), "test_lock_lost", func(ctx context.Context) {
+		isRun = true
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second * 5):
+			t.Errorf("ctx not done after lock lost")
+		}
+	})
+	if !isRun {
+		t.Fatalf("locked func not run")
+	}
+	err = mock.ExpectationsWereMet()
+	if err != nil {
+		t.Fatalf("sql expectations: %s", err.Error())
+	}
+	if _, ok := heldLocks.Load("test_lock_lost"); ok {
+		t.Fatalf("lost lock still held")
+	}
+}
@@ -248,7 +248,7 @@ WHERE
 }
 
 // SQLSelectTTxColByOrgForUpdate 获取未整理交易
-func SQLSelectTTxColByOrgForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, orgStatus int64) ([]*model.DBTTx, error) {
+func SQLSelectTTxColByOrgForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, orgStatus int64, chain string) ([]*model.DBTTx, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
@@ -256,7 +256,8 @@ func SQLSelectTTxColByOrgForUpdate(ctx context.Context, tx mcommon.DbExeAble, co
 FROM
 	t_tx
 WHERE
-	org_status=:org_status
+	chain=:chain
+	AND org_status=:org_status
 FOR UPDATE`)
 
 	var rows []*model.DBTTx
@@ -266,6 +267,7 @@ FOR UPDATE`)
 		&rows,
 		query.String(),
 		gin.H{
+			"chain":      chain,
 			"org_status": orgStatus,
 		},
 	)
@@ -276,7 +278,7 @@ FOR UPDATE`)
 }
 
 // SQLGetTSendMaxNonce 获取地址的nonce
-func SQLGetTSendMaxNonce(ctx context.Context, tx mcommon.DbExeAble, address string) (int64, error) {
+func SQLGetTSendMaxNonce(ctx context.Context, tx mcommon.DbExeAble, address string, chain string) (int64, error) {
 	var i int64
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
@@ -287,10 +289,14 @@ func SQLGetTSendMaxNonce(ctx context.Context, tx mcommon.DbExeAble, address stri
 FROM
 	t_send
 WHERE
-	from_address=:address
+	chain=:chain
+	AND from_address=:address
+	AND handle_status<>:handle_status
 LIMIT 1`,
 		gin.H{
-			"address": address,
+			"chain":         chain,
+			"address":       address,
+			"handle_status": SendStatusFailed,
 		},
 	)
 	if err != nil {
@@ -302,8 +308,183 @@ LIMIT 1`,
 	return i + 1, nil
 }
 
+// SQLGetTEthNonceForUpdate 获取并锁定地址的nonce数据，需要在事务中调用
+func SQLGetTEthNonceForUpdate(ctx context.Context, tx mcommon.DbExeAble, address string, chain string) (*model.DBTEthNonce, error) {
+	var row model.DBTEthNonce
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		`SELECT
+	id,
+	address,
+	nonce,
+	update_time
+FROM
+	t_eth_nonce
+WHERE
+	chain=:chain
+	AND address=:address
+LIMIT 1
+FOR UPDATE`,
+		gin.H{
+			"chain":   chain,
+			"address": address,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLUpdateTEthNonceByAddress 更新地址最后分配的nonce
+func SQLUpdateTEthNonceByAddress(ctx context.Context, tx mcommon.DbExeAble, address string, nonce int64, updateTime int64, chain string) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_eth_nonce
+SET
+    nonce=:nonce,
+    update_time=:update_time
+WHERE
+	chain=:chain
+	AND address=:address`,
+		gin.H{
+			"chain":       chain,
+			"address":     address,
+			"nonce":       nonce,
+			"update_time": updateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTSendPendingFromAddresses 获取有未完成发送的地址
+func SQLSelectTSendPendingFromAddresses(ctx context.Context, tx mcommon.DbExeAble, chain string) ([]string, error) {
+	var rows []string
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		`SELECT
+	DISTINCT from_address
+FROM
+	t_send
+WHERE
+	chain=:chain
+	AND handle_status<2`,
+		gin.H{
+			"chain": chain,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTSendColPendingByAddress 获取地址未完成的发送
+func SQLSelectTSendColPendingByAddress(ctx context.Context, tx mcommon.DbExeAble, cols []string, address string, chain string) ([]*model.DBTSend, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send
+WHERE
+	chain=:chain
+	AND from_address=:address
+	AND handle_status<2
+ORDER BY id`)
+
+	var rows []*model.DBTSend
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"chain":   chain,
+			"address": address,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLGetTSendPendingCount 获取地址未完成的交易数
+func SQLGetTSendPendingCount(ctx context.Context, tx mcommon.DbExeAble, address string, chain string) (int64, error) {
+	var i int64
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&i,
+		`SELECT 
+	COUNT(*)
+FROM
+	t_send
+WHERE
+	chain=:chain
+	AND from_address=:address
+	AND hex<>''
+	AND handle_status<2
+LIMIT 1`,
+		gin.H{
+			"chain":   chain,
+			"address": address,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, nil
+	}
+	return i, nil
+}
+
+// SQLSelectTEthForwarderColByAddresses 根据地址获取forwarder
+func SQLSelectTEthForwarderColByAddresses(ctx context.Context, tx mcommon.DbExeAble, cols []string, addresses []string) ([]*model.DBTEthForwarder, error) {
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_eth_forwarder
+WHERE
+	address IN (:addresses)`)
+
+	var rows []*model.DBTEthForwarder
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"addresses": addresses,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 // SQLGetTSendPendingBalanceReal 获取地址的打包数额
-func SQLGetTSendPendingBalanceReal(ctx context.Context, tx mcommon.DbExeAble, address string) (string, error) {
+func SQLGetTSendPendingBalanceReal(ctx context.Context, tx mcommon.DbExeAble, address string, chain string) (string, error) {
 	var i string
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
@@ -314,10 +495,12 @@ func SQLGetTSendPendingBalanceReal(ctx context.Context, tx mcommon.DbExeAble, ad
 FROM
 	t_send
 WHERE
-	from_address=:address
+	chain=:chain
+	AND from_address=:address
 	AND handle_status<2
 LIMIT 1`,
 		gin.H{
+			"chain":   chain,
 			"address": address,
 		},
 	)
@@ -533,6 +716,116 @@ WHERE
 	return count, nil
 }
 
+// SQLUpdateTSendTxIDByTxID 替换交易后更新同一交易的发送数据和占位数据的 tx hash
+func SQLUpdateTSendTxIDByTxID(ctx context.Context, tx mcommon.DbExeAble, txID string, newTxID string) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send
+SET
+    tx_id=:new_tx_id
+WHERE
+	tx_id=:tx_id`,
+		gin.H{
+			"tx_id":     txID,
+			"new_tx_id": newTxID,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLUpdateTSendGasUsedByTxID 记录交易回执中实际消耗的gas，不更新占位数据
+func SQLUpdateTSendGasUsedByTxID(ctx context.Context, tx mcommon.DbExeAble, txID string, gasUsed int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send
+SET
+    gas_used=:gas_used
+WHERE
+	tx_id=:tx_id
+	AND gas>0`,
+		gin.H{
+			"tx_id":    txID,
+			"gas_used": gasUsed,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLUpdateTSendReplaceByID 更新替换交易的发送数据
+func SQLUpdateTSendReplaceByID(ctx context.Context, tx mcommon.DbExeAble, row *model.DBTSend) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send
+SET
+    balance_real=:balance_real,
+    gas_price=:gas_price,
+    max_fee_per_gas=:max_fee_per_gas,
+    max_priority_fee_per_gas=:max_priority_fee_per_gas,
+    hex=:hex,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	id=:id`,
+		gin.H{
+			"id":                       row.ID,
+			"balance_real":             row.BalanceReal,
+			"gas_price":                row.GasPrice,
+			"max_fee_per_gas":          row.MaxFeePerGas,
+			"max_priority_fee_per_gas": row.MaxPriorityFeePerGas,
+			"hex":                      row.Hex,
+			"handle_msg":               row.HandleMsg,
+			"handle_time":              row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTSendReplaceColBySendIDs 获取发送数据被替换的交易
+func SQLSelectTSendReplaceColBySendIDs(ctx context.Context, tx mcommon.DbExeAble, cols []string, sendIDs []int64) ([]*model.DBTSendReplace, error) {
+	if len(sendIDs) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_replace
+WHERE
+	send_id IN (:send_ids)
+ORDER BY id`)
+
+	var rows []*model.DBTSendReplace
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"send_ids": sendIDs,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 // SQLUpdateTSendEosStatusByIDs 更新
 func SQLUpdateTSendEosStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row model.DBTSendEos) (int64, error) {
 	if len(ids) == 0 {
@@ -563,7 +856,7 @@ WHERE
 }
 
 // SQLSelectTSendColByStatus 根据ids获取
-func SQLSelectTSendColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64) ([]*model.DBTSend, error) {
+func SQLSelectTSendColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64, chain string) ([]*model.DBTSend, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
@@ -571,7 +864,8 @@ func SQLSelectTSendColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols [
 FROM
 	t_send
 WHERE
-	handle_status=:handle_status
+	chain=:chain
+	AND handle_status=:handle_status
 ORDER BY id`)
 
 	var rows []*model.DBTSend
@@ -581,6 +875,7 @@ ORDER BY id`)
 		&rows,
 		query.String(),
 		gin.H{
+			"chain":         chain,
 			"handle_status": status,
 		},
 	)
@@ -738,27 +1033,20 @@ WHERE
 	return count, nil
 }
 
-// SQLUpdateTWithdrawStatusByIDs 更新
-func SQLUpdateTWithdrawStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row *model.DBTWithdraw) (int64, error) {
-	if len(ids) == 0 {
-		return 0, nil
-	}
+// SQLUpdateTWithdrawTxHashByID 替换交易后更新提币的 tx hash
+func SQLUpdateTWithdrawTxHashByID(ctx context.Context, tx mcommon.DbExeAble, id int64, txHash string) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
 	t_withdraw
 SET
-    handle_status=:handle_status,
-    handle_msg=:handle_msg,
-    handle_time=:handle_time
+    tx_hash=:tx_hash
 WHERE
-	id IN (:ids)`,
+	id=:id`,
 		gin.H{
-			"ids":           ids,
-			"handle_status": row.HandleStatus,
-			"handle_msg":    row.HandleMsg,
-			"handle_time":   row.HandleTime,
+			"id":      id,
+			"tx_hash": txHash,
 		},
 	)
 	if err != nil {
@@ -767,110 +1055,257 @@ WHERE
 	return count, nil
 }
 
-// SQLGetTAppLockColByK 根据id查询
-func SQLGetTAppLockColByK(ctx context.Context, tx mcommon.DbExeAble, cols []string, k string) (*model.DBTAppLock, error) {
+// SQLSelectTSendWithdrawColBySendIDs 获取批量提币发送关联的提币
+func SQLSelectTSendWithdrawColBySendIDs(ctx context.Context, tx mcommon.DbExeAble, cols []string, sendIDs []int64) ([]*model.DBTSendWithdraw, error) {
+	if len(sendIDs) == 0 {
+		return nil, nil
+	}
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_lock
+	t_send_withdraw
 WHERE
-	k=:k
-	AND v=1`)
+	send_id IN (:send_ids)
+ORDER BY id`)
 
-	var row model.DBTAppLock
-	ok, err := mcommon.DbGetNamedContent(
+	var rows []*model.DBTSendWithdraw
+	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
-		&row,
+		&rows,
 		query.String(),
 		gin.H{
-			"k": k,
+			"send_ids": sendIDs,
 		},
 	)
 	if err != nil {
 		return nil, err
 	}
-	if !ok {
+	return rows, nil
+}
+
+// SQLSelectTSendWithdrawColByWithdrawIDs 获取提币关联的批量发送
+func SQLSelectTSendWithdrawColByWithdrawIDs(ctx context.Context, tx mcommon.DbExeAble, cols []string, withdrawIDs []int64) ([]*model.DBTSendWithdraw, error) {
+	if len(withdrawIDs) == 0 {
 		return nil, nil
 	}
-	return &row, nil
-}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_withdraw
+WHERE
+	withdraw_id IN (:withdraw_ids)
+ORDER BY id`)
 
-// SQLCreateTAppLockUpdate 创建
-func SQLCreateTAppLockUpdate(ctx context.Context, tx mcommon.DbExeAble, row *model.DBTAppLock) (int64, error) {
-	var lastID int64
-	var err error
-	if row.ID > 0 {
-		lastID, err = mcommon.DbExecuteLastIDNamedContent(
-			ctx,
-			tx,
-			`INSERT INTO t_app_lock (
-    id,
-    k,
-    v,
-    create_time
-) VALUES (
-    :id,
-    :k,
-    :v,
-    :create_time
-) ON DUPLICATE KEY UPDATE 
-	v=:v,
-	create_time=:create_time`,
-			gin.H{
-				"id":          row.ID,
-				"k":           row.K,
-				"v":           row.V,
-				"create_time": row.CreateTime,
-			},
-		)
-	} else {
-		lastID, err = mcommon.DbExecuteLastIDNamedContent(
-			ctx,
-			tx,
-			`INSERT INTO t_app_lock (
+	var rows []*model.DBTSendWithdraw
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"withdraw_ids": withdrawIDs,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTWithdrawTxHashBySendID 更新批量提币关联提币的 tx hash
+func SQLUpdateTWithdrawTxHashBySendID(ctx context.Context, tx mcommon.DbExeAble, sendID int64, txHash string) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_withdraw
+SET
+    tx_hash=:tx_hash
+WHERE
+	id IN (
+		SELECT
+			withdraw_id
+		FROM
+			t_send_withdraw
+		WHERE
+			send_id=:send_id
+	)`,
+		gin.H{
+			"send_id": sendID,
+			"tx_hash": txHash,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLUpdateTWithdrawStatusByIDs 更新
+func SQLUpdateTWithdrawStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row *model.DBTWithdraw) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_withdraw
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":           ids,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLUpdateTWithdrawBlockByID 更新提币打包信息
+func SQLUpdateTWithdrawBlockByID(ctx context.Context, tx mcommon.DbExeAble, row *model.DBTWithdraw) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_withdraw
+SET
+    fee=:fee,
+    block_number=:block_number,
+    block_hash=:block_hash
+WHERE
+	id=:id`,
+		gin.H{
+			"id":           row.ID,
+			"fee":          row.Fee,
+			"block_number": row.BlockNumber,
+			"block_hash":   row.BlockHash,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTAppLockIgnore 创建锁记录，已存在时忽略
+func SQLCreateTAppLockIgnore(ctx context.Context, tx mcommon.DbExeAble, k string) error {
+	_, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`INSERT IGNORE INTO t_app_lock (
     k,
     v,
-    create_time
+    owner,
+    create_time,
+    expire_time,
+    update_time
 ) VALUES (
     :k,
-    :v,
-    :create_time
-) ON DUPLICATE KEY UPDATE 
-	v=:v,
-	create_time=:create_time`,
-			gin.H{
-				"k":           row.K,
-				"v":           row.V,
-				"create_time": row.CreateTime,
-			},
-		)
+    0,
+    '',
+    0,
+    0,
+    0
+)`,
+		gin.H{
+			"k": k,
+		},
+	)
+	if err != nil {
+		return err
 	}
+	return nil
+}
+
+// SQLUpdateTAppLockAcquire 获取未锁定或租约已过期的锁
+func SQLUpdateTAppLockAcquire(ctx context.Context, tx mcommon.DbExeAble, row *model.DBTAppLock) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_app_lock
+SET
+    v=1,
+    owner=:owner,
+    create_time=:create_time,
+    expire_time=:expire_time,
+    update_time=:create_time
+WHERE
+	k=:k
+	AND (v=0 OR expire_time<:create_time)`,
+		gin.H{
+			"k":           row.K,
+			"owner":       row.Owner,
+			"create_time": row.CreateTime,
+			"expire_time": row.ExpireTime,
+		},
+	)
 	if err != nil {
 		return 0, err
 	}
-	return lastID, nil
+	return count, nil
 }
 
-// SQLUpdateTAppLockByK 更新
-func SQLUpdateTAppLockByK(ctx context.Context, tx mcommon.DbExeAble, row *model.DBTAppLock) (int64, error) {
+// SQLUpdateTAppLockRenew 持有者续约
+func SQLUpdateTAppLockRenew(ctx context.Context, tx mcommon.DbExeAble, row *model.DBTAppLock) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
 	t_app_lock
 SET
-    v=:v,
-    create_time=:create_time
+    expire_time=:expire_time,
+    update_time=:update_time
 WHERE
-	k=:k`,
+	k=:k
+	AND owner=:owner
+	AND v=1`,
 		gin.H{
-			"id":          row.ID,
 			"k":           row.K,
-			"v":           row.V,
-			"create_time": row.CreateTime,
+			"owner":       row.Owner,
+			"expire_time": row.ExpireTime,
+			"update_time": row.UpdateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLUpdateTAppLockRelease 持有者释放锁
+func SQLUpdateTAppLockRelease(ctx context.Context, tx mcommon.DbExeAble, row *model.DBTAppLock) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_app_lock
+SET
+    v=0,
+    expire_time=0,
+    update_time=:update_time
+WHERE
+	k=:k
+	AND owner=:owner
+	AND v=1`,
+		gin.H{
+			"k":           row.K,
+			"owner":       row.Owner,
+			"update_time": row.UpdateTime,
 		},
 	)
 	if err != nil {
@@ -879,6 +1314,33 @@ WHERE
 	return count, nil
 }
 
+// SQLSelectTAppLockColHeld 查询锁定中的记录
+func SQLSelectTAppLockColHeld(ctx context.Context, tx mcommon.DbExeAble, cols []string) ([]*model.DBTAppLock, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_lock
+WHERE
+	v=1
+ORDER BY
+	k`)
+
+	var rows []*model.DBTAppLock
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 // SQLGetTAddressKeyColFreeForUpdate 根据id查询
 func SQLGetTAddressKeyColFreeForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, symbol string) (*model.DBTAddressKey, error) {
 	query := strings.Builder{}
@@ -913,7 +1375,7 @@ FOR UPDATE`)
 }
 
 // SQLSelectTTxColByStatus 根据ids获取
-func SQLSelectTTxColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64) ([]*model.DBTTx, error) {
+func SQLSelectTTxColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64, chain string) ([]*model.DBTTx, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
@@ -921,7 +1383,8 @@ func SQLSelectTTxColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []s
 FROM
 	t_tx
 WHERE
-	handle_status=:handle_status`)
+	chain=:chain
+	AND handle_status=:handle_status`)
 
 	var rows []*model.DBTTx
 	err := mcommon.DbSelectNamedContent(
@@ -930,6 +1393,7 @@ WHERE
 		&rows,
 		query.String(),
 		gin.H{
+			"chain":         chain,
 			"handle_status": status,
 		},
 	)
@@ -995,6 +1459,38 @@ WHERE
 	return rows, nil
 }
 
+// SQLSelectTProductNotifyColByItemIDs 根据关联ids获取
+func SQLSelectTProductNotifyColByItemIDs(ctx context.Context, tx mcommon.DbExeAble, cols []string, itemType int64, itemIDs []int64) ([]*model.DBTProductNotify, error) {
+	if len(itemIDs) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_notify
+WHERE
+	item_type=:item_type
+	AND item_id IN (:item_ids)`)
+
+	var rows []*model.DBTProductNotify
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"item_type": itemType,
+			"item_ids":  itemIDs,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
 // SQLUpdateTProductNotifyStatusByID 更新
 func SQLUpdateTProductNotifyStatusByID(ctx context.Context, tx mcommon.DbExeAble, row *model.DBTProductNotify) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
@@ -1021,22 +1517,61 @@ WHERE
 	return count, nil
 }
 
-// SQLSelectTAppConfigTokenColAll 根据ids获取
-func SQLSelectTAppConfigTokenColAll(ctx context.Context, tx mcommon.DbExeAble, cols []string) ([]*model.DBTAppConfigToken, error) {
+// SQLSelectTProductNotifyColByFilter 根据条件查询通知
+func SQLSelectTProductNotifyColByFilter(ctx context.Context, tx mcommon.DbExeAble, cols []string, filter *StNotifyFilter) ([]*model.DBTProductNotify, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token`)
+	t_product_notify
+WHERE
+	1=1`)
+	argMap := gin.H{}
+	if filter.ID > 0 {
+		query.WriteString("\n\tAND id=:id")
+		argMap["id"] = filter.ID
+	}
+	if filter.ProductID > 0 {
+		query.WriteString("\n\tAND product_id=:product_id")
+		argMap["product_id"] = filter.ProductID
+	}
+	if filter.HandleStatus >= 0 {
+		query.WriteString("\n\tAND handle_status=:handle_status")
+		argMap["handle_status"] = filter.HandleStatus
+	}
+	if filter.ItemType > 0 {
+		query.WriteString("\n\tAND item_type=:item_type")
+		argMap["item_type"] = filter.ItemType
+	}
+	if filter.ItemID > 0 {
+		query.WriteString("\n\tAND item_id=:item_id")
+		argMap["item_id"] = filter.ItemID
+	}
+	if filter.NotifyType > 0 {
+		query.WriteString("\n\tAND notify_type=:notify_type")
+		argMap["notify_type"] = filter.NotifyType
+	}
+	if filter.StartTime > 0 {
+		query.WriteString("\n\tAND create_time>=:start_time")
+		argMap["start_time"] = filter.StartTime
+	}
+	if filter.EndTime > 0 {
+		query.WriteString("\n\tAND create_time<:end_time")
+		argMap["end_time"] = filter.EndTime
+	}
+	query.WriteString("\nORDER BY\n\tid DESC")
+	if filter.Limit > 0 {
+		query.WriteString(fmt.Sprintf("\nLIMIT %d", filter.Limit))
+	}
 
-	var rows []*model.DBTAppConfigToken
+	var rows []*model.DBTProductNotify
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
 		&rows,
 		query.String(),
-		gin.H{},
+		argMap,
 	)
 	if err != nil {
 		return nil, err
@@ -1044,25 +1579,82 @@ FROM
 	return rows, nil
 }
 
-// SQLSelectTTxErc20ColByStatus 根据ids获取
-func SQLSelectTTxErc20ColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64) ([]*model.DBTTxErc20, error) {
+// SQLUpdateTProductNotifyInitByIDs 重置通知状态，等待重新发送
+func SQLUpdateTProductNotifyInitByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, now int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_product_notify
+SET
+    handle_status=:handle_status,
+    update_time=:update_time
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":           ids,
+			"handle_status": NotifyStatusInit,
+			"update_time":   now,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLUpdateTProductNotifyMsgByID 更新通知内容，等待重新发送
+func SQLUpdateTProductNotifyMsgByID(ctx context.Context, tx mcommon.DbExeAble, row *model.DBTProductNotify) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_product_notify
+SET
+    nonce=:nonce,
+    url=:url,
+    msg=:msg,
+    handle_status=:handle_status,
+    update_time=:update_time
+WHERE
+	id=:id`,
+		gin.H{
+			"id":            row.ID,
+			"nonce":         row.Nonce,
+			"url":           row.URL,
+			"msg":           row.Msg,
+			"handle_status": row.HandleStatus,
+			"update_time":   row.UpdateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTAppConfigTokenColAll 根据ids获取
+func SQLSelectTAppConfigTokenColAll(ctx context.Context, tx mcommon.DbExeAble, cols []string, chain string) ([]*model.DBTAppConfigToken, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_erc20
+	t_app_config_token
 WHERE
-	handle_status=:handle_status`)
+	chain=:chain`)
 
-	var rows []*model.DBTTxErc20
+	var rows []*model.DBTAppConfigToken
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
 		&rows,
 		query.String(),
 		gin.H{
-			"handle_status": status,
+			"chain": chain,
 		},
 	)
 	if err != nil {
@@ -1071,8 +1663,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTTxErc20ColByOrgForUpdate 获取未整理交易
-func SQLSelectTTxErc20ColByOrgForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, orgStatuses []int64) ([]*model.DBTTxErc20, error) {
+// SQLSelectTTxErc20ColByStatus 根据ids获取
+func SQLSelectTTxErc20ColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64, chain string) ([]*model.DBTTxErc20, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
@@ -1080,8 +1672,8 @@ func SQLSelectTTxErc20ColByOrgForUpdate(ctx context.Context, tx mcommon.DbExeAbl
 FROM
 	t_tx_erc20
 WHERE
-	org_status IN (:org_status)
-FOR UPDATE`)
+	chain=:chain
+	AND handle_status=:handle_status`)
 
 	var rows []*model.DBTTxErc20
 	err := mcommon.DbSelectNamedContent(
@@ -1090,7 +1682,8 @@ FOR UPDATE`)
 		&rows,
 		query.String(),
 		gin.H{
-			"org_status": orgStatuses,
+			"chain":         chain,
+			"handle_status": status,
 		},
 	)
 	if err != nil {
@@ -1099,11 +1692,41 @@ FOR UPDATE`)
 	return rows, nil
 }
 
-// SQLUpdateTTxErc20OrgStatusByIDs 更新
-func SQLUpdateTTxErc20OrgStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row model.DBTTxErc20) (int64, error) {
-	if len(ids) == 0 {
-		return 0, nil
-	}
+// SQLSelectTTxErc20ColByOrgForUpdate 获取未整理交易
+func SQLSelectTTxErc20ColByOrgForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, orgStatuses []int64, chain string) ([]*model.DBTTxErc20, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_erc20
+WHERE
+	chain=:chain
+	AND org_status IN (:org_status)
+FOR UPDATE`)
+
+	var rows []*model.DBTTxErc20
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"chain":      chain,
+			"org_status": orgStatuses,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTTxErc20OrgStatusByIDs 更新
+func SQLUpdateTTxErc20OrgStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row model.DBTTxErc20) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
@@ -1256,6 +1879,67 @@ ON DUPLICATE KEY UPDATE
 	return count, nil
 }
 
+// SQLUpdateTTxBtcUxtoReleaseBySpendTxIDs 释放被占用的uxto
+func SQLUpdateTTxBtcUxtoReleaseBySpendTxIDs(ctx context.Context, tx mcommon.DbExeAble, spendTxIDs []string, handleTime int64) (int64, error) {
+	if len(spendTxIDs) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_btc_uxto
+SET
+    spend_tx_id='',
+    spend_n=0,
+    handle_status=:handle_status,
+    handle_msg="release",
+    handle_time=:handle_time
+WHERE
+	spend_tx_id IN (:spend_tx_ids)
+	AND handle_status=:use_status`,
+		gin.H{
+			"spend_tx_ids":  spendTxIDs,
+			"handle_status": UxtoHandleStatusInit,
+			"handle_time":   handleTime,
+			"use_status":    UxtoHandleStatusUse,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLUpdateTTxBtcUxtoInvalidByTxIDs 作废交易产生的uxto
+func SQLUpdateTTxBtcUxtoInvalidByTxIDs(ctx context.Context, tx mcommon.DbExeAble, txIDs []string, handleMsg string, handleTime int64) (int64, error) {
+	if len(txIDs) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_btc_uxto
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	tx_id IN (:tx_ids)`,
+		gin.H{
+			"tx_ids":        txIDs,
+			"handle_status": UxtoHandleStatusInvalid,
+			"handle_msg":    handleMsg,
+			"handle_time":   handleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 // SQLSelectTTxBtcUxtoColToOrgForUpdate 根据ids获取
 func SQLSelectTTxBtcUxtoColToOrgForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, uxtoType int64) ([]*model.DBTTxBtcUxto, error) {
 	query := strings.Builder{}
@@ -1334,6 +2018,37 @@ ORDER BY
 	return rows, nil
 }
 
+// SQLGetTTxBtcUxtoBalanceByAddressAndType 获取地址可用的uxto金额
+func SQLGetTTxBtcUxtoBalanceByAddressAndType(ctx context.Context, tx mcommon.DbExeAble, address string, uxtoType int64) (string, error) {
+	var i string
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&i,
+		`SELECT
+	IFNULL(SUM(CAST(vout_value as DECIMAL(65,8))), "0")
+FROM
+	t_tx_btc_uxto
+WHERE
+	vout_address=:vout_address
+	AND handle_status=:handle_status
+	AND uxto_type=:uxto_type
+LIMIT 1`,
+		gin.H{
+			"vout_address":  address,
+			"handle_status": UxtoHandleStatusInit,
+			"uxto_type":     uxtoType,
+		},
+	)
+	if err != nil {
+		return "0", err
+	}
+	if !ok {
+		return "0", nil
+	}
+	return i, nil
+}
+
 // SQLSelectTTxBtcUxtoColByAddressesAndTypeForUpdate 根据ids获取
 func SQLSelectTTxBtcUxtoColByAddressesAndTypeForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, addresses []string, uxtoType int64) ([]*model.DBTTxBtcUxto, error) {
 	query := strings.Builder{}
@@ -1819,3 +2534,801 @@ WHERE
 	}
 	return addressInt, nil
 }
+
+// SQLSelectTAppJobRunColByFilter 根据条件查询任务运行记录
+func SQLSelectTAppJobRunColByFilter(ctx context.Context, tx mcommon.DbExeAble, cols []string, filter *StJobRunFilter) ([]*model.DBTAppJobRun, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_job_run
+WHERE
+	1=1`)
+	argMap := gin.H{}
+	if filter.Name != "" {
+		query.WriteString("\n\tAND name=:name")
+		argMap["name"] = filter.Name
+	}
+	if filter.StartTime > 0 {
+		query.WriteString("\n\tAND start_time>=:start_time")
+		argMap["start_time"] = filter.StartTime
+	}
+	if filter.EndTime > 0 {
+		query.WriteString("\n\tAND start_time<:end_time")
+		argMap["end_time"] = filter.EndTime
+	}
+	if filter.IsHideSkipped {
+		query.WriteString("\n\tAND lock_skipped=0")
+	}
+	if filter.IsErrOnly {
+		query.WriteString("\n\tAND err_msg<>''")
+	}
+	query.WriteString("\nORDER BY\n\tid DESC")
+	if filter.Limit > 0 {
+		query.WriteString(fmt.Sprintf("\nLIMIT %d", filter.Limit))
+	}
+
+	var rows []*model.DBTAppJobRun
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLDeleteTAppJobRunByStartTime 删除指定时间之前的任务运行记录
+func SQLDeleteTAppJobRunByStartTime(ctx context.Context, tx mcommon.DbExeAble, startTime int64, limit int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		fmt.Sprintf(`DELETE FROM
+	t_app_job_run
+WHERE
+	start_time<:start_time
+LIMIT %d`, limit),
+		gin.H{
+			"start_time": startTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLUpdateTAppStatusIntByKLess 回退
+func SQLUpdateTAppStatusIntByKLess(ctx context.Context, tx mcommon.DbExeAble, row *model.DBTAppStatusInt) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_app_status_int
+SET
+    v=:v
+WHERE
+	k=:k
+	AND v>:v`,
+		gin.H{
+			"k": row.K,
+			"v": row.V,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTEthBlockHashForUpdate 获取并锁定区块的hash，没有记录时返回空，需要在事务中调用
+func SQLGetTEthBlockHashForUpdate(ctx context.Context, tx mcommon.DbExeAble, blockNumber int64, chain string) (string, error) {
+	var row model.DBTEthBlock
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		`SELECT
+	block_hash
+FROM
+	t_eth_block
+WHERE
+	chain=:chain
+	AND block_number=:block_number
+LIMIT 1
+FOR UPDATE`,
+		gin.H{
+			"chain":        chain,
+			"block_number": blockNumber,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", nil
+	}
+	return row.BlockHash, nil
+}
+
+// SQLLockTEthBlockByBlockNumberGreater 锁定高于指定高度的区块记录，需要在事务中调用
+func SQLLockTEthBlockByBlockNumberGreater(ctx context.Context, tx mcommon.DbExeAble, blockNumber int64, chain string) error {
+	var rows []*model.DBTEthBlock
+	return mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		`SELECT
+	id
+FROM
+	t_eth_block
+WHERE
+	chain=:chain
+	AND block_number>:block_number
+FOR UPDATE`,
+		gin.H{
+			"chain":        chain,
+			"block_number": blockNumber,
+		},
+	)
+}
+
+// SQLDeleteTEthBlockByBlockNumberGreater 删除高于指定高度的区块记录
+func SQLDeleteTEthBlockByBlockNumberGreater(ctx context.Context, tx mcommon.DbExeAble, blockNumber int64, chain string) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE FROM
+	t_eth_block
+WHERE
+	chain=:chain
+	AND block_number>:block_number`,
+		gin.H{
+			"chain":        chain,
+			"block_number": blockNumber,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTEthBlockByBlockNumberLess 删除低于指定高度的区块记录
+func SQLDeleteTEthBlockByBlockNumberLess(ctx context.Context, tx mcommon.DbExeAble, blockNumber int64, chain string) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE FROM
+	t_eth_block
+WHERE
+	chain=:chain
+	AND block_number<:block_number`,
+		gin.H{
+			"chain":        chain,
+			"block_number": blockNumber,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTTxColByBlockNumberGreater 获取高于指定高度且未回滚的交易
+func SQLSelectTTxColByBlockNumberGreater(ctx context.Context, tx mcommon.DbExeAble, cols []string, blockNumber int64, chain string) ([]*model.DBTTx, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx
+WHERE
+	chain=:chain
+	AND block_number>:block_number
+	AND handle_status<>:handle_status`)
+
+	var rows []*model.DBTTx
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"chain":         chain,
+			"block_number":  blockNumber,
+			"handle_status": TxStatusReorg,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTTxErc20ColByBlockNumberGreater 获取高于指定高度且未回滚的交易
+func SQLSelectTTxErc20ColByBlockNumberGreater(ctx context.Context, tx mcommon.DbExeAble, cols []string, blockNumber int64, chain string) ([]*model.DBTTxErc20, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_erc20
+WHERE
+	chain=:chain
+	AND block_number>:block_number
+	AND handle_status<>:handle_status`)
+
+	var rows []*model.DBTTxErc20
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"chain":         chain,
+			"block_number":  blockNumber,
+			"handle_status": TxStatusReorg,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTTxReorgByIDs 标记交易所在区块被回滚，未整理的不再整理
+func SQLUpdateTTxReorgByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, now int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time,
+    org_status=IF(org_status=:org_status_init, :org_status, org_status)
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":             ids,
+			"handle_status":   TxStatusReorg,
+			"handle_msg":      NotifyReasonReorg,
+			"handle_time":     now,
+			"org_status_init": TxOrgStatusInit,
+			"org_status":      TxOrgStatusReorg,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLUpdateTTxErc20ReorgByIDs 标记交易所在区块被回滚，未整理的不再整理
+func SQLUpdateTTxErc20ReorgByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, now int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_erc20
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time,
+    org_status=IF(org_status=:org_status_init, :org_status, org_status)
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":             ids,
+			"handle_status":   TxStatusReorg,
+			"handle_msg":      NotifyReasonReorg,
+			"handle_time":     now,
+			"org_status_init": TxOrgStatusInit,
+			"org_status":      TxOrgStatusReorg,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTBtcBlockColByBlockNumberGreater 获取检测任务记录的高于指定高度的区块
+func SQLSelectTBtcBlockColByBlockNumberGreater(ctx context.Context, tx mcommon.DbExeAble, cols []string, seekKey string, blockNumber int64) ([]*model.DBTBtcBlock, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_btc_block
+WHERE
+	seek_key=:seek_key
+	AND block_number>:block_number`)
+
+	var rows []*model.DBTBtcBlock
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"seek_key":     seekKey,
+			"block_number": blockNumber,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLDeleteTBtcBlockByBlockNumberGreater 删除检测任务记录的高于指定高度的区块
+func SQLDeleteTBtcBlockByBlockNumberGreater(ctx context.Context, tx mcommon.DbExeAble, seekKey string, blockNumber int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE FROM
+	t_btc_block
+WHERE
+	seek_key=:seek_key
+	AND block_number>:block_number`,
+		gin.H{
+			"seek_key":     seekKey,
+			"block_number": blockNumber,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTBtcBlockByBlockNumberLess 删除检测任务记录的低于指定高度的区块
+func SQLDeleteTBtcBlockByBlockNumberLess(ctx context.Context, tx mcommon.DbExeAble, seekKey string, blockNumber int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE FROM
+	t_btc_block
+WHERE
+	seek_key=:seek_key
+	AND block_number<:block_number`,
+		gin.H{
+			"seek_key":     seekKey,
+			"block_number": blockNumber,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTTxBtcColByBlockHashes 获取指定区块中未回滚的交易
+func SQLSelectTTxBtcColByBlockHashes(ctx context.Context, tx mcommon.DbExeAble, cols []string, blockHashes []string) ([]*model.DBTTxBtc, error) {
+	if len(blockHashes) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_btc
+WHERE
+	block_hash IN (:block_hashes)
+	AND handle_status<>:handle_status`)
+
+	var rows []*model.DBTTxBtc
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"block_hashes":  blockHashes,
+			"handle_status": TxStatusReorg,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTTxBtcTokenColByBlockHashes 获取指定区块中未回滚的交易
+func SQLSelectTTxBtcTokenColByBlockHashes(ctx context.Context, tx mcommon.DbExeAble, cols []string, blockHashes []string) ([]*model.DBTTxBtcToken, error) {
+	if len(blockHashes) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_btc_token
+WHERE
+	block_hash IN (:block_hashes)
+	AND handle_status<>:handle_status`)
+
+	var rows []*model.DBTTxBtcToken
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"block_hashes":  blockHashes,
+			"handle_status": TxStatusReorg,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTTxBtcReorgByIDs 标记交易所在区块被回滚
+func SQLUpdateTTxBtcReorgByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, now int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_btc
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":           ids,
+			"handle_status": TxStatusReorg,
+			"handle_msg":    NotifyReasonReorg,
+			"handle_time":   now,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLUpdateTTxBtcTokenReorgByIDs 标记交易所在区块被回滚，未整理的不再整理
+func SQLUpdateTTxBtcTokenReorgByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, now int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_btc_token
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_at=:handle_at,
+    org_status=IF(org_status=:org_status_init, :org_status, org_status)
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":             ids,
+			"handle_status":   TxStatusReorg,
+			"handle_msg":      NotifyReasonReorg,
+			"handle_at":       now,
+			"org_status_init": TxOrgStatusInit,
+			"org_status":      TxOrgStatusReorg,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTTxBtcUxtoUnspentByBlockHashes 删除指定区块中未使用的uxto
+func SQLDeleteTTxBtcUxtoUnspentByBlockHashes(ctx context.Context, tx mcommon.DbExeAble, blockHashes []string) (int64, error) {
+	if len(blockHashes) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE FROM
+	t_tx_btc_uxto
+WHERE
+	block_hash IN (:block_hashes)
+	AND handle_status=:handle_status`,
+		gin.H{
+			"block_hashes":  blockHashes,
+			"handle_status": UxtoHandleStatusInit,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLUpdateTTxBtcUxtoReorgByBlockHashes 标记指定区块中已使用的uxto被回滚
+func SQLUpdateTTxBtcUxtoReorgByBlockHashes(ctx context.Context, tx mcommon.DbExeAble, blockHashes []string, now int64) (int64, error) {
+	if len(blockHashes) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_btc_uxto
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	block_hash IN (:block_hashes)
+	AND handle_status IN (:spent_status)`,
+		gin.H{
+			"block_hashes":  blockHashes,
+			"handle_status": UxtoHandleStatusReorg,
+			"handle_msg":    NotifyReasonReorg,
+			"handle_time":   now,
+			"spent_status": []int64{
+				UxtoHandleStatusUse,
+				UxtoHandleStatusConfirm,
+			},
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTAppConfigNftColAll 获取所有nft配置
+func SQLSelectTAppConfigNftColAll(ctx context.Context, tx mcommon.DbExeAble, cols []string, chain string) ([]*model.DBTAppConfigNft, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_config_nft
+WHERE
+	chain=:chain`)
+
+	var rows []*model.DBTAppConfigNft
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"chain": chain,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTTxNftColByStatus 根据处理状态获取
+func SQLSelectTTxNftColByStatus(ctx context.Context, tx mcommon.DbExeAble, cols []string, status int64, chain string) ([]*model.DBTTxNft, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_nft
+WHERE
+	chain=:chain
+	AND handle_status=:handle_status`)
+
+	var rows []*model.DBTTxNft
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"chain":         chain,
+			"handle_status": status,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTTxNftStatusByIDs 更新
+func SQLUpdateTTxNftStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row model.DBTTxNft) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_nft
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":           ids,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTTxNftColByOrgForUpdate 获取未整理交易
+func SQLSelectTTxNftColByOrgForUpdate(ctx context.Context, tx mcommon.DbExeAble, cols []string, orgStatuses []int64, chain string) ([]*model.DBTTxNft, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_nft
+WHERE
+	chain=:chain
+	AND org_status IN (:org_status)
+FOR UPDATE`)
+
+	var rows []*model.DBTTxNft
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"chain":      chain,
+			"org_status": orgStatuses,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTTxNftOrgStatusByIDs 更新
+func SQLUpdateTTxNftOrgStatusByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, row model.DBTTxNft) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_nft
+SET
+    org_status=:org_status,
+    org_msg=:org_msg,
+    org_time=:org_time
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":        ids,
+			"org_status": row.OrgStatus,
+			"org_msg":    row.OrgMsg,
+			"org_time":   row.OrgTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTTxNftColByBlockNumberGreater 获取高于指定高度且未回滚的交易
+func SQLSelectTTxNftColByBlockNumberGreater(ctx context.Context, tx mcommon.DbExeAble, cols []string, blockNumber int64, chain string) ([]*model.DBTTxNft, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_tx_nft
+WHERE
+	chain=:chain
+	AND block_number>:block_number
+	AND handle_status<>:handle_status`)
+
+	var rows []*model.DBTTxNft
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{
+			"chain":         chain,
+			"block_number":  blockNumber,
+			"handle_status": TxStatusReorg,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTTxNftReorgByIDs 标记交易所在区块被回滚，未整理的不再整理
+func SQLUpdateTTxNftReorgByIDs(ctx context.Context, tx mcommon.DbExeAble, ids []int64, now int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_tx_nft
+SET
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time,
+    org_status=IF(org_status=:org_status_init, :org_status, org_status)
+WHERE
+	id IN (:ids)`,
+		gin.H{
+			"ids":             ids,
+			"handle_status":   TxStatusReorg,
+			"handle_msg":      NotifyReasonReorg,
+			"handle_time":     now,
+			"org_status_init": TxOrgStatusInit,
+			"org_status":      TxOrgStatusReorg,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLSelectTAppConfigChainColEnable 获取开启的evm链配置
+func SQLSelectTAppConfigChainColEnable(ctx context.Context, tx mcommon.DbExeAble, cols []string) ([]*model.DBTAppConfigChain, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_config_chain
+WHERE
+	enable=1
+ORDER BY id`)
+
+	var rows []*model.DBTAppConfigChain
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		gin.H{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
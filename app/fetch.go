@@ -0,0 +1,114 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+)
+
+// BlockFetchFunc 获取指定高度区块
+type BlockFetchFunc func(ctx context.Context, num int64) (interface{}, error)
+
+type blockFetchResult struct {
+	block interface{}
+	err   error
+}
+
+// StBlockFetcher 区块预取，并发获取后续区块，按高度顺序返回
+type StBlockFetcher struct {
+	ctx         context.Context
+	cancel      context.CancelFunc
+	fetch       BlockFetchFunc
+	concurrency int64
+	// 下一个返回的区块高度
+	cur int64
+	// 下一个开始获取的区块高度
+	next    int64
+	end     int64
+	pending []chan blockFetchResult
+}
+
+// NewBlockFetcher 创建区块预取 获取 [start, end) 区间的区块
+func NewBlockFetcher(ctx context.Context, start int64, end int64, concurrency int64, fetch BlockFetchFunc) *StBlockFetcher {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	fetchCtx, cancel := context.WithCancel(ctx)
+	f := &StBlockFetcher{
+		ctx:         fetchCtx,
+		cancel:      cancel,
+		fetch:       fetch,
+		concurrency: concurrency,
+		cur:         start,
+		next:        start,
+		end:         end,
+	}
+	f.fill()
+	return f
+}
+
+// fill 补充获取中的区块到并发上限
+func (f *StBlockFetcher) fill() {
+	for f.next < f.end && int64(len(f.pending)) < f.concurrency {
+		ch := make(chan blockFetchResult, 1)
+		go func(num int64) {
+			block, err := f.fetch(f.ctx, num)
+			ch <- blockFetchResult{
+				block: block,
+				err:   err,
+			}
+		}(f.next)
+		f.pending = append(f.pending, ch)
+		f.next++
+	}
+}
+
+// Get 等待并返回指定高度的区块，必须按高度顺序调用
+// 返回错误后调用方应停止处理，之后的区块不再返回
+func (f *StBlockFetcher) Get(num int64) (interface{}, error) {
+	if num != f.cur || len(f.pending) == 0 {
+		return nil, fmt.Errorf("block fetch out of order: %d expect %d", num, f.cur)
+	}
+	ch := f.pending[0]
+	f.pending = f.pending[1:]
+	r := <-ch
+	if r.err != nil {
+		f.Close()
+		return nil, r.err
+	}
+	f.cur++
+	f.fill()
+	return r.block, nil
+}
+
+// Close 取消未完成的获取
+func (f *StBlockFetcher) Close() {
+	f.cancel()
+	f.pending = nil
+	f.next = f.end
+}
+
+// GetBlockFetchConcurrency 获取区块预取并发数配置
+func GetBlockFetchConcurrency(ctx context.Context) (int64, error) {
+	row, err := model.SQLGetTAppConfigIntColKV(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTAppConfigIntV,
+		},
+		[]string{
+			model.DBColShortTAppConfigIntK,
+		},
+		[]interface{}{
+			"block_fetch_concurrency",
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	if row == nil || row.V < 1 {
+		return BlockFetchConcurrencyDefault, nil
+	}
+	return row.V, nil
+}
@@ -10,17 +10,16 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/moremorefun/mcommon"
 	"github.com/parnurzeal/gorequest"
 )
 
 // CheckDoNotify 检测发送回调
-func CheckDoNotify() {
+func CheckDoNotify(ctx context.Context) {
 	lockKey := "CheckDoNotify"
-	LockWrap(lockKey, func() {
+	LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 初始化的
 		initNotifyRows, err := SQLSelectTProductNotifyColByStatusAndTime(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTProductNotifyID,
@@ -31,12 +30,12 @@ func CheckDoNotify() {
 			time.Now().Unix(),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 错误的
 		delayNotifyRows, err := SQLSelectTProductNotifyColByStatusAndTime(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTProductNotifyID,
@@ -47,11 +46,12 @@ func CheckDoNotify() {
 			time.Now().Add(-time.Minute*10).Unix(),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 合并初始化状态和发送失败状态数据
 		initNotifyRows = append(initNotifyRows, delayNotifyRows...)
+		JobAddCount(ctx, int64(len(initNotifyRows)))
 		// 遍历发送通知
 		for _, initNotifyRow := range initNotifyRows {
 			// 发送通知
@@ -61,9 +61,9 @@ func CheckDoNotify() {
 				Send(initNotifyRow.Msg).
 				End()
 			if errs != nil {
-				mcommon.Log.Errorf("err: [%T] %s", errs[0], errs[0].Error())
+				JobErrorf(ctx, "err: [%T] %s", errs[0], errs[0].Error())
 				_, err = SQLUpdateTProductNotifyStatusByID(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					&model.DBTProductNotify{
 						ID:           initNotifyRow.ID,
@@ -73,15 +73,15 @@ func CheckDoNotify() {
 					},
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				}
 				continue
 			}
 			if gresp.StatusCode != http.StatusOK {
 				// 状态错误
-				mcommon.Log.Errorf("req status error: %d", gresp.StatusCode)
+				JobErrorf(ctx, "req status error: %d", gresp.StatusCode)
 				_, err = SQLUpdateTProductNotifyStatusByID(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					&model.DBTProductNotify{
 						ID:           initNotifyRow.ID,
@@ -91,16 +91,16 @@ func CheckDoNotify() {
 					},
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				}
 				continue
 			}
 			resp := gin.H{}
 			err = json.Unmarshal([]byte(body), &resp)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				_, err = SQLUpdateTProductNotifyStatusByID(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					&model.DBTProductNotify{
 						ID:           initNotifyRow.ID,
@@ -110,7 +110,7 @@ func CheckDoNotify() {
 					},
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				}
 				continue
 			}
@@ -118,7 +118,7 @@ func CheckDoNotify() {
 			if ok {
 				// 处理成功
 				_, err = SQLUpdateTProductNotifyStatusByID(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					&model.DBTProductNotify{
 						ID:           initNotifyRow.ID,
@@ -128,7 +128,7 @@ func CheckDoNotify() {
 					},
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				}
 			} else {
 				if len(body) > 500 {
@@ -136,7 +136,7 @@ func CheckDoNotify() {
 				}
 				//mcommon.Log.Errorf("no error in resp")
 				_, err = SQLUpdateTProductNotifyStatusByID(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					&model.DBTProductNotify{
 						ID:           initNotifyRow.ID,
@@ -146,7 +146,7 @@ func CheckDoNotify() {
 					},
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				}
 				continue
 			}
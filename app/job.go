@@ -0,0 +1,108 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/moremorefun/mcommon"
+	"go.uber.org/zap"
+)
+
+// JobRunErrMsgMaxLen 任务运行记录中错误信息的最大长度
+const JobRunErrMsgMaxLen = 1024
+
+// InstanceID 当前进程标识 主机名-进程号
+var InstanceID = func() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}()
+
+// StJobRun 单次任务运行的统计
+type StJobRun struct {
+	mutex       sync.Mutex
+	handleCount int64
+	errMsgs     []string
+	lockSkipped bool
+}
+
+// StJobRunFilter 任务运行记录查询条件
+type StJobRunFilter struct {
+	Name          string
+	StartTime     int64
+	EndTime       int64
+	IsHideSkipped bool
+	IsErrOnly     bool
+	Limit         int64
+}
+
+type jobRunKey struct{}
+
+// WithJobRun 在ctx中附加任务运行统计
+func WithJobRun(ctx context.Context) (context.Context, *StJobRun) {
+	run := &StJobRun{}
+	return context.WithValue(ctx, jobRunKey{}, run), run
+}
+
+// getJobRun 获取ctx中的任务运行统计，不在任务中运行时返回nil
+func getJobRun(ctx context.Context) *StJobRun {
+	run, _ := ctx.Value(jobRunKey{}).(*StJobRun)
+	return run
+}
+
+// JobAddCount 记录任务处理的数量
+func JobAddCount(ctx context.Context, count int64) {
+	run := getJobRun(ctx)
+	if run == nil {
+		return
+	}
+	run.mutex.Lock()
+	run.handleCount += count
+	run.mutex.Unlock()
+}
+
+// JobErrorf 记录错误日志，同时记录到任务运行统计中
+func JobErrorf(ctx context.Context, template string, args ...interface{}) {
+	mcommon.ZapLog.WithOptions(zap.AddCallerSkip(1)).Sugar().Errorf(template, args...)
+	run := getJobRun(ctx)
+	if run == nil {
+		return
+	}
+	run.mutex.Lock()
+	run.errMsgs = append(run.errMsgs, fmt.Sprintf(template, args...))
+	run.mutex.Unlock()
+}
+
+// jobSetLockSkipped 记录任务因未获取到锁而跳过
+func jobSetLockSkipped(ctx context.Context) {
+	run := getJobRun(ctx)
+	if run == nil {
+		return
+	}
+	run.mutex.Lock()
+	run.lockSkipped = true
+	run.mutex.Unlock()
+}
+
+// Result 获取统计结果
+func (run *StJobRun) Result() (handleCount int64, errMsg string, lockSkipped bool) {
+	run.mutex.Lock()
+	defer run.mutex.Unlock()
+	errMsg = strings.Join(run.errMsgs, "; ")
+	if len(errMsg) > JobRunErrMsgMaxLen {
+		errMsg = errMsg[:JobRunErrMsgMaxLen]
+	}
+	return run.handleCount, errMsg, run.lockSkipped
+}
+
+// AddErrMsg 添加错误信息
+func (run *StJobRun) AddErrMsg(errMsg string) {
+	run.mutex.Lock()
+	run.errMsgs = append(run.errMsgs, errMsg)
+	run.mutex.Unlock()
+}
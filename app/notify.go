@@ -0,0 +1,201 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-dc-wallet/model"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/moremorefun/mcommon"
+)
+
+// 回调数据版本
+const (
+	NotifyVersion1 = 1
+	NotifyVersion2 = 2
+)
+
+// NotifyReasonReorg 区块回滚通知的原因
+const NotifyReasonReorg = "block reorg"
+
+// StNotifyData 回调数据
+type StNotifyData struct {
+	ProductID    int64
+	ItemType     int64
+	ItemID       int64
+	NotifyType   int64
+	Symbol       string
+	TxHash       string
+	Address      string
+	FromAddress  string
+	Balance      string
+	OutSerial    string
+	Memo         string
+	TokenAddress string
+	TokenIndex   int64
+	// TokenID nft token id
+	TokenID       string
+	BlockNumber   int64
+	BlockHash     string
+	Confirmations int64
+	Fee           string
+	Reason        string
+}
+
+// StNotifyFilter 通知查询条件，值为0时不作为条件，HandleStatus为-1时不作为条件
+type StNotifyFilter struct {
+	ID           int64
+	ProductID    int64
+	HandleStatus int64
+	ItemType     int64
+	ItemID       int64
+	NotifyType   int64
+	StartTime    int64
+	EndTime      int64
+	Limit        int64
+}
+
+// GetNotifyEventID 获取通知的唯一标示，重复发送时保持不变
+func GetNotifyEventID(productID, itemType, itemID, notifyType int64, symbol string) string {
+	return fmt.Sprintf("%d-%s-%d-%d-%d", productID, symbol, itemType, itemID, notifyType)
+}
+
+// IsWithdrawNotifyType 是否是提币通知
+func IsWithdrawNotifyType(notifyType int64) bool {
+	return notifyType != NotifyTypeTx && notifyType != NotifyTypeTxReorg
+}
+
+// GetNotifyReqObj 根据产品配置的版本生成回调数据
+func GetNotifyReqObj(productRow *model.DBTProduct, data *StNotifyData) gin.H {
+	var reqObj gin.H
+	switch productRow.NotifyVersion {
+	case NotifyVersion2:
+		reqObj = gin.H{
+			"version":       NotifyVersion2,
+			"event_id":      GetNotifyEventID(data.ProductID, data.ItemType, data.ItemID, data.NotifyType, data.Symbol),
+			"notify_type":   data.NotifyType,
+			"app_name":      productRow.AppName,
+			"symbol":        data.Symbol,
+			"tx_hash":       data.TxHash,
+			"address":       data.Address,
+			"from_address":  data.FromAddress,
+			"balance":       data.Balance,
+			"memo":          data.Memo,
+			"token_address": data.TokenAddress,
+			"token_index":   data.TokenIndex,
+			"block_number":  data.BlockNumber,
+			"block_hash":    data.BlockHash,
+			"confirmations": data.Confirmations,
+		}
+		if data.TokenID != "" {
+			reqObj["token_id"] = data.TokenID
+		}
+		if IsWithdrawNotifyType(data.NotifyType) {
+			reqObj["out_serial"] = data.OutSerial
+			reqObj["fee"] = data.Fee
+		}
+		if data.NotifyType == NotifyTypeWithdrawFailed || data.NotifyType == NotifyTypeTxReorg {
+			reqObj["reason"] = data.Reason
+		}
+	default:
+		reqObj = gin.H{
+			"tx_hash":     data.TxHash,
+			"app_name":    productRow.AppName,
+			"address":     data.Address,
+			"balance":     data.Balance,
+			"symbol":      data.Symbol,
+			"notify_type": data.NotifyType,
+		}
+		if data.NotifyType == NotifyTypeTx && data.Symbol == "eos" {
+			// eos 充值，与旧版本的回调数据和签名保持一致，memo 为空时同样包含
+			reqObj["memo"] = data.Memo
+		}
+		if data.TokenID != "" {
+			reqObj["token_id"] = data.TokenID
+		}
+		if IsWithdrawNotifyType(data.NotifyType) {
+			reqObj["out_serial"] = data.OutSerial
+		}
+		if data.NotifyType == NotifyTypeWithdrawFailed || data.NotifyType == NotifyTypeTxReorg {
+			reqObj["reason"] = data.Reason
+		}
+	}
+	return reqObj
+}
+
+// GetNotifyMsg 生成带签名的回调数据
+func GetNotifyMsg(productRow *model.DBTProduct, data *StNotifyData) (string, error) {
+	reqObj := GetNotifyReqObj(productRow, data)
+	reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
+	req, err := json.Marshal(reqObj)
+	if err != nil {
+		return "", err
+	}
+	return string(req), nil
+}
+
+// GetNotifyRow 生成通知数据
+func GetNotifyRow(productRow *model.DBTProduct, data *StNotifyData, now int64) (*model.DBTProductNotify, error) {
+	msg, err := GetNotifyMsg(productRow, data)
+	if err != nil {
+		return nil, err
+	}
+	return &model.DBTProductNotify{
+		Nonce:        mcommon.GetUUIDStr(),
+		ProductID:    data.ProductID,
+		ItemType:     data.ItemType,
+		ItemID:       data.ItemID,
+		NotifyType:   data.NotifyType,
+		TokenSymbol:  data.Symbol,
+		URL:          productRow.CbURL,
+		Msg:          msg,
+		HandleStatus: NotifyStatusInit,
+		HandleMsg:    "",
+		CreateTime:   now,
+		UpdateTime:   now,
+	}, nil
+}
+
+// ResignNotifyMsg 使用产品当前的密钥对原有回调数据重新签名
+func ResignNotifyMsg(productRow *model.DBTProduct, msg string) (string, error) {
+	reqObj, err := decodeNotifyMsg(msg)
+	if err != nil {
+		return "", err
+	}
+	return signNotifyReqObj(productRow, reqObj)
+}
+
+// ReplaceNotifyTxHash 修改回调数据中的 tx hash 并重新签名
+func ReplaceNotifyTxHash(productRow *model.DBTProduct, msg string, txHash string) (string, error) {
+	reqObj, err := decodeNotifyMsg(msg)
+	if err != nil {
+		return "", err
+	}
+	reqObj["tx_hash"] = txHash
+	return signNotifyReqObj(productRow, reqObj)
+}
+
+// decodeNotifyMsg 解析回调数据
+func decodeNotifyMsg(msg string) (gin.H, error) {
+	// 保持数字的原始格式，避免签名不一致
+	decoder := json.NewDecoder(strings.NewReader(msg))
+	decoder.UseNumber()
+	reqObj := gin.H{}
+	err := decoder.Decode(&reqObj)
+	if err != nil {
+		return nil, err
+	}
+	return reqObj, nil
+}
+
+// signNotifyReqObj 签名并生成回调数据
+func signNotifyReqObj(productRow *model.DBTProduct, reqObj gin.H) (string, error) {
+	delete(reqObj, "sign")
+	reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
+	req, err := json.Marshal(reqObj)
+	if err != nil {
+		return "", err
+	}
+	return string(req), nil
+}
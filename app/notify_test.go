@@ -0,0 +1,82 @@
+package app
+
+import (
+	"encoding/json"
+	"go-dc-wallet/model"
+	"testing"
+
+	"github.com/moremorefun/mcommon"
+)
+
+func TestGetNotifyReqObjV1Memo(t *testing.T) {
+	productRow := &model.DBTProduct{
+		AppName: "app",
+		AppSk:   "sk",
+	}
+	// eos 充值始终包含 memo
+	reqObj := GetNotifyReqObj(productRow, &StNotifyData{
+		NotifyType: NotifyTypeTx,
+		Symbol:     "eos",
+		TxHash:     "0xaa_0",
+		Address:    "wallet",
+		Balance:    "1.0000",
+	})
+	memo, ok := reqObj["memo"]
+	if !ok || memo != "" {
+		t.Fatalf("eos tx req: %v", reqObj)
+	}
+	reqObj = GetNotifyReqObj(productRow, &StNotifyData{
+		NotifyType: NotifyTypeTx,
+		Symbol:     "eth",
+		TxHash:     "0xbb",
+		Address:    "0xcc",
+		Balance:    "1",
+	})
+	if _, ok := reqObj["memo"]; ok {
+		t.Fatalf("eth tx req: %v", reqObj)
+	}
+}
+
+func TestReplaceNotifyTxHash(t *testing.T) {
+	productRow := &model.DBTProduct{
+		AppName:       "app",
+		AppSk:         "sk",
+		NotifyVersion: NotifyVersion2,
+	}
+	msg, err := GetNotifyMsg(productRow, &StNotifyData{
+		ProductID:   1,
+		ItemType:    SendRelationTypeWithdraw,
+		ItemID:      2,
+		NotifyType:  NotifyTypeWithdrawSend,
+		Symbol:      "eth",
+		TxHash:      "0xaa",
+		Address:     "0xcc",
+		Balance:     "1",
+		BlockNumber: 100,
+		OutSerial:   "s1",
+	})
+	if err != nil {
+		t.Fatalf("get msg err: %s", err.Error())
+	}
+	newMsg, err := ReplaceNotifyTxHash(productRow, msg, "0xbb")
+	if err != nil {
+		t.Fatalf("replace err: %s", err.Error())
+	}
+	var oldObj, newObj map[string]interface{}
+	err = json.Unmarshal([]byte(msg), &oldObj)
+	if err != nil {
+		t.Fatalf("decode err: %s", err.Error())
+	}
+	err = json.Unmarshal([]byte(newMsg), &newObj)
+	if err != nil {
+		t.Fatalf("decode err: %s", err.Error())
+	}
+	if newObj["tx_hash"] != "0xbb" || newObj["event_id"] != oldObj["event_id"] {
+		t.Fatalf("new msg: %s", newMsg)
+	}
+	sign := newObj["sign"]
+	delete(newObj, "sign")
+	if sign != mcommon.WechatGetSign(productRow.AppSk, newObj) {
+		t.Fatalf("sign wrong: %s", newMsg)
+	}
+}
@@ -4,6 +4,8 @@ package app
 const (
 	TxStatusInit   = 0
 	TxStatusNotify = 1
+	// TxStatusReorg 所在区块被回滚
+	TxStatusReorg = 2
 )
 
 // 零钱整理状态
@@ -15,6 +17,8 @@ const (
 	TxOrgStatusFeeHex     = 4
 	TxOrgStatusFeeSend    = 5
 	TxOrgStatusFeeConfirm = 6
+	// TxOrgStatusReorg 所在区块被回滚，不再整理
+	TxOrgStatusReorg = 7
 )
 
 // 发送状态
@@ -22,6 +26,7 @@ const (
 	SendStatusInit    = 0
 	SendStatusSend    = 1
 	SendStatusConfirm = 2
+	SendStatusFailed  = 3
 )
 
 // 发送类型
@@ -32,6 +37,14 @@ const (
 	SendRelationTypeTxErc20Fee = 4
 	SendRelationTypeUXTOOrg    = 5
 	SendRelationTypeOmniOrg    = 6
+	// SendRelationTypeNonceFill 填补nonce空缺的0金额转给自己的交易
+	SendRelationTypeNonceFill = 7
+	// SendRelationTypeWithdrawBatch 批量提币，关联的提币记录在 t_send_withdraw
+	SendRelationTypeWithdrawBatch = 8
+	// SendRelationTypeTxNft nft零钱整理
+	SendRelationTypeTxNft = 9
+	// SendRelationTypeTxNftFee nft零钱整理的eth手续费
+	SendRelationTypeTxNftFee = 10
 )
 
 // 通知状态
@@ -46,6 +59,9 @@ const (
 	NotifyTypeTx              = 1
 	NotifyTypeWithdrawSend    = 2
 	NotifyTypeWithdrawConfirm = 3
+	NotifyTypeWithdrawFailed  = 4
+	// NotifyTypeTxReorg 充币所在区块被回滚
+	NotifyTypeTxReorg = 5
 )
 
 // 提币状态
@@ -54,6 +70,7 @@ const (
 	WithdrawStatusHex     = 1
 	WithdrawStatusSend    = 2
 	WithdrawStatusConfirm = 3
+	WithdrawStatusFailed  = 4
 )
 
 // uxto 类型
@@ -70,4 +87,21 @@ const (
 	UxtoHandleStatusInit    = 0
 	UxtoHandleStatusUse     = 1
 	UxtoHandleStatusConfirm = 2
+	UxtoHandleStatusInvalid = 3
+	// UxtoHandleStatusReorg 已使用的uxto所在区块被回滚，需要人工处理
+	UxtoHandleStatusReorg = 4
+)
+
+// 运行锁租约
+const (
+	// LockLeaseSeconds 租约时长，超过该时间未续约视为失效
+	LockLeaseSeconds = 60
+	// LockHeartbeatSeconds 持有期间续约间隔
+	LockHeartbeatSeconds = 20
+)
+
+// 区块预取
+const (
+	// BlockFetchConcurrencyDefault 默认同时获取的区块数
+	BlockFetchConcurrencyDefault = 5
 )
@@ -2,6 +2,8 @@
 package main
 
 import (
+	"context"
+	"go-dc-wallet/heth"
 	"go-dc-wallet/web"
 	"go-dc-wallet/xenv"
 	"time"
@@ -15,6 +17,11 @@ import (
 func main() {
 	xenv.EnvCreate()
 	defer xenv.EnvDestroy()
+	// 加载其他evm链，用于提币币种和通知
+	err := heth.InitChains(context.Background())
+	if err != nil {
+		mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+	}
 	// 初始化gin
 	if !xenv.Cfg.IsDebug {
 		gin.SetMode(gin.ReleaseMode)
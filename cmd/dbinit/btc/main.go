@@ -58,7 +58,7 @@ func main() {
 		btcAddresses = append(btcAddresses, btcAddressRow.Address)
 	}
 	if len(btcAddresses) < 10 {
-		btcAddresses, err = hbtc.CreateHotAddress(50)
+		btcAddresses, err = hbtc.CreateHotAddress(context.Background(), 50)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
@@ -123,7 +123,7 @@ func main() {
 	}
 
 	// 5. 初始化 t_app_status_int
-	btcRPCBlockNum, err := omniclient.RPCGetBlockCount()
+	btcRPCBlockNum, err := omniclient.RPCGetBlockCount(context.Background())
 	if err != nil {
 		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 		return
@@ -13,7 +13,7 @@ func main() {
 	xenv.EnvCreate()
 	defer xenv.EnvDestroy()
 
-	rpcChainInfo, err := eosclient.RPCChainGetInfo()
+	rpcChainInfo, err := eosclient.RPCChainGetInfo(context.Background())
 	if err != nil {
 		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 		return
@@ -13,7 +13,6 @@ import (
 	"go-dc-wallet/model"
 	"go-dc-wallet/omniclient"
 	"go-dc-wallet/xenv"
-	"math"
 	"net/http"
 	"strings"
 	"time"
@@ -81,7 +80,7 @@ func main() {
 	}
 	if len(ethAddresses) < 10 {
 		// 创建可用地址
-		ethAddresses, err = heth.CreateHotAddress(50)
+		ethAddresses, err = heth.CreateHotAddress(context.Background(), 50)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
@@ -106,7 +105,7 @@ func main() {
 		btcAddresses = append(btcAddresses, btcAddressRow.Address)
 	}
 	if len(btcAddresses) < 10 {
-		btcAddresses, err = hbtc.CreateHotAddress(50)
+		btcAddresses, err = hbtc.CreateHotAddress(context.Background(), 50)
 		if err != nil {
 			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 			return
@@ -183,6 +182,7 @@ func main() {
 	configTokenRows := []*model.DBTAppConfigToken{
 		{
 			// erc20 token配置
+			Chain:         ethclient.DefaultChain,
 			TokenAddress:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
 			TokenDecimals: 6,
 			TokenSymbol:   "erc20_usdt",
@@ -233,56 +233,29 @@ func main() {
 		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 		return
 	}
-	btcRPCBlockNum, err := omniclient.RPCGetBlockCount()
+	btcRPCBlockNum, err := omniclient.RPCGetBlockCount(context.Background())
 	if err != nil {
 		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 		return
 	}
-	rpcChainInfo, err := eosclient.RPCChainGetInfo()
+	rpcChainInfo, err := eosclient.RPCChainGetInfo(context.Background())
 	if err != nil {
 		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 		return
 	}
-	type StRespGasPrice struct {
-		Fast        int64   `json:"fast"`
-		Fastest     int64   `json:"fastest"`
-		SafeLow     int64   `json:"safeLow"`
-		Average     int64   `json:"average"`
-		BlockTime   float64 `json:"block_time"`
-		BlockNum    int64   `json:"blockNum"`
-		Speed       float64 `json:"speed"`
-		SafeLowWait float64 `json:"safeLowWait"`
-		AvgWait     float64 `json:"avgWait"`
-		FastWait    float64 `json:"fastWait"`
-		FastestWait float64 `json:"fastestWait"`
-	}
-	gresp, body, errs := gorequest.New().
-		Get("https://ethgasstation.info/api/ethgasAPI.json").
-		Timeout(time.Second * 120).
-		End()
-	if errs != nil {
-		mcommon.Log.Errorf("err: [%T] %s", errs[0], errs[0].Error())
-		return
-	}
-	if gresp.StatusCode != http.StatusOK {
-		// 状态错误
-		mcommon.Log.Errorf("req status error: %d", gresp.StatusCode)
-		return
-	}
-	var resp StRespGasPrice
-	err = json.Unmarshal([]byte(body), &resp)
+	ethGasPrice, err := heth.GetGasPrice(context.Background())
 	if err != nil {
 		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
 		return
 	}
-	ethToUserGasPrice := resp.Fast * int64(math.Pow10(8))
-	ethToColdGasPrice := resp.Average * int64(math.Pow10(8))
+	ethToUserGasPrice := ethGasPrice.ToUser
+	ethToColdGasPrice := ethGasPrice.ToCold
 	type BtcStRespGasPrice struct {
 		FastestFee  int64 `json:"fastestFee"`
 		HalfHourFee int64 `json:"halfHourFee"`
 		HourFee     int64 `json:"hourFee"`
 	}
-	gresp, body, errs = gorequest.New().
+	gresp, body, errs := gorequest.New().
 		Get("https://bitcoinfees.earn.com/api/v1/fees/recommended").
 		Timeout(time.Second * 120).
 		End()
@@ -315,6 +288,11 @@ func main() {
 			K: "erc20_seek_num",
 			V: ethRPCBlockNum,
 		},
+		{
+			// nft blocknum
+			K: "nft_seek_num",
+			V: ethRPCBlockNum,
+		},
 		{
 			// btc blocknum
 			K: "btc_seek_num",
@@ -0,0 +1,137 @@
+// 查看和单次运行定时任务
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/hjob"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/moremorefun/mcommon"
+)
+
+const timeLayout = "2006-01-02 15:04:05"
+
+func main() {
+	// 读取运行参数
+	var action = flag.String("a", "list", "操作 list 列出任务及配置 | run 运行一次任务 | history 查看运行记录")
+	var name = flag.String("n", "", "任务名称")
+	var limit = flag.Int64("limit", 20, "运行记录数量")
+	var isErrOnly = flag.Bool("err", false, "只查看有错误的运行记录")
+	var isHideSkipped = flag.Bool("ran", false, "不显示因未获取到锁跳过的运行记录")
+	var h = flag.Bool("h", false, "help message")
+	flag.Parse()
+	if *h {
+		flag.Usage()
+		return
+	}
+
+	switch *action {
+	case "list":
+		xenv.EnvCreate()
+		defer xenv.EnvDestroy()
+
+		err := hjob.InitChainJobs(context.Background())
+		if err != nil {
+			mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+		}
+		configMap, err := hjob.GetJobConfigMap(
+			context.Background(),
+			xenv.DbCon,
+		)
+		if err != nil {
+			mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+		}
+		fmt.Printf("%-24s %-8s %-12s %-8s %s\n", "name", "chain", "spec", "enable", "timeout")
+		for _, job := range hjob.GetJobs() {
+			config := configMap[job.Name]
+			fmt.Printf(
+				"%-24s %-8s %-12s %-8t %d\n",
+				job.Name,
+				job.Chain,
+				config.Spec,
+				config.Enable && hjob.IsChainEnable(job.Chain),
+				config.TimeoutSeconds,
+			)
+		}
+	case "run":
+		xenv.EnvCreate()
+		defer xenv.EnvDestroy()
+
+		err := hjob.InitChainJobs(context.Background())
+		if err != nil {
+			mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+		}
+		job := hjob.GetJob(*name)
+		if job == nil {
+			flag.Usage()
+			return
+		}
+		if !hjob.IsChainEnable(job.Chain) {
+			mcommon.Log.Fatalf("chain not enable: %s", job.Chain)
+		}
+		configMap, err := hjob.GetJobConfigMap(
+			context.Background(),
+			xenv.DbCon,
+		)
+		if err != nil {
+			mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+		}
+		// 收到退出信号时取消任务
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+		go func() {
+			sig := <-sigCh
+			mcommon.Log.Infof("receive signal %s, cancel job", sig)
+			cancel()
+		}()
+		hjob.RunJob(ctx, job, configMap[job.Name])
+	case "history":
+		xenv.EnvCreate()
+		defer xenv.EnvDestroy()
+
+		runRows, err := app.SQLSelectTAppJobRunColByFilter(
+			context.Background(),
+			xenv.DbCon,
+			model.DBColTAppJobRunAll,
+			&app.StJobRunFilter{
+				Name:          *name,
+				IsHideSkipped: *isHideSkipped,
+				IsErrOnly:     *isErrOnly,
+				Limit:         *limit,
+			},
+		)
+		if err != nil {
+			mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+		}
+		fmt.Printf("%-24s %-19s %-8s %-8s %-7s %-32s %s\n", "name", "start_time", "cost_ms", "count", "skipped", "instance", "err_msg")
+		for _, runRow := range runRows {
+			errMsg := strings.ReplaceAll(runRow.ErrMsg, "\n", " ")
+			if len(errMsg) > 80 {
+				errMsg = errMsg[:80] + "..."
+			}
+			fmt.Printf(
+				"%-24s %-19s %-8d %-8d %-7t %-32s %s\n",
+				runRow.Name,
+				time.Unix(runRow.StartTime, 0).Format(timeLayout),
+				runRow.CostMs,
+				runRow.HandleCount,
+				runRow.LockSkipped == 1,
+				runRow.Instance,
+				errMsg,
+			)
+		}
+	default:
+		flag.Usage()
+	}
+}
@@ -0,0 +1,183 @@
+// 查询和重发通知
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/heth"
+	"go-dc-wallet/hnotify"
+	"go-dc-wallet/xenv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moremorefun/mcommon"
+)
+
+const timeLayout = "2006-01-02 15:04:05"
+
+func main() {
+	// 读取运行参数
+	var action = flag.String("a", "list", "操作 list|show|replay")
+	var productID = flag.Int64("product", 0, "产品id")
+	var status = flag.Int64("status", -1, "通知状态 0 待发送 1 发送失败 2 发送成功")
+	var itemType = flag.Int64("item_type", 0, "关联类型 1 充币 2 提币")
+	var itemID = flag.Int64("item_id", 0, "关联id")
+	var notifyType = flag.Int64("notify_type", 0, "通知类型")
+	var startTime = flag.String("start", "", "开始时间 2006-01-02 15:04:05")
+	var endTime = flag.String("end", "", "结束时间 2006-01-02 15:04:05")
+	var limit = flag.Int64("limit", 50, "数量")
+	var ids = flag.String("ids", "", "通知id，多个以逗号分隔")
+	var mode = flag.String("mode", hnotify.ReplayModeOriginal, "重发方式 original|resign|regenerate")
+	var h = flag.Bool("h", false, "help message")
+	flag.Parse()
+	if *h {
+		flag.Usage()
+		return
+	}
+
+	switch *action {
+	case "list":
+		filter := &app.StNotifyFilter{
+			ProductID:    *productID,
+			HandleStatus: *status,
+			ItemType:     *itemType,
+			ItemID:       *itemID,
+			NotifyType:   *notifyType,
+			Limit:        *limit,
+		}
+		var err error
+		filter.StartTime, err = parseTime(*startTime)
+		if err != nil {
+			mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+		}
+		filter.EndTime, err = parseTime(*endTime)
+		if err != nil {
+			mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+		}
+		xenv.EnvCreate()
+		defer xenv.EnvDestroy()
+
+		notifyRows, err := hnotify.List(
+			context.Background(),
+			xenv.DbCon,
+			filter,
+		)
+		if err != nil {
+			mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+		}
+		fmt.Printf("%-8s %-8s %-6s %-10s %-6s %-8s %-6s %-19s %s\n", "id", "product", "item", "item_id", "type", "symbol", "status", "update_time", "handle_msg")
+		for _, notifyRow := range notifyRows {
+			handleMsg := strings.ReplaceAll(notifyRow.HandleMsg, "\n", " ")
+			if len(handleMsg) > 60 {
+				handleMsg = handleMsg[:60] + "..."
+			}
+			fmt.Printf(
+				"%-8d %-8d %-6d %-10d %-6d %-8s %-6d %-19s %s\n",
+				notifyRow.ID,
+				notifyRow.ProductID,
+				notifyRow.ItemType,
+				notifyRow.ItemID,
+				notifyRow.NotifyType,
+				notifyRow.TokenSymbol,
+				notifyRow.HandleStatus,
+				time.Unix(notifyRow.UpdateTime, 0).Format(timeLayout),
+				handleMsg,
+			)
+		}
+	case "show":
+		notifyIDs, err := parseIDs(*ids)
+		if err != nil || len(notifyIDs) == 0 {
+			flag.Usage()
+			return
+		}
+		xenv.EnvCreate()
+		defer xenv.EnvDestroy()
+
+		for _, notifyID := range notifyIDs {
+			notifyRows, err := hnotify.List(
+				context.Background(),
+				xenv.DbCon,
+				&app.StNotifyFilter{
+					ID:           notifyID,
+					HandleStatus: -1,
+				},
+			)
+			if err != nil {
+				mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+			}
+			if len(notifyRows) == 0 {
+				fmt.Printf("no notify: %d\n\n", notifyID)
+				continue
+			}
+			notifyRow := notifyRows[0]
+			fmt.Printf("id:          %d\n", notifyRow.ID)
+			fmt.Printf("product:     %d\n", notifyRow.ProductID)
+			fmt.Printf("item:        %d %d\n", notifyRow.ItemType, notifyRow.ItemID)
+			fmt.Printf("notify_type: %d\n", notifyRow.NotifyType)
+			fmt.Printf("symbol:      %s\n", notifyRow.TokenSymbol)
+			fmt.Printf("status:      %d\n", notifyRow.HandleStatus)
+			fmt.Printf("create_time: %s\n", time.Unix(notifyRow.CreateTime, 0).Format(timeLayout))
+			fmt.Printf("update_time: %s\n", time.Unix(notifyRow.UpdateTime, 0).Format(timeLayout))
+			fmt.Printf("url:         %s\n", notifyRow.URL)
+			fmt.Printf("msg:         %s\n", notifyRow.Msg)
+			fmt.Printf("handle_msg:  %s\n\n", notifyRow.HandleMsg)
+		}
+	case "replay":
+		notifyIDs, err := parseIDs(*ids)
+		if err != nil || len(notifyIDs) == 0 || !hnotify.IsReplayMode(*mode) {
+			flag.Usage()
+			return
+		}
+		xenv.EnvCreate()
+		defer xenv.EnvDestroy()
+
+		// 重新生成通知时需要获取其他evm链的高度
+		err = heth.InitChains(context.Background())
+		if err != nil {
+			mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+		}
+		count, err := hnotify.Replay(
+			context.Background(),
+			xenv.DbCon,
+			notifyIDs,
+			*mode,
+		)
+		if err != nil {
+			mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+		}
+		fmt.Printf("replay %d notify with mode %s\n", count, *mode)
+	default:
+		flag.Usage()
+	}
+}
+
+func parseTime(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	t, err := time.ParseInLocation(timeLayout, s, time.Local)
+	if err != nil {
+		return 0, err
+	}
+	return t.Unix(), nil
+}
+
+func parseIDs(s string) ([]int64, error) {
+	var ids []int64
+	for _, idStr := range strings.Split(s, ",") {
+		idStr = strings.TrimSpace(idStr)
+		if idStr == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -1,14 +0,0 @@
-// 创建地址
-package main
-
-import (
-	"go-dc-wallet/hbtc"
-	"go-dc-wallet/xenv"
-)
-
-func main() {
-	xenv.EnvCreate()
-	defer xenv.EnvDestroy()
-
-	hbtc.CheckAddressFree()
-}
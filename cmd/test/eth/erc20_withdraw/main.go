@@ -1,14 +0,0 @@
-// erc20 提币
-package main
-
-import (
-	"go-dc-wallet/heth"
-	"go-dc-wallet/xenv"
-)
-
-func main() {
-	xenv.EnvCreate()
-	defer xenv.EnvDestroy()
-
-	heth.CheckErc20Withdraw()
-}
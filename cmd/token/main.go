@@ -0,0 +1,110 @@
+// 查看和添加 erc20 token 配置
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/heth"
+	"go-dc-wallet/xenv"
+
+	"github.com/moremorefun/mcommon"
+)
+
+func main() {
+	// 读取运行参数
+	var action = flag.String("a", "info", "操作 info 查看合约信息 | add 添加token配置 | check 校验已配置的token")
+	var address = flag.String("address", "", "token 合约地址")
+	var symbol = flag.String("symbol", "", "token_symbol，为空时使用 erc20_ 加合约符号")
+	var cold = flag.String("cold", "", "冷钱包地址，添加token时必填")
+	var hot = flag.String("hot", "", "热钱包地址，多个以逗号分隔")
+	var orgMin = flag.String("org_min", "0", "零钱整理最小数额")
+	var isOrgReal = flag.Bool("org_real", false, "整理时以链上余额为准，用于转账扣费和rebase的token")
+	var chain = flag.String("chain", ethclient.DefaultChain, "token 所属的evm链，t_app_config_chain.name")
+	var h = flag.Bool("h", false, "help message")
+	flag.Parse()
+	if *h {
+		flag.Usage()
+		return
+	}
+
+	ctx := ethclient.WithChain(context.Background(), *chain)
+	switch *action {
+	case "info":
+		if *address == "" {
+			flag.Usage()
+			return
+		}
+		xenv.EnvCreate()
+		defer xenv.EnvDestroy()
+		initChains()
+
+		info, err := heth.GetTokenInfo(
+			ctx,
+			*address,
+		)
+		if err != nil {
+			mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+		}
+		printTokenInfo(info)
+	case "add":
+		if *address == "" || *cold == "" {
+			flag.Usage()
+			return
+		}
+		xenv.EnvCreate()
+		defer xenv.EnvDestroy()
+		initChains()
+
+		orgRealBalance := int64(0)
+		if *isOrgReal {
+			orgRealBalance = 1
+		}
+
+		tokenRow, info, err := heth.AddToken(
+			ctx,
+			&heth.StTokenAdd{
+				TokenAddress:   *address,
+				TokenSymbol:    *symbol,
+				ColdAddress:    *cold,
+				HotAddress:     *hot,
+				OrgMinBalance:  *orgMin,
+				OrgRealBalance: orgRealBalance,
+			},
+		)
+		if err != nil {
+			mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+		}
+		printTokenInfo(info)
+		fmt.Printf("add token id: %d symbol: %s decimals: %d\n", tokenRow.ID, tokenRow.TokenSymbol, tokenRow.TokenDecimals)
+	case "check":
+		xenv.EnvCreate()
+		defer xenv.EnvDestroy()
+		initChains()
+
+		err := heth.CheckTokens(ctx)
+		if err != nil {
+			mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+		}
+		fmt.Println("token config ok")
+	default:
+		flag.Usage()
+	}
+}
+
+// initChains 加载其他evm链的节点
+func initChains() {
+	err := heth.InitChains(context.Background())
+	if err != nil {
+		mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+	}
+}
+
+func printTokenInfo(info *ethclient.StTokenInfo) {
+	fmt.Printf("address:      %s\n", info.Address)
+	fmt.Printf("name:         %s\n", info.Name)
+	fmt.Printf("symbol:       %s\n", info.Symbol)
+	fmt.Printf("decimals:     %d\n", info.Decimals)
+	fmt.Printf("total_supply: %s\n", info.TotalSupply.String())
+}
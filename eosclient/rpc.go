@@ -1,8 +1,10 @@
 package eosclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -234,25 +236,44 @@ func InitClient(uri string) {
 	rpcURI = uri
 }
 
-func doReq(funURI string, arqs interface{}, resp interface{}) error {
-	_, body, errs := gorequest.New().Timeout(time.Minute * 5).Post(rpcURI + funURI).Send(arqs).EndBytes()
-	if errs != nil {
-		return errs[0]
+func doReq(ctx context.Context, funURI string, arqs interface{}, resp interface{}) error {
+	agent := gorequest.New().Timeout(time.Minute * 5).Post(rpcURI + funURI).Send(arqs)
+	body, err := doAgentReq(ctx, agent)
+	if err != nil {
+		return err
 	}
-	err := json.Unmarshal(body, resp)
+	err = json.Unmarshal(body, resp)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// doAgentReq 发送请求，ctx 取消时中断请求
+func doAgentReq(ctx context.Context, agent *gorequest.SuperAgent) ([]byte, error) {
+	if len(agent.Errors) > 0 {
+		return nil, agent.Errors[0]
+	}
+	req, err := agent.MakeRequest()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := agent.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return ioutil.ReadAll(resp.Body)
+}
+
 // RPCChainGetInfo 获取链信息
-func RPCChainGetInfo() (*StChainGetInfo, error) {
+func RPCChainGetInfo(ctx context.Context) (*StChainGetInfo, error) {
 	resp := struct {
 		StRPCRespError
 		StChainGetInfo
 	}{}
 	err := doReq(
+		ctx,
 		"/v1/chain/get_info",
 		nil,
 		&resp,
@@ -267,12 +288,13 @@ func RPCChainGetInfo() (*StChainGetInfo, error) {
 }
 
 // RPCChainGetAccount 获取账户信息
-func RPCChainGetAccount(account string) (*StAccount, error) {
+func RPCChainGetAccount(ctx context.Context, account string) (*StAccount, error) {
 	resp := struct {
 		StRPCRespError
 		StAccount
 	}{}
 	err := doReq(
+		ctx,
 		"/v1/chain/get_account",
 		gin.H{
 			"account_name": account,
@@ -289,12 +311,13 @@ func RPCChainGetAccount(account string) (*StAccount, error) {
 }
 
 // RPCChainGetBlock 获取链信息
-func RPCChainGetBlock(blockNum int64) (*StBlock, error) {
+func RPCChainGetBlock(ctx context.Context, blockNum int64) (*StBlock, error) {
 	resp := struct {
 		StRPCRespError
 		StBlock
 	}{}
 	err := doReq(
+		ctx,
 		"/v1/chain/get_block",
 		gin.H{
 			"block_num_or_id": blockNum,
@@ -311,12 +334,13 @@ func RPCChainGetBlock(blockNum int64) (*StBlock, error) {
 }
 
 // RPCChainPushTransaction 推送交易
-func RPCChainPushTransaction(arg StPushTransactionArg) (*StPushTransaction, error) {
+func RPCChainPushTransaction(ctx context.Context, arg StPushTransactionArg) (*StPushTransaction, error) {
 	resp := struct {
 		StRPCRespError
 		StPushTransaction
 	}{}
 	err := doReq(
+		ctx,
 		"/v1/chain/push_transaction",
 		arg,
 		&resp,
@@ -330,13 +354,14 @@ func RPCChainPushTransaction(arg StPushTransactionArg) (*StPushTransaction, erro
 	return &resp.StPushTransaction, nil
 }
 
-// RPCChainPushTransaction 推送交易
-func RPCHistoryGetTransaction(id string) (*StPushTransaction, error) {
+// RPCHistoryGetTransaction 查询交易
+func RPCHistoryGetTransaction(ctx context.Context, id string) (*StGetTransaction, error) {
 	resp := struct {
 		StRPCRespError
-		StPushTransaction
+		StGetTransaction
 	}{}
 	err := doReq(
+		ctx,
 		"/v1/history/get_transaction",
 		gin.H{
 			"id": id,
@@ -349,5 +374,5 @@ func RPCHistoryGetTransaction(id string) (*StPushTransaction, error) {
 	if resp.Code != 0 {
 		return nil, &(resp.StRPCRespError)
 	}
-	return &resp.StPushTransaction, nil
+	return &resp.StGetTransaction, nil
 }
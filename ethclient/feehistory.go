@@ -0,0 +1,27 @@
+package ethclient
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StFeeHistory eth_feeHistory 返回数据
+type StFeeHistory struct {
+	OldestBlock *hexutil.Big `json:"oldestBlock"`
+	// Reward 各区块按分位数的小费
+	Reward [][]*hexutil.Big `json:"reward"`
+	// BaseFee 各区块的 baseFee，最后一个为下一区块的 baseFee
+	BaseFee      []*hexutil.Big `json:"baseFeePerGas"`
+	GasUsedRatio []float64      `json:"gasUsedRatio"`
+}
+
+// FeeHistory 获取最近 blockCount 个区块的手续费数据
+func (ec *Client) FeeHistory(ctx context.Context, blockCount int64, rewardPercentiles []float64) (*StFeeHistory, error) {
+	var resp StFeeHistory
+	err := ec.c.CallContext(ctx, &resp, "eth_feeHistory", hexutil.Uint64(blockCount), "latest", rewardPercentiles)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,42 @@
+package ethclient
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ForwarderFactoryABI forwarder工厂合约的 abi，
+// forwarder 地址由工厂合约通过 create2 根据 salt 生成，整理时按需部署并转出余额
+const ForwarderFactoryABI = "[{\"constant\":true,\"inputs\":[{\"name\":\"salt\",\"type\":\"bytes32\"}],\"name\":\"computeAddress\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"to\",\"type\":\"address\"},{\"name\":\"salts\",\"type\":\"bytes32[]\"}],\"name\":\"flushEther\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"token\",\"type\":\"address\"},{\"name\":\"to\",\"type\":\"address\"},{\"name\":\"salts\",\"type\":\"bytes32[]\"}],\"name\":\"flushTokens\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// RPCForwarderAddress 获取工厂合约根据 salt 生成的forwarder地址
+func RPCForwarderAddress(ctx context.Context, factoryAddress string, salt common.Hash) (string, error) {
+	contractAbi, err := abi.JSON(strings.NewReader(ForwarderFactoryABI))
+	if err != nil {
+		return "", err
+	}
+	input, err := contractAbi.Pack("computeAddress", salt)
+	if err != nil {
+		return "", err
+	}
+	output, err := RPCCallContract(ctx, "", factoryAddress, nil, input)
+	if err != nil {
+		return "", err
+	}
+	results, err := contractAbi.Unpack("computeAddress", output)
+	if err != nil {
+		return "", err
+	}
+	if len(results) != 1 {
+		return "", errors.New("error computeAddress result")
+	}
+	address, ok := results[0].(common.Address)
+	if !ok {
+		return "", errors.New("error computeAddress result")
+	}
+	return strings.ToLower(address.Hex()), nil
+}
@@ -0,0 +1,73 @@
+package ethclient
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Erc721ABI erc721 转账事件和转账、查询持有人方法的 abi
+const Erc721ABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"constant\":false,\"inputs\":[{\"name\":\"from\",\"type\":\"address\"},{\"name\":\"to\",\"type\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// Erc1155ABI erc1155 转账事件和转账、查询余额方法的 abi
+const Erc1155ABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":false,\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"TransferSingle\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"name\":\"ids\",\"type\":\"uint256[]\"},{\"indexed\":false,\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"TransferBatch\",\"type\":\"event\"},{\"constant\":false,\"inputs\":[{\"name\":\"from\",\"type\":\"address\"},{\"name\":\"to\",\"type\":\"address\"},{\"name\":\"id\",\"type\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"account\",\"type\":\"address\"},{\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"balanceOf\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// RPCNftOwnerOf 获取erc721 token的持有地址
+func RPCNftOwnerOf(ctx context.Context, tokenAddress string, tokenID *big.Int) (string, error) {
+	contractAbi, err := abi.JSON(strings.NewReader(Erc721ABI))
+	if err != nil {
+		return "", err
+	}
+	input, err := contractAbi.Pack("ownerOf", tokenID)
+	if err != nil {
+		return "", err
+	}
+	output, err := RPCCallContract(ctx, "", tokenAddress, nil, input)
+	if err != nil {
+		return "", err
+	}
+	results, err := contractAbi.Unpack("ownerOf", output)
+	if err != nil {
+		return "", err
+	}
+	if len(results) != 1 {
+		return "", errors.New("error ownerOf result")
+	}
+	owner, ok := results[0].(common.Address)
+	if !ok {
+		return "", errors.New("error ownerOf result")
+	}
+	return strings.ToLower(owner.Hex()), nil
+}
+
+// RPCNft1155Balance 获取erc1155 token的余额
+func RPCNft1155Balance(ctx context.Context, tokenAddress string, address string, tokenID *big.Int) (*big.Int, error) {
+	contractAbi, err := abi.JSON(strings.NewReader(Erc1155ABI))
+	if err != nil {
+		return nil, err
+	}
+	input, err := contractAbi.Pack("balanceOf", common.HexToAddress(address), tokenID)
+	if err != nil {
+		return nil, err
+	}
+	output, err := RPCCallContract(ctx, "", tokenAddress, nil, input)
+	if err != nil {
+		return nil, err
+	}
+	results, err := contractAbi.Unpack("balanceOf", output)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, errors.New("error balanceOf result")
+	}
+	balance, ok := results[0].(*big.Int)
+	if !ok {
+		return nil, errors.New("error balanceOf result")
+	}
+	return balance, nil
+}
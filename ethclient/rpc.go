@@ -2,7 +2,9 @@ package ethclient
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/moremorefun/mcommon"
@@ -16,8 +18,18 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 )
 
+// DefaultChain 默认链名称，使用 InitClient 初始化的节点
+const DefaultChain = "eth"
+
+type ctxKeyChain struct{}
+
 var client *Client
-var networkID int64
+
+// chainClients 其他evm链的接口对象 name => *Client
+var chainClients sync.Map
+
+// networkIDs 链id缓存 name => int64
+var networkIDs sync.Map
 
 // InitClient 初始化接口对象
 func InitClient(uri string) {
@@ -28,9 +40,66 @@ func InitClient(uri string) {
 	}
 }
 
+// InitChainClient 初始化其他evm链的接口对象，
+// chainID 大于0时校验节点的链id并用于交易签名
+func InitChainClient(name string, uri string, chainID int64) error {
+	c, err := Dial(uri)
+	if err != nil {
+		return err
+	}
+	if chainID > 0 {
+		resp, err := c.ChainID(context.Background())
+		if err != nil {
+			c.Close()
+			return err
+		}
+		if resp.Int64() != chainID {
+			c.Close()
+			return fmt.Errorf("chain %s id not match, config %d, node %d", name, chainID, resp.Int64())
+		}
+		networkIDs.Store(name, chainID)
+	}
+	chainClients.Store(name, c)
+	return nil
+}
+
+// WithChain 指定 ctx 所属的链，rpc 调用使用该链的节点
+func WithChain(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, ctxKeyChain{}, name)
+}
+
+// ChainOf 获取 ctx 所属的链，未指定时为默认链
+func ChainOf(ctx context.Context) string {
+	name, ok := ctx.Value(ctxKeyChain{}).(string)
+	if !ok || name == "" {
+		return DefaultChain
+	}
+	return name
+}
+
+// getClient 获取 ctx 所属链的接口对象
+func getClient(ctx context.Context) (*Client, error) {
+	name := ChainOf(ctx)
+	if name == DefaultChain {
+		if client == nil {
+			return nil, fmt.Errorf("eth client not init")
+		}
+		return client, nil
+	}
+	c, ok := chainClients.Load(name)
+	if !ok {
+		return nil, fmt.Errorf("eth client not init: %s", name)
+	}
+	return c.(*Client), nil
+}
+
 // RPCBlockNumber 获取最新的block number
 func RPCBlockNumber(ctx context.Context) (int64, error) {
-	blockNum, err := client.GetBlockNumber(ctx)
+	ec, err := getClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+	blockNum, err := ec.GetBlockNumber(ctx)
 	if nil != err {
 		return 0, err
 	}
@@ -39,7 +108,76 @@ func RPCBlockNumber(ctx context.Context) (int64, error) {
 
 // RPCBlockByNum 获取block信息
 func RPCBlockByNum(ctx context.Context, blockNum int64) (*types.Block, error) {
-	resp, err := client.BlockByNumber(ctx, big.NewInt(blockNum))
+	ec, err := getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := ec.BlockByNumber(ctx, big.NewInt(blockNum))
+	if nil != err {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RPCHeaderByNum 获取区块头
+func RPCHeaderByNum(ctx context.Context, blockNum int64) (*types.Header, error) {
+	ec, err := getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := ec.HeaderByNumber(ctx, big.NewInt(blockNum))
+	if nil != err {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RPCHeaderLatest 获取最新区块头
+func RPCHeaderLatest(ctx context.Context) (*types.Header, error) {
+	ec, err := getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := ec.HeaderByNumber(ctx, nil)
+	if nil != err {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RPCSuggestGasTipCap 获取建议的 maxPriorityFeePerGas
+func RPCSuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	ec, err := getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := ec.SuggestGasTipCap(ctx)
+	if nil != err {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RPCFeeHistory 获取最近区块的手续费数据
+func RPCFeeHistory(ctx context.Context, blockCount int64, rewardPercentiles []float64) (*StFeeHistory, error) {
+	ec, err := getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := ec.FeeHistory(ctx, blockCount, rewardPercentiles)
+	if nil != err {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RPCTraceBlockTransfers 获取区块中的内部转账
+func RPCTraceBlockTransfers(ctx context.Context, method string, blockNum int64, txHashes []string) ([]*StInternalTransfer, error) {
+	ec, err := getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := ec.TraceBlockTransfers(ctx, method, blockNum, txHashes)
 	if nil != err {
 		return nil, err
 	}
@@ -48,7 +186,11 @@ func RPCBlockByNum(ctx context.Context, blockNum int64) (*types.Block, error) {
 
 // RPCNonceAt 获取nonce
 func RPCNonceAt(ctx context.Context, address string) (int64, error) {
-	count, err := client.NonceAt(
+	ec, err := getClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := ec.NonceAt(
 		ctx,
 		common.HexToAddress(address),
 		nil,
@@ -59,22 +201,47 @@ func RPCNonceAt(ctx context.Context, address string) (int64, error) {
 	return int64(count), nil
 }
 
+// RPCPendingNonceAt 获取包含节点交易池中交易的nonce
+func RPCPendingNonceAt(ctx context.Context, address string) (int64, error) {
+	ec, err := getClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := ec.PendingNonceAt(
+		ctx,
+		common.HexToAddress(address),
+	)
+	if nil != err {
+		return 0, err
+	}
+	return int64(count), nil
+}
+
 // RPCNetworkID 获取block信息
 func RPCNetworkID(ctx context.Context) (int64, error) {
-	if networkID != 0 {
-		return networkID, nil
+	name := ChainOf(ctx)
+	if v, ok := networkIDs.Load(name); ok {
+		return v.(int64), nil
 	}
-	resp, err := client.NetworkID(ctx)
+	ec, err := getClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := ec.NetworkID(ctx)
 	if nil != err {
 		return 0, err
 	}
-	networkID = resp.Int64()
+	networkIDs.Store(name, resp.Int64())
 	return resp.Int64(), nil
 }
 
 // RPCSendTransaction 发送交易
 func RPCSendTransaction(ctx context.Context, tx *types.Transaction) error {
-	err := client.SendTransaction(
+	ec, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	err = ec.SendTransaction(
 		ctx,
 		tx,
 	)
@@ -86,8 +253,12 @@ func RPCSendTransaction(ctx context.Context, tx *types.Transaction) error {
 
 // RPCTransactionByHash 确认交易是否打包完成
 func RPCTransactionByHash(ctx context.Context, txHashStr string) (*types.Transaction, error) {
+	ec, err := getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
 	txHash := common.HexToHash(txHashStr)
-	tx, isPending, err := client.TransactionByHash(ctx, txHash)
+	tx, isPending, err := ec.TransactionByHash(ctx, txHash)
 	if err != nil {
 		return nil, err
 	}
@@ -99,8 +270,12 @@ func RPCTransactionByHash(ctx context.Context, txHashStr string) (*types.Transac
 
 // RPCTransactionReceipt 确认交易是否打包完成
 func RPCTransactionReceipt(ctx context.Context, txHashStr string) (*types.Receipt, error) {
+	ec, err := getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
 	txHash := common.HexToHash(txHashStr)
-	tx, err := client.TransactionReceipt(ctx, txHash)
+	tx, err := ec.TransactionReceipt(ctx, txHash)
 	if err != nil {
 		return nil, err
 	}
@@ -109,15 +284,36 @@ func RPCTransactionReceipt(ctx context.Context, txHashStr string) (*types.Receip
 
 // RPCBalanceAt 获取余额
 func RPCBalanceAt(ctx context.Context, address string) (*big.Int, error) {
-	balance, err := client.BalanceAt(ctx, common.HexToAddress(address), nil)
+	ec, err := getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := ec.BalanceAt(ctx, common.HexToAddress(address), nil)
 	if nil != err {
 		return nil, err
 	}
 	return balance, nil
 }
 
+// RPCCodeAt 获取地址的合约代码，普通地址返回空
+func RPCCodeAt(ctx context.Context, address string) ([]byte, error) {
+	ec, err := getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	code, err := ec.CodeAt(ctx, common.HexToAddress(address), nil)
+	if nil != err {
+		return nil, err
+	}
+	return code, nil
+}
+
 // RPCFilterLogs 获取日志
 func RPCFilterLogs(ctx context.Context, startBlock int64, endBlock int64, contractAddresses []string, event abi.Event) ([]types.Log, error) {
+	ec, err := getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
 	var warpAddresses []common.Address
 	for _, contractAddress := range contractAddresses {
 		warpAddresses = append(warpAddresses, common.HexToAddress(contractAddress))
@@ -130,7 +326,7 @@ func RPCFilterLogs(ctx context.Context, startBlock int64, endBlock int64, contra
 			{event.ID},
 		},
 	}
-	logs, err := client.FilterLogs(ctx, query)
+	logs, err := ec.FilterLogs(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -139,8 +335,12 @@ func RPCFilterLogs(ctx context.Context, startBlock int64, endBlock int64, contra
 
 // RPCTokenBalance 获取token余额
 func RPCTokenBalance(ctx context.Context, tokenAddress string, address string) (*big.Int, error) {
+	ec, err := getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
 	tokenAddressHash := common.HexToAddress(tokenAddress)
-	instance, err := NewEth(tokenAddressHash, client)
+	instance, err := NewEth(tokenAddressHash, ec)
 	if err != nil {
 		return nil, err
 	}
@@ -150,3 +350,75 @@ func RPCTokenBalance(ctx context.Context, tokenAddress string, address string) (
 	}
 	return balance, nil
 }
+
+// RPCTokenAllowance 获取token授权额度
+func RPCTokenAllowance(ctx context.Context, tokenAddress string, owner string, spender string) (*big.Int, error) {
+	ec, err := getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	instance, err := NewEth(common.HexToAddress(tokenAddress), ec)
+	if err != nil {
+		return nil, err
+	}
+	allowance, err := instance.Allowance(&bind.CallOpts{Context: ctx}, common.HexToAddress(owner), common.HexToAddress(spender))
+	if err != nil {
+		return nil, err
+	}
+	return allowance, nil
+}
+
+// RPCCallContract 模拟执行合约调用，执行失败时返回错误
+func RPCCallContract(ctx context.Context, from string, to string, value *big.Int, data []byte) ([]byte, error) {
+	ec, err := getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	toAddress := common.HexToAddress(to)
+	return ec.CallContract(
+		ctx,
+		ethereum.CallMsg{
+			From:  common.HexToAddress(from),
+			To:    &toAddress,
+			Value: value,
+			Data:  data,
+		},
+		nil,
+	)
+}
+
+// RPCEstimateGas 估算交易需要的gas，执行失败时返回错误
+func RPCEstimateGas(ctx context.Context, from string, to string, value *big.Int, data []byte) (int64, error) {
+	ec, err := getClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+	toAddress := common.HexToAddress(to)
+	gas, err := ec.EstimateGas(
+		ctx,
+		ethereum.CallMsg{
+			From:  common.HexToAddress(from),
+			To:    &toAddress,
+			Value: value,
+			Data:  data,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return int64(gas), nil
+}
+
+// RPCSubscribeNewHead 通过websocket订阅新区块头，结束时需关闭返回的client
+func RPCSubscribeNewHead(ctx context.Context, wsURI string, ch chan<- *types.Header) (*Client, ethereum.Subscription, error) {
+	wsClient, err := DialContext(ctx, wsURI)
+	if err != nil {
+		return nil, nil, err
+	}
+	sub, err := wsClient.SubscribeNewHead(ctx, ch)
+	if err != nil {
+		wsClient.Close()
+		return nil, nil, err
+	}
+	return wsClient, sub, nil
+}
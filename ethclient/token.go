@@ -0,0 +1,85 @@
+package ethclient
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// StTokenInfo erc20 token 链上信息
+type StTokenInfo struct {
+	Address     string
+	Name        string
+	Symbol      string
+	Decimals    int64
+	TotalSupply *big.Int
+}
+
+// RPCTokenInfo 获取erc20 token的名称、符号、精度和发行量
+func RPCTokenInfo(ctx context.Context, tokenAddress string) (*StTokenInfo, error) {
+	ec, err := getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	instance, err := NewEthCaller(common.HexToAddress(tokenAddress), ec)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	decimals, err := instance.Decimals(opts)
+	if err != nil {
+		return nil, err
+	}
+	totalSupply, err := instance.TotalSupply(opts)
+	if err != nil {
+		return nil, err
+	}
+	name, err := instance.Name(opts)
+	if err != nil {
+		// 部分早期token的name和symbol返回bytes32
+		name, err = rpcTokenBytes32(ctx, tokenAddress, "name")
+		if err != nil {
+			return nil, err
+		}
+	}
+	symbol, err := instance.Symbol(opts)
+	if err != nil {
+		symbol, err = rpcTokenBytes32(ctx, tokenAddress, "symbol")
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &StTokenInfo{
+		Address:     strings.ToLower(tokenAddress),
+		Name:        name,
+		Symbol:      symbol,
+		Decimals:    int64(decimals),
+		TotalSupply: totalSupply,
+	}, nil
+}
+
+// rpcTokenBytes32 获取返回bytes32的token字符串信息
+func rpcTokenBytes32(ctx context.Context, tokenAddress string, method string) (string, error) {
+	contractAbi, err := abi.JSON(strings.NewReader(EthABI))
+	if err != nil {
+		return "", err
+	}
+	input, err := contractAbi.Pack(method)
+	if err != nil {
+		return "", err
+	}
+	output, err := RPCCallContract(ctx, "", tokenAddress, nil, input)
+	if err != nil {
+		return "", err
+	}
+	if len(output) != 32 {
+		return "", errors.New("error " + method + " result")
+	}
+	return string(bytes.TrimRight(output, "\x00")), nil
+}
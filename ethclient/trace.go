@@ -0,0 +1,178 @@
+package ethclient
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// trace 接口类型
+const (
+	TraceMethodTraceBlock      = "trace_block"
+	TraceMethodDebugTraceBlock = "debug_traceBlockByNumber"
+)
+
+// StInternalTransfer 合约调用中的内部转账
+type StInternalTransfer struct {
+	TxHash string
+	// TraceAddress 调用在交易中的位置，例如 0_1
+	TraceAddress string
+	From         string
+	To           string
+	Value        *big.Int
+}
+
+// trace_block 返回数据
+type parityTrace struct {
+	Action struct {
+		CallType      string       `json:"callType"`
+		From          string       `json:"from"`
+		To            string       `json:"to"`
+		Value         *hexutil.Big `json:"value"`
+		Address       string       `json:"address"`
+		RefundAddress string       `json:"refundAddress"`
+		Balance       *hexutil.Big `json:"balance"`
+	} `json:"action"`
+	Error           string  `json:"error"`
+	TraceAddress    []int64 `json:"traceAddress"`
+	TransactionHash string  `json:"transactionHash"`
+	Type            string  `json:"type"`
+}
+
+// debug_traceBlockByNumber callTracer 返回数据
+type gethCallFrame struct {
+	Type  string           `json:"type"`
+	From  string           `json:"from"`
+	To    string           `json:"to"`
+	Value *hexutil.Big     `json:"value"`
+	Error string           `json:"error"`
+	Calls []*gethCallFrame `json:"calls"`
+}
+
+type gethTxTrace struct {
+	TxHash string         `json:"txHash"`
+	Result *gethCallFrame `json:"result"`
+}
+
+// traceAddressStr 调用位置转为字符串
+func traceAddressStr(traceAddress []int64) string {
+	var parts []string
+	for _, i := range traceAddress {
+		parts = append(parts, strconv.FormatInt(i, 10))
+	}
+	return strings.Join(parts, "_")
+}
+
+// isPositive 金额是否大于0
+func isPositive(v *hexutil.Big) bool {
+	return v != nil && v.ToInt().Sign() > 0
+}
+
+// TraceBlockTransfers 获取区块中的内部转账，不包含交易本身的转账，
+// 失败的调用及其子调用中的转账不计入
+func (ec *Client) TraceBlockTransfers(ctx context.Context, method string, blockNum int64, txHashes []string) ([]*StInternalTransfer, error) {
+	switch method {
+	case TraceMethodTraceBlock:
+		var traces []*parityTrace
+		err := ec.c.CallContext(ctx, &traces, method, hexutil.EncodeUint64(uint64(blockNum)))
+		if err != nil {
+			return nil, err
+		}
+		// 失败调用的位置
+		failedMap := make(map[string]bool)
+		for _, trace := range traces {
+			if trace.Error != "" {
+				failedMap[trace.TransactionHash+"-"+traceAddressStr(trace.TraceAddress)] = true
+			}
+		}
+		var transfers []*StInternalTransfer
+		for _, trace := range traces {
+			if len(trace.TraceAddress) == 0 {
+				continue
+			}
+			isFailed := false
+			for i := 0; i <= len(trace.TraceAddress); i++ {
+				if failedMap[trace.TransactionHash+"-"+traceAddressStr(trace.TraceAddress[:i])] {
+					isFailed = true
+					break
+				}
+			}
+			if isFailed {
+				continue
+			}
+			switch {
+			case trace.Type == "call" && trace.Action.CallType == "call" && isPositive(trace.Action.Value):
+				transfers = append(transfers, &StInternalTransfer{
+					TxHash:       trace.TransactionHash,
+					TraceAddress: traceAddressStr(trace.TraceAddress),
+					From:         trace.Action.From,
+					To:           trace.Action.To,
+					Value:        trace.Action.Value.ToInt(),
+				})
+			case trace.Type == "suicide" && isPositive(trace.Action.Balance):
+				transfers = append(transfers, &StInternalTransfer{
+					TxHash:       trace.TransactionHash,
+					TraceAddress: traceAddressStr(trace.TraceAddress),
+					From:         trace.Action.Address,
+					To:           trace.Action.RefundAddress,
+					Value:        trace.Action.Balance.ToInt(),
+				})
+			}
+		}
+		return transfers, nil
+	case TraceMethodDebugTraceBlock:
+		var txTraces []*gethTxTrace
+		err := ec.c.CallContext(
+			ctx,
+			&txTraces,
+			method,
+			hexutil.EncodeUint64(uint64(blockNum)),
+			map[string]interface{}{
+				"tracer": "callTracer",
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		var transfers []*StInternalTransfer
+		var walk func(txHash string, frame *gethCallFrame, traceAddress []int64)
+		walk = func(txHash string, frame *gethCallFrame, traceAddress []int64) {
+			if frame.Error != "" {
+				return
+			}
+			frameType := strings.ToUpper(frame.Type)
+			if len(traceAddress) > 0 && (frameType == "CALL" || frameType == "SELFDESTRUCT") && isPositive(frame.Value) {
+				transfers = append(transfers, &StInternalTransfer{
+					TxHash:       txHash,
+					TraceAddress: traceAddressStr(traceAddress),
+					From:         frame.From,
+					To:           frame.To,
+					Value:        frame.Value.ToInt(),
+				})
+			}
+			for i, call := range frame.Calls {
+				walk(txHash, call, append(append([]int64{}, traceAddress...), int64(i)))
+			}
+		}
+		for i, txTrace := range txTraces {
+			if txTrace.Result == nil {
+				continue
+			}
+			txHash := txTrace.TxHash
+			if txHash == "" {
+				// 旧版本节点不返回交易hash，按区块中的交易顺序对应
+				if i >= len(txHashes) {
+					return nil, fmt.Errorf("trace count %d more than tx count %d", len(txTraces), len(txHashes))
+				}
+				txHash = txHashes[i]
+			}
+			walk(txHash, txTrace.Result, nil)
+		}
+		return transfers, nil
+	}
+	return nil, fmt.Errorf("unknown trace method: %s", method)
+}
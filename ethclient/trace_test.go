@@ -0,0 +1,147 @@
+package ethclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// 模拟节点 trace 接口的返回数据
+const (
+	fakeTraceBlockResult = `[
+	{"type":"call","action":{"callType":"call","from":"0xa0","to":"0xc0","value":"0x1"},"traceAddress":[],"transactionHash":"0xaa"},
+	{"type":"call","action":{"callType":"call","from":"0xc0","to":"0xd1","value":"0x2"},"traceAddress":[0],"transactionHash":"0xaa"},
+	{"type":"call","action":{"callType":"call","from":"0xc0","to":"0xd2","value":"0x3"},"error":"Reverted","traceAddress":[1],"transactionHash":"0xaa"},
+	{"type":"call","action":{"callType":"call","from":"0xd2","to":"0xd3","value":"0x4"},"traceAddress":[1,0],"transactionHash":"0xaa"},
+	{"type":"call","action":{"callType":"call","from":"0xc0","to":"0xd4","value":"0x0"},"traceAddress":[2],"transactionHash":"0xaa"},
+	{"type":"call","action":{"callType":"delegatecall","from":"0xc0","to":"0xd5","value":"0x5"},"traceAddress":[3],"transactionHash":"0xaa"},
+	{"type":"call","action":{"callType":"call","from":"0xa1","to":"0xc1","value":"0x0"},"traceAddress":[],"transactionHash":"0xbb"},
+	{"type":"suicide","action":{"address":"0xc1","refundAddress":"0xd6","balance":"0x6"},"traceAddress":[0],"transactionHash":"0xbb"},
+	{"type":"call","action":{"callType":"call","from":"0xa2","to":"0xc2","value":"0x0"},"error":"Out of gas","traceAddress":[],"transactionHash":"0xcc"},
+	{"type":"call","action":{"callType":"call","from":"0xc2","to":"0xd7","value":"0x7"},"traceAddress":[0],"transactionHash":"0xcc"}
+]`
+	fakeDebugTraceResult = `[
+	{"txHash":"0xaa","result":{"type":"CALL","from":"0xa0","to":"0xc0","value":"0x1","calls":[
+		{"type":"CALL","from":"0xc0","to":"0xd1","value":"0x2"},
+		{"type":"CALL","from":"0xc0","to":"0xd2","value":"0x3","error":"execution reverted","calls":[
+			{"type":"CALL","from":"0xd2","to":"0xd3","value":"0x4"}
+		]},
+		{"type":"CALL","from":"0xc0","to":"0xd4","value":"0x0","calls":[
+			{"type":"CALL","from":"0xd4","to":"0xd8","value":"0x8"}
+		]},
+		{"type":"STATICCALL","from":"0xc0","to":"0xd5"}
+	]}},
+	{"result":{"type":"CALL","from":"0xa1","to":"0xc1","value":"0x0","calls":[
+		{"type":"SELFDESTRUCT","from":"0xc1","to":"0xd6","value":"0x6"}
+	]}},
+	{"txHash":"0xcc","result":{"type":"CALL","from":"0xa2","to":"0xc2","value":"0x0","error":"out of gas","calls":[
+		{"type":"CALL","from":"0xc2","to":"0xd7","value":"0x7"}
+	]}}
+]`
+)
+
+// newFakeTraceClient 启动模拟 JSON-RPC 节点，按方法名返回固定数据
+func newFakeTraceClient(t *testing.T, results map[string]string) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		result, ok := results[req.Method]
+		if !ok {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"error":{"code":-32601,"message":"method not found"}}`))
+			return
+		}
+		if req.Method == TraceMethodDebugTraceBlock {
+			var config struct {
+				Tracer string `json:"tracer"`
+			}
+			if len(req.Params) != 2 || json.Unmarshal(req.Params[1], &config) != nil || config.Tracer != "callTracer" {
+				t.Errorf("debug trace params error: %v", req.Params)
+			}
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":` + result + `}`))
+	}))
+	t.Cleanup(server.Close)
+	client, err := DialContext(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("dial fake rpc err: %s", err.Error())
+	}
+	t.Cleanup(client.Close)
+	return client
+}
+
+// checkTransfers 对比内部转账 txHash-traceAddress => from-to-value
+func checkTransfers(t *testing.T, transfers []*StInternalTransfer, expected []string) {
+	var actual []string
+	for _, transfer := range transfers {
+		actual = append(actual, transfer.TxHash+"-"+transfer.TraceAddress+" "+transfer.From+"->"+transfer.To+" "+transfer.Value.String())
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("transfers: %v, expected: %v", actual, expected)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("transfers: %v, expected: %v", actual, expected)
+		}
+	}
+}
+
+func TestTraceBlockTransfersTraceBlock(t *testing.T) {
+	client := newFakeTraceClient(t, map[string]string{
+		TraceMethodTraceBlock: fakeTraceBlockResult,
+	})
+	transfers, err := client.TraceBlockTransfers(context.Background(), TraceMethodTraceBlock, 100, nil)
+	if err != nil {
+		t.Fatalf("trace err: %s", err.Error())
+	}
+	// 不包含交易本身的转账、失败调用及其子调用、0金额和 delegatecall
+	checkTransfers(t, transfers, []string{
+		"0xaa-0 0xc0->0xd1 2",
+		"0xbb-0 0xc1->0xd6 6",
+	})
+}
+
+func TestTraceBlockTransfersDebugTrace(t *testing.T) {
+	client := newFakeTraceClient(t, map[string]string{
+		TraceMethodDebugTraceBlock: fakeDebugTraceResult,
+	})
+	transfers, err := client.TraceBlockTransfers(context.Background(), TraceMethodDebugTraceBlock, 100, []string{"0xaa", "0xbb", "0xcc"})
+	if err != nil {
+		t.Fatalf("trace err: %s", err.Error())
+	}
+	// 没有 txHash 的交易按区块中的顺序对应，0金额调用的子调用仍然计入
+	checkTransfers(t, transfers, []string{
+		"0xaa-0 0xc0->0xd1 2",
+		"0xaa-2_0 0xd4->0xd8 8",
+		"0xbb-0 0xc1->0xd6 6",
+	})
+}
+
+func TestTraceBlockTransfersDebugTraceTxCount(t *testing.T) {
+	client := newFakeTraceClient(t, map[string]string{
+		TraceMethodDebugTraceBlock: fakeDebugTraceResult,
+	})
+	// 缺少 txHash 的交易超出区块交易数时报错
+	_, err := client.TraceBlockTransfers(context.Background(), TraceMethodDebugTraceBlock, 100, []string{"0xaa"})
+	if err == nil {
+		t.Fatalf("trace count more than tx count should fail")
+	}
+}
+
+func TestTraceBlockTransfersUnknownMethod(t *testing.T) {
+	client := newFakeTraceClient(t, nil)
+	_, err := client.TraceBlockTransfers(context.Background(), "eth_unknown", 100, nil)
+	if err == nil {
+		t.Fatalf("unknown trace method should fail")
+	}
+}
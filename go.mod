@@ -3,12 +3,15 @@ module go-dc-wallet
 go 1.14
 
 require (
+	github.com/DATA-DOG/go-sqlmock v1.5.0
 	github.com/StackExchange/wmi v0.0.0-20190523213315-cbe66965904d // indirect
 	github.com/aristanetworks/goarista v0.0.0-20200812190859-4cb0e71f3c0e // indirect
+	github.com/aws/aws-sdk-go v1.25.48 // indirect
 	github.com/btcsuite/btcd v0.21.0-beta
 	github.com/btcsuite/btcutil v1.0.2
+	github.com/dvyukov/go-fuzz v0.0.0-20200318091601-be3528f3a813 // indirect
 	github.com/eoscanada/eos-go v0.9.0
-	github.com/ethereum/go-ethereum v1.9.25
+	github.com/ethereum/go-ethereum v1.10.16
 	github.com/fvbock/endless v0.0.0-20170109170031-447134032cb6
 	github.com/gin-contrib/zap v0.0.1
 	github.com/gin-gonic/gin v1.6.3
@@ -19,9 +22,9 @@ require (
 	github.com/parnurzeal/gorequest v0.2.16
 	github.com/robfig/cron/v3 v3.0.1
 	github.com/schemalex/schemalex v0.1.2-0.20201120132426-1265e8bfd186
-	github.com/shirou/gopsutil v2.20.7+incompatible // indirect
 	github.com/shopspring/decimal v1.2.0
 	github.com/tidwall/sjson v1.1.1 // indirect
 	github.com/timest/env v0.0.0-20180717050204-5fce78d35255
-	golang.org/x/crypto v0.0.0-20201016220609-9e8e0b390897 // indirect
+	go.uber.org/zap v1.16.0
+	golang.org/x/mobile v0.0.0-20200801112145-973feb4309de // indirect
 )
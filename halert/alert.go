@@ -0,0 +1,182 @@
+package halert
+
+import (
+	"context"
+	"go-dc-wallet/app"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/moremorefun/mcommon"
+	"github.com/parnurzeal/gorequest"
+)
+
+// 报警状态
+const (
+	StatusAlert    = "alert"
+	StatusResolved = "resolved"
+)
+
+// getAlertRow 获取报警记录
+func getAlertRow(ctx context.Context, k string) (*model.DBTAppAlert, error) {
+	return model.SQLGetTAppAlertColKV(
+		ctx,
+		xenv.DbCon,
+		model.DBColTAppAlertAll,
+		[]string{
+			model.DBColShortTAppAlertK,
+		},
+		[]interface{}{
+			k,
+		},
+	)
+}
+
+// sendWebhook 发送报警到配置的webhook地址
+func sendWebhook(ctx context.Context, k string, status string, msg string) error {
+	urlsValue, err := app.GetConfigStr(ctx, xenv.DbCon, "alert_webhook_urls")
+	if err != nil {
+		return err
+	}
+	reqObj := gin.H{
+		"key":    k,
+		"status": status,
+		"msg":    msg,
+		"time":   time.Now().Unix(),
+	}
+	for _, url := range strings.Split(urlsValue, ",") {
+		url = strings.TrimSpace(url)
+		if url == "" {
+			continue
+		}
+		gresp, _, errs := gorequest.New().
+			Post(url).
+			Timeout(time.Second * 30).
+			Send(reqObj).
+			End()
+		if errs != nil {
+			mcommon.Log.Errorf("err: [%T] %s", errs[0], errs[0].Error())
+			continue
+		}
+		if gresp.StatusCode != http.StatusOK {
+			mcommon.Log.Errorf("alert webhook status error: %s %d", url, gresp.StatusCode)
+		}
+	}
+	return nil
+}
+
+// Fire 触发报警，同一报警在重复间隔内只发送一次
+func Fire(ctx context.Context, k string, msg string) error {
+	mcommon.Log.Warnf("alert %s: %s", k, msg)
+	repeatSeconds, err := app.GetConfigInt(ctx, xenv.DbCon, "alert_repeat_seconds", 3600)
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	alertRow, err := getAlertRow(ctx, k)
+	if err != nil {
+		return err
+	}
+	if alertRow == nil {
+		alertRow = &model.DBTAppAlert{
+			K: k,
+		}
+	}
+	if alertRow.AlertTime > 0 && now-alertRow.AlertTime < repeatSeconds {
+		return nil
+	}
+	err = sendWebhook(ctx, k, StatusAlert, msg)
+	if err != nil {
+		return err
+	}
+	if len(msg) > 512 {
+		msg = msg[:512]
+	}
+	alertRow.AlertMsg = msg
+	alertRow.AlertTime = now
+	_, err = model.SQLCreateTAppAlertDuplicate(
+		ctx,
+		xenv.DbCon,
+		alertRow,
+		[]string{
+			model.DBColShortTAppAlertAlertMsg,
+			model.DBColShortTAppAlertAlertTime,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Resolve 解除报警，之前触发过时发送恢复通知
+func Resolve(ctx context.Context, k string) error {
+	alertRow, err := getAlertRow(ctx, k)
+	if err != nil {
+		return err
+	}
+	if alertRow == nil || alertRow.AlertTime == 0 {
+		return nil
+	}
+	mcommon.Log.Infof("alert resolved %s", k)
+	err = sendWebhook(ctx, k, StatusResolved, alertRow.AlertMsg)
+	if err != nil {
+		return err
+	}
+	alertRow.AlertTime = 0
+	_, err = model.SQLCreateTAppAlertDuplicate(
+		ctx,
+		xenv.DbCon,
+		alertRow,
+		[]string{
+			model.DBColShortTAppAlertAlertTime,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// FireOrResolve 根据条件触发或解除报警
+func FireOrResolve(ctx context.Context, k string, isFire bool, msg string) error {
+	if isFire {
+		return Fire(ctx, k, msg)
+	}
+	return Resolve(ctx, k)
+}
+
+// getStallSeconds 记录检测值，返回检测值未变化的秒数
+func getStallSeconds(ctx context.Context, k string, value int64) (int64, error) {
+	now := time.Now().Unix()
+	alertRow, err := getAlertRow(ctx, k)
+	if err != nil {
+		return 0, err
+	}
+	if alertRow != nil && alertRow.CheckValue == value && alertRow.CheckTime > 0 {
+		return now - alertRow.CheckTime, nil
+	}
+	if alertRow == nil {
+		alertRow = &model.DBTAppAlert{
+			K: k,
+		}
+	}
+	alertRow.CheckValue = value
+	alertRow.CheckTime = now
+	_, err = model.SQLCreateTAppAlertDuplicate(
+		ctx,
+		xenv.DbCon,
+		alertRow,
+		[]string{
+			model.DBColShortTAppAlertCheckValue,
+			model.DBColShortTAppAlertCheckTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return 0, nil
+}
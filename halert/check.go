@@ -0,0 +1,578 @@
+package halert
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/eosclient"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/hbtc"
+	"go-dc-wallet/heos"
+	"go-dc-wallet/heth"
+	"go-dc-wallet/model"
+	"go-dc-wallet/omniclient"
+	"go-dc-wallet/xenv"
+	"strings"
+	"time"
+
+	"github.com/moremorefun/mcommon"
+	"github.com/shopspring/decimal"
+)
+
+// CheckAlert 检测报警条件
+func CheckAlert(ctx context.Context) {
+	lockKey := "CheckAlert"
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
+		checks := []func(ctx context.Context) error{
+			checkFreeAddress,
+			checkLock,
+			checkSendStuck,
+			checkSeek,
+		}
+		if xenv.Cfg.BtcEnable {
+			checks = append(checks, checkBtcBalance, checkOmniBalance, checkBtcUxtoReorg)
+		}
+		if xenv.Cfg.EosEnable {
+			checks = append(checks, checkEosBalance)
+		}
+		for _, check := range checks {
+			err := check(ctx)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			}
+		}
+		// evm 链的检测使用所属链的节点和配置
+		for _, chain := range heth.GetChains() {
+			chainCtx := ethclient.WithChain(ctx, chain.Name)
+			for _, check := range []func(ctx context.Context) error{
+				checkEthBalance,
+				checkErc20Balance,
+				checkErc20FeeBalance,
+			} {
+				err := check(chainCtx)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				}
+			}
+		}
+	})
+}
+
+// getEnableSymbols 获取开启的币种
+func getEnableSymbols() []string {
+	var symbols []string
+	for _, chain := range heth.GetChains() {
+		symbols = append(symbols, chain.CoinSymbol)
+	}
+	if xenv.Cfg.BtcEnable {
+		symbols = append(symbols, hbtc.CoinSymbol)
+	}
+	if xenv.Cfg.EosEnable {
+		symbols = append(symbols, heos.CoinSymbol)
+	}
+	return symbols
+}
+
+// checkFreeAddress 检测剩余可用地址
+func checkFreeAddress(ctx context.Context) error {
+	minFreeCount, err := app.GetConfigInt(ctx, xenv.DbCon, "alert_min_free_address", 10)
+	if err != nil {
+		return err
+	}
+	for _, symbol := range getEnableSymbols() {
+		freeCount, err := app.SQLGetTAddressKeyFreeCount(
+			ctx,
+			xenv.DbCon,
+			symbol,
+		)
+		if err != nil {
+			return err
+		}
+		err = FireOrResolve(
+			ctx,
+			fmt.Sprintf("free_address_%s", symbol),
+			freeCount < minFreeCount,
+			fmt.Sprintf("free address of %s: %d < %d", symbol, freeCount, minFreeCount),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkLock 检测长时间持有或租约过期未释放的锁
+func checkLock(ctx context.Context) error {
+	holdSeconds, err := app.GetConfigInt(ctx, xenv.DbCon, "alert_lock_hold_seconds", 60*30)
+	if err != nil {
+		return err
+	}
+	lockRows, err := model.SQLSelectTAppLockColKV(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTAppLockK,
+			model.DBColTAppLockV,
+			model.DBColTAppLockOwner,
+			model.DBColTAppLockCreateTime,
+			model.DBColTAppLockExpireTime,
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	for _, lockRow := range lockRows {
+		err = FireOrResolve(
+			ctx,
+			fmt.Sprintf("lock_%s", lockRow.K),
+			lockRow.V == 1 && (now-lockRow.CreateTime > holdSeconds || lockRow.ExpireTime < now),
+			fmt.Sprintf(
+				"lock %s held by %s since %s, lease expire at %s",
+				lockRow.K,
+				lockRow.Owner,
+				time.Unix(lockRow.CreateTime, 0).Format(time.RFC3339),
+				time.Unix(lockRow.ExpireTime, 0).Format(time.RFC3339),
+			),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkBtcUxtoReorg 检测所在区块被回滚的已使用uxto
+func checkBtcUxtoReorg(ctx context.Context) error {
+	uxtoRows, err := model.SQLSelectTTxBtcUxtoColKV(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTTxBtcUxtoTxID,
+			model.DBColTTxBtcUxtoVoutN,
+			model.DBColTTxBtcUxtoSpendTxID,
+		},
+		[]string{
+			model.DBColShortTTxBtcUxtoHandleStatus,
+		},
+		[]interface{}{
+			app.UxtoHandleStatusReorg,
+		},
+		nil,
+		[]int64{10},
+	)
+	if err != nil {
+		return err
+	}
+	var uxtos []string
+	for _, uxtoRow := range uxtoRows {
+		uxtos = append(uxtos, fmt.Sprintf("%s_%d spent by %s", uxtoRow.TxID, uxtoRow.VoutN, uxtoRow.SpendTxID))
+	}
+	return FireOrResolve(
+		ctx,
+		"btc_uxto_reorg",
+		len(uxtoRows) > 0,
+		fmt.Sprintf("spent uxto in reorg block: %s", strings.Join(uxtos, ", ")),
+	)
+}
+
+// checkSendStuck 检测长时间未确认的交易
+func checkSendStuck(ctx context.Context) error {
+	stuckSeconds, err := app.GetConfigInt(ctx, xenv.DbCon, "alert_send_stuck_seconds", 60*30)
+	if err != nil {
+		return err
+	}
+	stuckTime := time.Now().Unix() - stuckSeconds
+	for _, chain := range heth.GetChains() {
+		sendRows, err := app.SQLSelectTSendColByStatus(
+			ctx,
+			xenv.DbCon,
+			[]string{
+				model.DBColTSendTxID,
+				model.DBColTSendHandleTime,
+			},
+			app.SendStatusSend,
+			chain.Name,
+		)
+		if err != nil {
+			return err
+		}
+		var txIDs []string
+		for _, sendRow := range sendRows {
+			if sendRow.HandleTime < stuckTime && !mcommon.IsStringInSlice(txIDs, sendRow.TxID) {
+				txIDs = append(txIDs, sendRow.TxID)
+			}
+		}
+		err = fireSendStuck(ctx, chain.CoinSymbol, txIDs)
+		if err != nil {
+			return err
+		}
+	}
+	if xenv.Cfg.BtcEnable {
+		sendRows, err := app.SQLSelectTSendBtcColByStatus(
+			ctx,
+			xenv.DbCon,
+			[]string{
+				model.DBColTSendBtcTxID,
+				model.DBColTSendBtcHandleTime,
+			},
+			app.SendStatusSend,
+		)
+		if err != nil {
+			return err
+		}
+		var txIDs []string
+		for _, sendRow := range sendRows {
+			if sendRow.HandleTime < stuckTime && !mcommon.IsStringInSlice(txIDs, sendRow.TxID) {
+				txIDs = append(txIDs, sendRow.TxID)
+			}
+		}
+		err = fireSendStuck(ctx, hbtc.CoinSymbol, txIDs)
+		if err != nil {
+			return err
+		}
+	}
+	if xenv.Cfg.EosEnable {
+		sendRows, err := app.SQLSelectTSendEosColByStatus(
+			ctx,
+			xenv.DbCon,
+			[]string{
+				model.DBColTSendEosTxHash,
+				model.DBColTSendEosHandleAt,
+			},
+			app.SendStatusSend,
+		)
+		if err != nil {
+			return err
+		}
+		var txIDs []string
+		for _, sendRow := range sendRows {
+			if sendRow.HandleAt < stuckTime && !mcommon.IsStringInSlice(txIDs, sendRow.TxHash) {
+				txIDs = append(txIDs, sendRow.TxHash)
+			}
+		}
+		err = fireSendStuck(ctx, heos.CoinSymbol, txIDs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fireSendStuck 发送交易未确认报警
+func fireSendStuck(ctx context.Context, symbol string, txIDs []string) error {
+	msg := fmt.Sprintf("%d %s tx stuck in send", len(txIDs), symbol)
+	if len(txIDs) > 0 {
+		msg = fmt.Sprintf("%s: %s", msg, strings.Join(txIDs, ","))
+	}
+	return FireOrResolve(
+		ctx,
+		fmt.Sprintf("send_stuck_%s", symbol),
+		len(txIDs) > 0,
+		msg,
+	)
+}
+
+// checkSeek 检测区块高度是否停止增长
+func checkSeek(ctx context.Context) error {
+	stallSeconds, err := app.GetConfigInt(ctx, xenv.DbCon, "alert_seek_stall_seconds", 60*15)
+	if err != nil {
+		return err
+	}
+	var seekKeys []string
+	for _, chain := range heth.GetChains() {
+		seekKeys = append(seekKeys, heth.ChainKey(chain.Name, "eth_seek_num"), heth.ChainKey(chain.Name, "erc20_seek_num"))
+	}
+	if xenv.Cfg.BtcEnable {
+		seekKeys = append(seekKeys, "btc_seek_num", "omni_seek_num", "btc_hot_fee_seek_num")
+	}
+	if xenv.Cfg.EosEnable {
+		seekKeys = append(seekKeys, "eos_seek_num")
+	}
+	for _, seekKey := range seekKeys {
+		seekValue, err := app.SQLGetTAppStatusIntValueByK(
+			ctx,
+			xenv.DbCon,
+			seekKey,
+		)
+		if err != nil {
+			return err
+		}
+		k := fmt.Sprintf("seek_%s", seekKey)
+		stall, err := getStallSeconds(ctx, k, seekValue)
+		if err != nil {
+			return err
+		}
+		err = FireOrResolve(
+			ctx,
+			k,
+			stall > stallSeconds,
+			fmt.Sprintf("%s stopped at %d for %ds", seekKey, seekValue, stall),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkBalance 对比余额和报警阈值
+func checkBalance(ctx context.Context, k string, name string, address string, balance string, minBalance string) error {
+	balanceObj, err := decimal.NewFromString(balance)
+	if err != nil {
+		return err
+	}
+	minBalanceObj, err := decimal.NewFromString(minBalance)
+	if err != nil {
+		return err
+	}
+	return FireOrResolve(
+		ctx,
+		k,
+		balanceObj.LessThan(minBalanceObj),
+		fmt.Sprintf("%s %s balance %s < %s", name, address, balanceObj.String(), minBalanceObj.String()),
+	)
+}
+
+// checkEthBalance 检测eth热钱包余额
+func checkEthBalance(ctx context.Context) error {
+	chainName := ethclient.ChainOf(ctx)
+	minBalance, err := app.GetConfigStr(ctx, xenv.DbCon, heth.ChainKey(chainName, "alert_min_balance_eth"))
+	if err != nil {
+		return err
+	}
+	if minBalance == "" {
+		return nil
+	}
+	hotAddressValue, err := app.GetConfigStr(ctx, xenv.DbCon, heth.ChainKey(chainName, "hot_wallet_address_eth"))
+	if err != nil {
+		return err
+	}
+	if hotAddressValue == "" {
+		return nil
+	}
+	hotAddresses, err := heth.ParseHotAddresses(hotAddressValue)
+	if err != nil {
+		return err
+	}
+	for _, hotAddress := range hotAddresses {
+		rpcBalance, err := ethclient.RPCBalanceAt(ctx, hotAddress)
+		if err != nil {
+			return err
+		}
+		balance, err := heth.WeiBigIntToEthStr(rpcBalance)
+		if err != nil {
+			return err
+		}
+		err = checkBalance(
+			ctx,
+			heth.ChainKey(chainName, fmt.Sprintf("balance_eth_%s", hotAddress)),
+			fmt.Sprintf("hot wallet %s", heth.GetChain(ctx).CoinSymbol),
+			hotAddress,
+			balance,
+			minBalance,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkErc20Balance 检测erc20热钱包余额
+func checkErc20Balance(ctx context.Context) error {
+	tokenRows, err := app.SQLSelectTAppConfigTokenColAll(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTAppConfigTokenTokenAddress,
+			model.DBColTAppConfigTokenTokenDecimals,
+			model.DBColTAppConfigTokenTokenSymbol,
+			model.DBColTAppConfigTokenHotAddress,
+		},
+		ethclient.ChainOf(ctx),
+	)
+	if err != nil {
+		return err
+	}
+	for _, tokenRow := range tokenRows {
+		minBalance, err := app.GetConfigStr(ctx, xenv.DbCon, fmt.Sprintf("alert_min_balance_%s", tokenRow.TokenSymbol))
+		if err != nil {
+			return err
+		}
+		if minBalance == "" || tokenRow.HotAddress == "" {
+			continue
+		}
+		hotAddresses, err := heth.ParseHotAddresses(tokenRow.HotAddress)
+		if err != nil {
+			return err
+		}
+		for _, hotAddress := range hotAddresses {
+			rpcBalance, err := ethclient.RPCTokenBalance(ctx, tokenRow.TokenAddress, hotAddress)
+			if err != nil {
+				return err
+			}
+			balance, err := heth.TokenWeiBigIntToEthStr(rpcBalance, tokenRow.TokenDecimals)
+			if err != nil {
+				return err
+			}
+			err = checkBalance(
+				ctx,
+				fmt.Sprintf("balance_%s_%s", tokenRow.TokenSymbol, hotAddress),
+				fmt.Sprintf("hot wallet %s", tokenRow.TokenSymbol),
+				hotAddress,
+				balance,
+				minBalance,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkErc20FeeBalance 检测erc20零钱整理手续费钱包余额
+func checkErc20FeeBalance(ctx context.Context) error {
+	chainName := ethclient.ChainOf(ctx)
+	minBalance, err := app.GetConfigStr(ctx, xenv.DbCon, heth.ChainKey(chainName, "alert_min_balance_fee_erc20"))
+	if err != nil {
+		return err
+	}
+	if minBalance == "" {
+		return nil
+	}
+	feeAddressListValue, err := app.GetConfigStr(ctx, xenv.DbCon, heth.ChainKey(chainName, "fee_wallet_address_list_erc20"))
+	if err != nil {
+		return err
+	}
+	for _, feeAddress := range strings.Split(feeAddressListValue, ",") {
+		feeAddress = strings.TrimSpace(feeAddress)
+		if feeAddress == "" {
+			continue
+		}
+		rpcBalance, err := ethclient.RPCBalanceAt(ctx, feeAddress)
+		if err != nil {
+			return err
+		}
+		balance, err := heth.WeiBigIntToEthStr(rpcBalance)
+		if err != nil {
+			return err
+		}
+		err = checkBalance(
+			ctx,
+			heth.ChainKey(chainName, fmt.Sprintf("balance_fee_erc20_%s", feeAddress)),
+			fmt.Sprintf("fee wallet %s", heth.ChainKey(chainName, "erc20")),
+			feeAddress,
+			balance,
+			minBalance,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkBtcBalance 检测btc热钱包余额
+func checkBtcBalance(ctx context.Context) error {
+	minBalance, err := app.GetConfigStr(ctx, xenv.DbCon, "alert_min_balance_btc")
+	if err != nil {
+		return err
+	}
+	if minBalance == "" {
+		return nil
+	}
+	hotAddress, err := app.GetConfigStr(ctx, xenv.DbCon, "hot_wallet_address_btc")
+	if err != nil {
+		return err
+	}
+	if hotAddress == "" {
+		return nil
+	}
+	balance, err := app.SQLGetTTxBtcUxtoBalanceByAddressAndType(
+		ctx,
+		xenv.DbCon,
+		hotAddress,
+		app.UxtoTypeHot,
+	)
+	if err != nil {
+		return err
+	}
+	return checkBalance(ctx, "balance_btc", "hot wallet btc", hotAddress, balance, minBalance)
+}
+
+// checkOmniBalance 检测omni热钱包余额
+func checkOmniBalance(ctx context.Context) error {
+	tokenRows, err := app.SQLSelectTAppConfigTokenBtcColAll(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTAppConfigTokenBtcTokenIndex,
+			model.DBColTAppConfigTokenBtcTokenSymbol,
+			model.DBColTAppConfigTokenBtcHotAddress,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	for _, tokenRow := range tokenRows {
+		minBalance, err := app.GetConfigStr(ctx, xenv.DbCon, fmt.Sprintf("alert_min_balance_%s", tokenRow.TokenSymbol))
+		if err != nil {
+			return err
+		}
+		if minBalance == "" || tokenRow.HotAddress == "" {
+			continue
+		}
+		rpcBalance, err := omniclient.RPCOmniGetBalance(ctx, tokenRow.HotAddress, tokenRow.TokenIndex)
+		if err != nil {
+			return err
+		}
+		err = checkBalance(
+			ctx,
+			fmt.Sprintf("balance_%s", tokenRow.TokenSymbol),
+			fmt.Sprintf("hot wallet %s", tokenRow.TokenSymbol),
+			tokenRow.HotAddress,
+			rpcBalance.Balance,
+			minBalance,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkEosBalance 检测eos热钱包余额
+func checkEosBalance(ctx context.Context) error {
+	minBalance, err := app.GetConfigStr(ctx, xenv.DbCon, "alert_min_balance_eos")
+	if err != nil {
+		return err
+	}
+	if minBalance == "" {
+		return nil
+	}
+	hotAddress, err := app.GetConfigStr(ctx, xenv.DbCon, "hot_wallet_address_eos")
+	if err != nil {
+		return err
+	}
+	if hotAddress == "" {
+		return nil
+	}
+	rpcAccount, err := eosclient.RPCChainGetAccount(ctx, hotAddress)
+	if err != nil {
+		return err
+	}
+	balance := "0"
+	if rpcAccount.CoreLiquidBalance != "" {
+		balance, err = heos.EosValueToStr(rpcAccount.CoreLiquidBalance)
+		if err != nil {
+			return err
+		}
+	}
+	return checkBalance(ctx, "balance_eos", "hot wallet eos", hotAddress, balance, minBalance)
+}
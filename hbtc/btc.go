@@ -22,7 +22,6 @@ import (
 
 	"github.com/parnurzeal/gorequest"
 
-	"github.com/gin-gonic/gin"
 
 	"github.com/shopspring/decimal"
 )
@@ -71,7 +70,7 @@ func genAddressAndAesKey() (string, string, error) {
 }
 
 // CreateHotAddress 创建自用地址
-func CreateHotAddress(num int64) ([]string, error) {
+func CreateHotAddress(ctx context.Context, num int64) ([]string, error) {
 	var rows []*model.DBTAddressKey
 	var addresses []string
 	// 遍历差值次数
@@ -91,7 +90,7 @@ func CreateHotAddress(num int64) ([]string, error) {
 	}
 	// 一次性将生成的地址存入数据库
 	_, err := model.SQLCreateManyTAddressKey(
-		context.Background(),
+		ctx,
 		xenv.DbCon,
 		rows,
 		true,
@@ -103,27 +102,27 @@ func CreateHotAddress(num int64) ([]string, error) {
 }
 
 // CheckAddressFree 检测剩余地址数
-func CheckAddressFree() {
+func CheckAddressFree(ctx context.Context) {
 	lockKey := "BtcCheckAddressFree"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取配置 允许的最小剩余地址数
 		minFreeValue, err := app.SQLGetTAppConfigIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			"min_free_address",
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 获取当前剩余可用地址数
 		freeCount, err := app.SQLGetTAddressKeyFreeCount(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			CoinSymbol,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 如果数据库中剩余可用地址小于最小允许可用地址
@@ -133,7 +132,7 @@ func CheckAddressFree() {
 			for i := int64(0); i < minFreeValue-freeCount; i++ {
 				address, wifStrEn, err := genAddressAndAesKey()
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				// 存入待添加队列
@@ -146,46 +145,47 @@ func CheckAddressFree() {
 			}
 			// 一次性将生成的地址存入数据库
 			_, err = model.SQLCreateManyTAddressKey(
-				context.Background(),
+				ctx,
 				xenv.DbCon,
 				rows,
 				true,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
+			app.JobAddCount(ctx, int64(len(rows)))
 		}
 	})
 }
 
 // CheckBlockSeek 检测到账
-func CheckBlockSeek() {
+func CheckBlockSeek(ctx context.Context) {
 	lockKey := "BtcCheckBlockSeek"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取配置 延迟确认数
 		confirmValue, err := app.SQLGetTAppConfigIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			"btc_block_confirm_num",
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 获取状态 当前处理完成的最新的block number
 		seekValue, err := app.SQLGetTAppStatusIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			"btc_seek_num",
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
-		rpcBlockNum, err := omniclient.RPCGetBlockCount()
+		rpcBlockNum, err := omniclient.RPCGetBlockCount(ctx)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		startI := seekValue + 1
@@ -195,7 +195,7 @@ func CheckBlockSeek() {
 			var tokenHotAddresses []string
 			var tokenFeeAddresses []string
 			tokenRows, err := app.SQLSelectTAppConfigTokenBtcColAll(
-				context.Background(),
+				ctx,
 				xenv.DbCon,
 				[]string{
 					model.DBColTAppConfigTokenBtcID,
@@ -204,7 +204,7 @@ func CheckBlockSeek() {
 				},
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			for _, tokenRow := range tokenRows {
@@ -215,18 +215,48 @@ func CheckBlockSeek() {
 					tokenFeeAddresses = append(tokenFeeAddresses, tokenRow.FeeAddress)
 				}
 			}
+			// 并发预取block信息
+			concurrency, err := app.GetBlockFetchConcurrency(ctx)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
+			fetcher := app.NewBlockFetcher(
+				ctx,
+				startI,
+				endI,
+				concurrency,
+				func(ctx context.Context, num int64) (interface{}, error) {
+					blockHash, err := omniclient.RPCGetBlockHash(ctx, num)
+					if err != nil {
+						return nil, err
+					}
+					return omniclient.RPCGetBlockVerbose(ctx, blockHash)
+				},
+			)
+			defer fetcher.Close()
+			// 上一个已处理区块的hash，用于检测回滚
+			prevHash, err := getSavedBlockHash(ctx, xenv.DbCon, "btc_seek_num", startI-1)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
 			// 遍历获取需要查询的block信息
 			for i := startI; i < endI; i++ {
 				//mcommon.Log.Debugf("btc check block: %d", i)
-				blockHash, err := omniclient.RPCGetBlockHash(i)
+				// 一个block
+				fetchBlock, err := fetcher.Get(i)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
-				// 一个block
-				rpcBlock, err := omniclient.RPCGetBlockVerbose(blockHash)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				rpcBlock := fetchBlock.(*omniclient.StBlockResult)
+				if isReorg(prevHash, rpcBlock) {
+					// 父区块不匹配，发生了回滚
+					err = handleReorg(ctx, "btc_seek_num", i-1)
+					if err != nil {
+						app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					}
 					return
 				}
 				// 目标地址
@@ -287,7 +317,7 @@ func CheckBlockSeek() {
 
 				// 从db中查询这些地址是否是冲币地址中的地址
 				dbAddressRows, err := app.SQLSelectTAddressKeyColByAddress(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					[]string{
 						model.DBColTAddressKeyAddress,
@@ -296,7 +326,7 @@ func CheckBlockSeek() {
 					toAddresses,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				// 待插入数据
@@ -322,7 +352,7 @@ func CheckBlockSeek() {
 									vin,
 								)
 								if err != nil {
-									mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+									app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 									return
 								}
 								if len(vinAddresses) > 0 {
@@ -339,6 +369,7 @@ func CheckBlockSeek() {
 								txBtcRows,
 								&model.DBTTxBtc{
 									ProductID:    dbAddressRow.UseTag,
+									BlockNumber:  rpcBlock.Height,
 									BlockHash:    rpcBlock.Hash,
 									TxID:         rpcTx.Txid,
 									VoutN:        voutIndex,
@@ -404,7 +435,7 @@ func CheckBlockSeek() {
 				// 从uxto中查询txhash
 				var updateUxtoRows []*model.DBTTxBtcUxto
 				uxtoRows, err := app.SQLSelectTTxBtcUxtoColByTxIDs(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					[]string{
 						model.DBColTTxBtcUxtoID,
@@ -414,7 +445,7 @@ func CheckBlockSeek() {
 					fromTxHashes,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				for _, uxtoRow := range uxtoRows {
@@ -435,38 +466,46 @@ func CheckBlockSeek() {
 				}
 				// 插入数据库
 				_, err = model.SQLCreateManyTTxBtc(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					txBtcRows,
 					true,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
+				app.JobAddCount(ctx, int64(len(txBtcRows)))
 				_, err = model.SQLCreateManyTTxBtcUxto(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					txBtcUxtoRows,
 					true,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				// 更新uxto状态
 				_, err = app.SQLCreateManyTTxBtcUxtoUpdate(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					updateUxtoRows,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					return
+				}
+				// 记录区块hash
+				err = saveBlock(ctx, xenv.DbCon, "btc_seek_num", rpcBlock)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
+				prevHash = rpcBlock.Hash
 				// 更新block num
 				_, err = app.SQLUpdateTAppStatusIntByKGreater(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					&model.DBTAppStatusInt{
 						K: "btc_seek_num",
@@ -474,7 +513,7 @@ func CheckBlockSeek() {
 					},
 				)
 				if err != nil {
-					mcommon.Log.Errorf("SQLUpdateTAppStatusIntByK err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "SQLUpdateTAppStatusIntByK err: [%T] %s", err, err.Error())
 					return
 				}
 			}
@@ -483,14 +522,14 @@ func CheckBlockSeek() {
 }
 
 // CheckTxOrg 检测零钱整理
-func CheckTxOrg() {
+func CheckTxOrg(ctx context.Context) {
 	lockKey := "BtcCheckTxOrg"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 开始事物
 		isComment := false
-		dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+		dbTx, err := xenv.DbCon.BeginTxx(ctx, nil)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		defer func() {
@@ -500,7 +539,7 @@ func CheckTxOrg() {
 		}()
 		// 获取所有需要整理的uxto
 		allUxtoRows, err := app.SQLSelectTTxBtcUxtoColToOrgForUpdate(
-			context.Background(),
+			ctx,
 			dbTx,
 			[]string{
 				model.DBColTTxBtcUxtoID,
@@ -513,7 +552,7 @@ func CheckTxOrg() {
 			app.UxtoTypeTx,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		if len(allUxtoRows) <= 0 {
@@ -521,22 +560,22 @@ func CheckTxOrg() {
 		}
 		// 获取冷包地址
 		coldAddressValue, err := app.SQLGetTAppConfigStrValueByK(
-			context.Background(),
+			ctx,
 			dbTx,
 			"cold_wallet_address_btc",
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 获取手续费配置
 		feePriceValue, err := app.SQLGetTAppStatusIntValueByK(
-			context.Background(),
+			ctx,
 			dbTx,
 			"to_cold_gas_price_btc",
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 获取私钥
@@ -547,12 +586,12 @@ func CheckTxOrg() {
 			}
 		}
 		addressWifMap, err := GetWifMapByAddresses(
-			context.Background(),
+			ctx,
 			dbTx,
 			addresses,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 按5000个in拆分
@@ -569,12 +608,12 @@ func CheckTxOrg() {
 			for _, uxtoRow := range uxtoRows {
 				wif, ok := addressWifMap[uxtoRow.VoutAddress]
 				if !ok {
-					mcommon.Log.Errorf("no address key: %s", uxtoRow.VoutAddress)
+					app.JobErrorf(ctx, "no address key: %s", uxtoRow.VoutAddress)
 					return
 				}
 				balance, err := decimal.NewFromString(uxtoRow.VoutValue)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				inItems = append(inItems, &StBtxTxIn{
@@ -587,7 +626,7 @@ func CheckTxOrg() {
 			}
 			tx, err := BtcMakeTx(GetNetwork(xenv.Cfg.BtcNetworkType).Params, inItems, outItems, feePriceValue, coldAddressValue)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			txSize := tx.SerializeSize()
@@ -595,7 +634,7 @@ func CheckTxOrg() {
 			b.Grow(txSize)
 			err = tx.Serialize(b)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			//mcommon.Log.Debugf("raw tx: %s", hex.EncodeToString(b.Bytes()))
@@ -641,30 +680,31 @@ func CheckTxOrg() {
 			}
 			// 插入数据
 			_, err = model.SQLCreateManyTSendBtc(
-				context.Background(),
+				ctx,
 				dbTx,
 				sendRows,
 				true,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
+			app.JobAddCount(ctx, int64(len(sendRows)))
 			// 更新uxto状态
 			_, err = app.SQLCreateManyTTxBtcUxtoUpdate(
-				context.Background(),
+				ctx,
 				dbTx,
 				updateUxtoRows,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 		}
 
 		err = dbTx.Commit()
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		isComment = true
@@ -672,18 +712,19 @@ func CheckTxOrg() {
 }
 
 // CheckRawTxSend 发送交易
-func CheckRawTxSend() {
+func CheckRawTxSend(ctx context.Context) {
 	lockKey := "BtcCheckRawTxSend"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 发送的数组
 		var sendHexes []string
 
 		sendRows, err := app.SQLSelectTSendBtcColByStatus(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTSendBtcID,
 				model.DBColTSendBtcTxID,
+				model.DBColTSendBtcFromAddress,
 				model.DBColTSendBtcHex,
 				model.DBColTSendBtcRelatedType,
 				model.DBColTSendBtcRelatedID,
@@ -691,7 +732,7 @@ func CheckRawTxSend() {
 			app.SendStatusInit,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 首先单独处理提币，提取提币通知要使用的数据
@@ -705,7 +746,7 @@ func CheckRawTxSend() {
 			}
 		}
 		withdrawMap, err := app.SQLGetWithdrawMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTWithdrawID,
@@ -726,13 +767,14 @@ func CheckRawTxSend() {
 			}
 		}
 		productMap, err := app.SQLGetProductMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTProductID,
 				model.DBColTProductAppName,
 				model.DBColTProductCbURL,
 				model.DBColTProductAppSk,
+				model.DBColTProductNotifyVersion,
 			},
 			productIDs,
 		)
@@ -753,44 +795,35 @@ func CheckRawTxSend() {
 			if sendRow.RelatedType == app.SendRelationTypeWithdraw {
 				withdrawRow, ok := withdrawMap[sendRow.RelatedID]
 				if !ok {
-					mcommon.Log.Errorf("withdrawMap no: %d", sendRow.RelatedID)
+					app.JobErrorf(ctx, "withdrawMap no: %d", sendRow.RelatedID)
 					return nil
 				}
 				productRow, ok := productMap[withdrawRow.ProductID]
 				if !ok {
-					mcommon.Log.Errorf("productMap no: %d", withdrawRow.ProductID)
+					app.JobErrorf(ctx, "productMap no: %d", withdrawRow.ProductID)
 					return nil
 				}
-				nonce := mcommon.GetUUIDStr()
-				reqObj := gin.H{
-					"tx_hash":     withdrawRow.TxHash,
-					"balance":     withdrawRow.BalanceReal,
-					"app_name":    productRow.AppName,
-					"out_serial":  withdrawRow.OutSerial,
-					"address":     withdrawRow.ToAddress,
-					"symbol":      withdrawRow.Symbol,
-					"notify_type": app.NotifyTypeWithdrawSend,
-				}
-				reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
-				req, err := json.Marshal(reqObj)
+				notifyRow, err := app.GetNotifyRow(
+					productRow,
+					&app.StNotifyData{
+						ProductID:   withdrawRow.ProductID,
+						ItemType:    app.SendRelationTypeWithdraw,
+						ItemID:      withdrawRow.ID,
+						NotifyType:  app.NotifyTypeWithdrawSend,
+						Symbol:      withdrawRow.Symbol,
+						TxHash:      withdrawRow.TxHash,
+						Address:     withdrawRow.ToAddress,
+						FromAddress: sendRow.FromAddress,
+						Balance:     withdrawRow.BalanceReal,
+						OutSerial:   withdrawRow.OutSerial,
+					},
+					now,
+				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return err
 				}
-				notifyRows = append(notifyRows, &model.DBTProductNotify{
-					Nonce:        nonce,
-					ProductID:    withdrawRow.ProductID,
-					ItemType:     app.SendRelationTypeWithdraw,
-					ItemID:       withdrawRow.ID,
-					NotifyType:   app.NotifyTypeWithdrawSend,
-					TokenSymbol:  withdrawRow.Symbol,
-					URL:          productRow.CbURL,
-					Msg:          string(req),
-					HandleStatus: app.NotifyStatusInit,
-					HandleMsg:    "",
-					CreateTime:   now,
-					UpdateTime:   now,
-				})
+				notifyRows = append(notifyRows, notifyRow)
 				withdrawIDs = append(withdrawIDs, withdrawRow.ID)
 			}
 			return nil
@@ -798,13 +831,19 @@ func CheckRawTxSend() {
 
 		var sendIDs []int64
 		var sendTxHashes []string
+		// 被拒绝的数据
+		var failRows []*model.DBTSendBtc
+		failMap := make(map[string]string)
 		for _, sendRow := range sendRows {
 			if sendRow.Hex == "" {
 				continue
 			}
-			_, err := omniclient.RPCSendRawTransaction(sendRow.Hex)
+			_, err := omniclient.RPCSendRawTransaction(ctx, sendRow.Hex)
 			if err != nil && !strings.Contains(err.Error(), "already in block chain") {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				if IsSendRejected(err) {
+					failMap[sendRow.TxID] = fmt.Sprintf("send rejected: %s", err.Error())
+				}
 				continue
 			}
 			// 解析发送的tx,查看是否需要添加入uxto
@@ -827,11 +866,19 @@ func CheckRawTxSend() {
 						return
 					}
 				}
+			} else if _, ok := failMap[sendRow.TxID]; ok {
+				failRows = append(failRows, sendRow)
 			}
 		}
+		// 处理被拒绝的交易
+		err = handleSendFailed(ctx, failRows, failMap)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
 		// 更新提币状态
 		_, err = app.SQLUpdateTWithdrawStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			withdrawIDs,
 			&model.DBTWithdraw{
@@ -841,23 +888,23 @@ func CheckRawTxSend() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 添加发送通知
 		_, err = model.SQLCreateManyTProductNotify(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			notifyRows,
 			true,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新整理状态
 		_, err = app.SQLUpdateTTxBtcTokenOrgStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			tokenTxIDs,
 			model.DBTTxBtcToken{
@@ -867,18 +914,18 @@ func CheckRawTxSend() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 检测发送是否生成新的uxto
-		err = checkSendUxto(sendHexes)
+		err = checkSendUxto(ctx, sendHexes)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新发送状态
 		_, err = app.SQLUpdateTSendBtcByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			sendIDs,
 			&model.DBTSendBtc{
@@ -888,20 +935,21 @@ func CheckRawTxSend() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
+		app.JobAddCount(ctx, int64(len(sendIDs)))
 	})
 }
 
-func checkSendUxto(hexes []string) error {
+func checkSendUxto(ctx context.Context, hexes []string) error {
 	if len(hexes) > 0 {
 		// 需要添加的uxto
 		var txBtcUxtoRows []*model.DBTTxBtcUxto
 		now := time.Now().Unix()
 		// 获取btc热钱包地址
 		hotAddress, err := app.SQLGetTAppConfigStrValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			"hot_wallet_address_btc",
 		)
@@ -912,7 +960,7 @@ func checkSendUxto(hexes []string) error {
 		var tokenHotAddresses []string
 		var tokenFeeAddresses []string
 		tokenRows, err := app.SQLSelectTAppConfigTokenBtcColAll(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTAppConfigTokenBtcID,
@@ -1048,13 +1096,13 @@ func checkSendUxto(hexes []string) error {
 		}
 		// 创建uxto
 		_, err = model.SQLCreateManyTTxBtcUxto(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			txBtcUxtoRows,
 			true,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return err
 		}
 	}
@@ -1062,15 +1110,18 @@ func checkSendUxto(hexes []string) error {
 }
 
 // CheckRawTxConfirm 确认tx是否打包完成
-func CheckRawTxConfirm() {
+func CheckRawTxConfirm(ctx context.Context) {
 	lockKey := "BtcCheckRawTxConfirm"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		sendRows, err := app.SQLSelectTSendBtcColByStatus(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTSendBtcID,
 				model.DBColTSendBtcTxID,
+				model.DBColTSendBtcFromAddress,
+				model.DBColTSendBtcGas,
+				model.DBColTSendBtcGasPrice,
 				model.DBColTSendBtcHex,
 				model.DBColTSendBtcRelatedType,
 				model.DBColTSendBtcRelatedID,
@@ -1078,7 +1129,7 @@ func CheckRawTxConfirm() {
 			app.SendStatusSend,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 获取提币信息
@@ -1092,7 +1143,7 @@ func CheckRawTxConfirm() {
 			}
 		}
 		withdrawMap, err := app.SQLGetWithdrawMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTWithdrawID,
@@ -1106,7 +1157,7 @@ func CheckRawTxConfirm() {
 			withdrawIDs,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		var productIDs []int64
@@ -1116,18 +1167,25 @@ func CheckRawTxConfirm() {
 			}
 		}
 		productMap, err := app.SQLGetProductMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTProductID,
 				model.DBColTProductAppName,
 				model.DBColTProductCbURL,
 				model.DBColTProductAppSk,
+				model.DBColTProductNotifyVersion,
 			},
 			productIDs,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		// 当前高度 用于计算交易所在区块
+		rpcBlockNum, err := omniclient.RPCGetBlockCount(ctx)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 
@@ -1135,6 +1193,8 @@ func CheckRawTxConfirm() {
 		var tokenTxIDs []int64
 		withdrawIDs = []int64{}
 		now := time.Now().Unix()
+		// 已确认的交易
+		rpcTxMap := make(map[string]*omniclient.StTxResult)
 		addWithdrawNotify := func(sendRow *model.DBTSendBtc) error {
 			switch sendRow.RelatedType {
 			case app.SendRelationTypeOmniOrg:
@@ -1146,61 +1206,94 @@ func CheckRawTxConfirm() {
 				// 提币
 				withdrawRow, ok := withdrawMap[sendRow.RelatedID]
 				if !ok {
-					mcommon.Log.Errorf("no withdrawMap: %d", sendRow.RelatedID)
+					app.JobErrorf(ctx, "no withdrawMap: %d", sendRow.RelatedID)
 					return nil
 				}
 				productRow, ok := productMap[withdrawRow.ProductID]
 				if !ok {
-					mcommon.Log.Errorf("no productMap: %d", withdrawRow.ProductID)
+					app.JobErrorf(ctx, "no productMap: %d", withdrawRow.ProductID)
 					return nil
 				}
-				nonce := mcommon.GetUUIDStr()
-				reqObj := gin.H{
-					"tx_hash":     withdrawRow.TxHash,
-					"balance":     withdrawRow.BalanceReal,
-					"app_name":    productRow.AppName,
-					"out_serial":  withdrawRow.OutSerial,
-					"address":     withdrawRow.ToAddress,
-					"symbol":      withdrawRow.Symbol,
-					"notify_type": app.NotifyTypeWithdrawConfirm,
-				}
-				reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
-				req, err := json.Marshal(reqObj)
+				rpcTx := rpcTxMap[sendRow.TxID]
+				// 批量提币时整笔交易的手续费记录在第一条
+				withdrawRow.Fee = decimal.NewFromInt(sendRow.Gas * sendRow.GasPrice).Shift(-8).StringFixed(8)
+				withdrawRow.BlockNumber = rpcBlockNum - rpcTx.Confirmations + 1
+				withdrawRow.BlockHash = rpcTx.Blockhash
+				_, err := app.SQLUpdateTWithdrawBlockByID(
+					ctx,
+					xenv.DbCon,
+					withdrawRow,
+				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return err
 				}
-				notifyRows = append(notifyRows, &model.DBTProductNotify{
-					Nonce:        nonce,
-					ProductID:    withdrawRow.ProductID,
-					ItemType:     app.SendRelationTypeWithdraw,
-					ItemID:       withdrawRow.ID,
-					NotifyType:   app.NotifyTypeWithdrawConfirm,
-					TokenSymbol:  withdrawRow.Symbol,
-					URL:          productRow.CbURL,
-					Msg:          string(req),
-					HandleStatus: app.NotifyStatusInit,
-					HandleMsg:    "",
-					CreateTime:   now,
-					UpdateTime:   now,
-				})
+				notifyRow, err := app.GetNotifyRow(
+					productRow,
+					&app.StNotifyData{
+						ProductID:     withdrawRow.ProductID,
+						ItemType:      app.SendRelationTypeWithdraw,
+						ItemID:        withdrawRow.ID,
+						NotifyType:    app.NotifyTypeWithdrawConfirm,
+						Symbol:        withdrawRow.Symbol,
+						TxHash:        withdrawRow.TxHash,
+						Address:       withdrawRow.ToAddress,
+						FromAddress:   sendRow.FromAddress,
+						Balance:       withdrawRow.BalanceReal,
+						OutSerial:     withdrawRow.OutSerial,
+						BlockNumber:   withdrawRow.BlockNumber,
+						BlockHash:     withdrawRow.BlockHash,
+						Confirmations: rpcTx.Confirmations,
+						Fee:           withdrawRow.Fee,
+					},
+					now,
+				)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					return err
+				}
+				notifyRows = append(notifyRows, notifyRow)
 				withdrawIDs = append(withdrawIDs, withdrawRow.ID)
 			}
 			return nil
 		}
 
+		// 交易hex
+		hexMap := make(map[string]string)
+		for _, sendRow := range sendRows {
+			if sendRow.Hex != "" {
+				hexMap[sendRow.TxID] = sendRow.Hex
+			}
+		}
 		var sendIDs []int64
 		var confirmHashes []string
+		// 被丢弃的数据
+		var failRows []*model.DBTSendBtc
+		failMap := make(map[string]string)
 		for _, sendRow := range sendRows {
+			if _, ok := failMap[sendRow.TxID]; ok {
+				failRows = append(failRows, sendRow)
+				continue
+			}
 			if !mcommon.IsStringInSlice(confirmHashes, sendRow.TxID) {
-				rpcTx, err := omniclient.RPCGetRawTransactionVerbose(sendRow.TxID)
+				rpcTx, err := omniclient.RPCGetRawTransactionVerbose(ctx, sendRow.TxID)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					if strings.Contains(err.Error(), "No such mempool or blockchain transaction") {
+						// 交易已从节点丢弃，尝试重新广播
+						_, err = omniclient.RPCSendRawTransaction(ctx, hexMap[sendRow.TxID])
+						// 输入已被花费或不存在时同样无法再打包
+						if err != nil && (IsSendRejected(err) || strings.Contains(err.Error(), "issing inputs")) {
+							failMap[sendRow.TxID] = fmt.Sprintf("tx dropped: %s", err.Error())
+							failRows = append(failRows, sendRow)
+						}
+					}
 					continue
 				}
 				if rpcTx.Confirmations <= 0 {
 					continue
 				}
+				rpcTxMap[sendRow.TxID] = rpcTx
 				confirmHashes = append(confirmHashes, sendRow.TxID)
 			}
 			err = addWithdrawNotify(sendRow)
@@ -1212,7 +1305,7 @@ func CheckRawTxConfirm() {
 		}
 		// 更新提币状态
 		_, err = app.SQLUpdateTWithdrawStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			withdrawIDs,
 			&model.DBTWithdraw{
@@ -1222,23 +1315,23 @@ func CheckRawTxConfirm() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 添加通知
 		_, err = model.SQLCreateManyTProductNotify(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			notifyRows,
 			true,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新整理状态
 		_, err = app.SQLUpdateTTxBtcTokenOrgStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			tokenTxIDs,
 			model.DBTTxBtcToken{
@@ -1248,12 +1341,12 @@ func CheckRawTxConfirm() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新发送状态
 		_, err = app.SQLUpdateTSendBtcByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			sendIDs,
 			&model.DBTSendBtc{
@@ -1263,21 +1356,169 @@ func CheckRawTxConfirm() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		app.JobAddCount(ctx, int64(len(sendIDs)))
+		// 处理被丢弃的交易
+		err = handleSendFailed(ctx, failRows, failMap)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 	})
 }
 
+// handleSendFailed 处理发送失败的交易
+func handleSendFailed(ctx context.Context, sendRows []*model.DBTSendBtc, failMap map[string]string) error {
+	if len(sendRows) == 0 {
+		return nil
+	}
+	var withdrawIDs []int64
+	for _, sendRow := range sendRows {
+		if sendRow.RelatedType == app.SendRelationTypeWithdraw {
+			if !mcommon.IsIntInSlice(withdrawIDs, sendRow.RelatedID) {
+				withdrawIDs = append(withdrawIDs, sendRow.RelatedID)
+			}
+		}
+	}
+	withdrawMap, err := app.SQLGetWithdrawMap(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTWithdrawID,
+			model.DBColTWithdrawProductID,
+			model.DBColTWithdrawOutSerial,
+			model.DBColTWithdrawToAddress,
+			model.DBColTWithdrawBalanceReal,
+			model.DBColTWithdrawSymbol,
+			model.DBColTWithdrawTxHash,
+			model.DBColTWithdrawFee,
+			model.DBColTWithdrawBlockNumber,
+			model.DBColTWithdrawBlockHash,
+		},
+		withdrawIDs,
+	)
+	if err != nil {
+		return err
+	}
+	var productIDs []int64
+	for _, withdrawRow := range withdrawMap {
+		if !mcommon.IsIntInSlice(productIDs, withdrawRow.ProductID) {
+			productIDs = append(productIDs, withdrawRow.ProductID)
+		}
+	}
+	productMap, err := app.SQLGetProductMap(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTProductID,
+			model.DBColTProductAppName,
+			model.DBColTProductCbURL,
+			model.DBColTProductAppSk,
+			model.DBColTProductNotifyVersion,
+		},
+		productIDs,
+	)
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	var failTxHashes []string
+	var tokenTxIDs []int64
+	for _, sendRow := range sendRows {
+		reason := failMap[sendRow.TxID]
+		if !mcommon.IsStringInSlice(failTxHashes, sendRow.TxID) {
+			failTxHashes = append(failTxHashes, sendRow.TxID)
+		}
+		_, err = app.SQLUpdateTSendBtcByIDs(
+			ctx,
+			xenv.DbCon,
+			[]int64{sendRow.ID},
+			&model.DBTSendBtc{
+				HandleStatus: app.SendStatusFailed,
+				HandleMsg:    reason,
+				HandleTime:   now,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		switch sendRow.RelatedType {
+		case app.SendRelationTypeWithdraw:
+			withdrawRow, ok := withdrawMap[sendRow.RelatedID]
+			if !ok {
+				app.JobErrorf(ctx, "no withdrawMap: %d", sendRow.RelatedID)
+				continue
+			}
+			productRow, ok := productMap[withdrawRow.ProductID]
+			if !ok {
+				app.JobErrorf(ctx, "no productMap: %d", withdrawRow.ProductID)
+			}
+			err = app.WithdrawFailed(
+				ctx,
+				xenv.DbCon,
+				withdrawRow,
+				productRow,
+				withdrawRow.TxHash,
+				reason,
+			)
+			if err != nil {
+				return err
+			}
+		case app.SendRelationTypeOmniOrg:
+			if !mcommon.IsIntInSlice(tokenTxIDs, sendRow.RelatedID) {
+				tokenTxIDs = append(tokenTxIDs, sendRow.RelatedID)
+			}
+		}
+	}
+	// 释放交易占用的uxto
+	_, err = app.SQLUpdateTTxBtcUxtoReleaseBySpendTxIDs(
+		ctx,
+		xenv.DbCon,
+		failTxHashes,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+	// 作废交易产生的uxto
+	_, err = app.SQLUpdateTTxBtcUxtoInvalidByTxIDs(
+		ctx,
+		xenv.DbCon,
+		failTxHashes,
+		"send failed",
+		now,
+	)
+	if err != nil {
+		return err
+	}
+	// omni零钱整理失败的重新整理
+	_, err = app.SQLUpdateTTxBtcTokenOrgStatusByIDs(
+		ctx,
+		xenv.DbCon,
+		tokenTxIDs,
+		model.DBTTxBtcToken{
+			OrgStatus: app.TxOrgStatusInit,
+			OrgMsg:    "send failed",
+			OrgAt:     now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // CheckWithdraw 检测提现
-func CheckWithdraw() {
+func CheckWithdraw(ctx context.Context) {
 	lockKey := "BtcCheckWithdraw"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 开始事物
 		isComment := false
-		dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+		dbTx, err := xenv.DbCon.BeginTxx(ctx, nil)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		defer func() {
@@ -1287,7 +1528,7 @@ func CheckWithdraw() {
 		}()
 		// 获取提币信息
 		withdrawRows, err := app.SQLSelectTWithdrawColByStatusForUpdate(
-			context.Background(),
+			ctx,
 			dbTx,
 			[]string{
 				model.DBColTWithdrawID,
@@ -1300,7 +1541,7 @@ func CheckWithdraw() {
 			[]string{CoinSymbol},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		if len(withdrawRows) == 0 {
@@ -1308,28 +1549,28 @@ func CheckWithdraw() {
 		}
 		// 获取手续费配置
 		feePriceValue, err := app.SQLGetTAppStatusIntValueByK(
-			context.Background(),
+			ctx,
 			dbTx,
 			"to_user_gas_price_btc",
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 获取热钱包地址
 		hotAddressValue, err := app.SQLGetTAppConfigStrValueByK(
-			context.Background(),
+			ctx,
 			dbTx,
 			"hot_wallet_address_btc",
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		hotAddress := hotAddressValue
 		// 获取热钱包uxto
 		uxtoRows, err := app.SQLSelectTTxBtcUxtoColByAddressAndTypeForUpdate(
-			context.Background(),
+			ctx,
 			dbTx,
 			[]string{
 				model.DBColTTxBtcUxtoID,
@@ -1343,7 +1584,7 @@ func CheckWithdraw() {
 			app.UxtoTypeHot,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 获取私钥
@@ -1352,12 +1593,12 @@ func CheckWithdraw() {
 			addresses = append(addresses, uxtoRow.VoutAddress)
 		}
 		addressWifMap, err := GetWifMapByAddresses(
-			context.Background(),
+			ctx,
 			dbTx,
 			addresses,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 生成交易
@@ -1377,7 +1618,7 @@ func CheckWithdraw() {
 			// 添加输出
 			withdrawBalance, err := decimal.NewFromString(withdrawRow.BalanceReal)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			outBalance += withdrawBalance.Mul(decimal.NewFromInt(1e8)).IntPart()
@@ -1392,7 +1633,7 @@ func CheckWithdraw() {
 				}
 				txSize, err := BtcTxWithdrawSize(GetNetwork(xenv.Cfg.BtcNetworkType).Params, feeInUxtoRows, feeOutWithdrawRows, addressWifMap)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				if inBalance >= outBalance+txSize*feePriceValue {
@@ -1411,7 +1652,7 @@ func CheckWithdraw() {
 				uxtoRow := uxtoRows[uxtoUseIndex]
 				uxtoBalance, err := decimal.NewFromString(uxtoRow.VoutValue)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				inBalance += uxtoBalance.Mul(decimal.NewFromInt(1e8)).IntPart()
@@ -1430,11 +1671,11 @@ func CheckWithdraw() {
 		}
 		//mcommon.Log.Debugf("inUxtoRows: %#v, outWithdrawRows: %#v", inUxtoRows, outWithdrawRows)
 		if len(inUxtoRows) == 0 {
-			mcommon.Log.Errorf("btc hot balance limit")
+			app.JobErrorf(ctx, "btc hot balance limit")
 			return
 		}
 		if len(outWithdrawRows) == 0 {
-			mcommon.Log.Errorf("btc hot balance limit")
+			app.JobErrorf(ctx, "btc hot balance limit")
 			return
 		}
 		// 创建交易
@@ -1443,12 +1684,12 @@ func CheckWithdraw() {
 		for _, vin := range inUxtoRows {
 			wif, ok := addressWifMap[vin.VoutAddress]
 			if !ok {
-				mcommon.Log.Errorf("no wif of: %s", vin.VoutAddress)
+				app.JobErrorf(ctx, "no wif of: %s", vin.VoutAddress)
 				return
 			}
 			balance, err := decimal.NewFromString(vin.VoutValue)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			argVins = append(argVins, &StBtxTxIn{
@@ -1462,7 +1703,7 @@ func CheckWithdraw() {
 		for _, vout := range outWithdrawRows {
 			balance, err := decimal.NewFromString(vout.BalanceReal)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			argVouts = append(argVouts, &StBtxTxOut{
@@ -1472,14 +1713,14 @@ func CheckWithdraw() {
 		}
 		tx, err := BtcMakeTx(GetNetwork(xenv.Cfg.BtcNetworkType).Params, argVins, argVouts, feePriceValue, hotAddress)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		b := new(bytes.Buffer)
 		b.Grow(tx.SerializeSize())
 		err = tx.Serialize(b)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		//mcommon.Log.Debugf("raw tx: %s", hex.EncodeToString(b.Bytes()))
@@ -1534,39 +1775,40 @@ func CheckWithdraw() {
 		}
 		// 插入数据
 		_, err = model.SQLCreateManyTSendBtc(
-			context.Background(),
+			ctx,
 			dbTx,
 			sendRows,
 			true,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新uxto状态
 		_, err = app.SQLCreateManyTTxBtcUxtoUpdate(
-			context.Background(),
+			ctx,
 			dbTx,
 			updateUxtoRows,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新withdraw
 		_, err = app.SQLCreateManyTWithdrawUpdate(
-			context.Background(),
+			ctx,
 			dbTx,
 			updateWithdrawRows,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
+		app.JobAddCount(ctx, int64(len(updateWithdrawRows)))
 		// 提交事物
 		err = dbTx.Commit()
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		isComment = true
@@ -1574,15 +1816,17 @@ func CheckWithdraw() {
 }
 
 // CheckTxNotify 创建btc冲币通知
-func CheckTxNotify() {
+func CheckTxNotify(ctx context.Context) {
 	lockKey := "BtcCheckTxNotify"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		txRows, err := app.SQLSelectTTxBtcColByStatus(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTTxBtcID,
 				model.DBColTTxBtcProductID,
+				model.DBColTTxBtcBlockNumber,
+				model.DBColTTxBtcBlockHash,
 				model.DBColTTxBtcTxID,
 				model.DBColTTxBtcVoutAddress,
 				model.DBColTTxBtcVoutN,
@@ -1591,7 +1835,16 @@ func CheckTxNotify() {
 			app.TxStatusInit,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(txRows) == 0 {
+			return
+		}
+		// 当前高度 用于计算确认数
+		rpcBlockNum, err := omniclient.RPCGetBlockCount(ctx)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		var productIDs []int64
@@ -1601,18 +1854,19 @@ func CheckTxNotify() {
 			}
 		}
 		productMap, err := app.SQLGetProductMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTProductID,
 				model.DBColTProductAppName,
 				model.DBColTProductCbURL,
 				model.DBColTProductAppSk,
+				model.DBColTProductNotifyVersion,
 			},
 			productIDs,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		var notifyTxIDs []int64
@@ -1625,49 +1879,42 @@ func CheckTxNotify() {
 				notifyTxIDs = append(notifyTxIDs, txRow.ID)
 				continue
 			}
-			nonce := mcommon.GetUUIDStr()
-			reqObj := gin.H{
-				"tx_hash":     fmt.Sprintf("%s_%d", txRow.TxID, txRow.VoutN),
-				"app_name":    productRow.AppName,
-				"address":     txRow.VoutAddress,
-				"balance":     txRow.VoutValue,
-				"symbol":      CoinSymbol,
-				"notify_type": app.NotifyTypeTx,
-			}
-			reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
-			req, err := json.Marshal(reqObj)
+			notifyRow, err := app.GetNotifyRow(
+				productRow,
+				&app.StNotifyData{
+					ProductID:     txRow.ProductID,
+					ItemType:      app.SendRelationTypeTx,
+					ItemID:        txRow.ID,
+					NotifyType:    app.NotifyTypeTx,
+					Symbol:        CoinSymbol,
+					TxHash:        fmt.Sprintf("%s_%d", txRow.TxID, txRow.VoutN),
+					Address:       txRow.VoutAddress,
+					Balance:       txRow.VoutValue,
+					BlockNumber:   txRow.BlockNumber,
+					BlockHash:     txRow.BlockHash,
+					Confirmations: rpcBlockNum - txRow.BlockNumber + 1,
+				},
+				now,
+			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				continue
 			}
-			notifyRows = append(notifyRows, &model.DBTProductNotify{
-				Nonce:        nonce,
-				ProductID:    txRow.ProductID,
-				ItemType:     app.SendRelationTypeTx,
-				ItemID:       txRow.ID,
-				NotifyType:   app.NotifyTypeTx,
-				TokenSymbol:  CoinSymbol,
-				URL:          productRow.CbURL,
-				Msg:          string(req),
-				HandleStatus: app.NotifyStatusInit,
-				HandleMsg:    "",
-				CreateTime:   now,
-				UpdateTime:   now,
-			})
+			notifyRows = append(notifyRows, notifyRow)
 			notifyTxIDs = append(notifyTxIDs, txRow.ID)
 		}
 		_, err = model.SQLCreateManyTProductNotify(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			notifyRows,
 			true,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		_, err = app.SQLUpdateTTxBtcStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			notifyTxIDs,
 			model.DBTTxBtc{
@@ -1677,26 +1924,27 @@ func CheckTxNotify() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
+		app.JobAddCount(ctx, int64(len(notifyTxIDs)))
 	})
 
 }
 
 // CheckGasPrice 检测gas price
-func CheckGasPrice() {
+func CheckGasPrice(ctx context.Context) {
 	lockKey := "BtcCheckGasPrice"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取最高单价
 		maxValue, err := app.SQLGetTAppStatusIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			"max_gas_price_btc",
 		)
 		if err != nil {
 			if !strings.Contains(err.Error(), "no app status int of") {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 		}
@@ -1704,7 +1952,7 @@ func CheckGasPrice() {
 			maxValue = 168
 			// 创建
 			_, err := model.SQLCreateTAppStatusInt(
-				context.Background(),
+				ctx,
 				xenv.DbCon,
 				&model.DBTAppStatusInt{
 					K: "max_gas_price_btc",
@@ -1713,7 +1961,7 @@ func CheckGasPrice() {
 				true,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 		}
@@ -1728,18 +1976,18 @@ func CheckGasPrice() {
 			Timeout(time.Second * 120).
 			End()
 		if errs != nil {
-			mcommon.Log.Errorf("err: [%T] %s", errs[0], errs[0].Error())
+			app.JobErrorf(ctx, "err: [%T] %s", errs[0], errs[0].Error())
 			return
 		}
 		if gresp.StatusCode != http.StatusOK {
 			// 状态错误
-			mcommon.Log.Errorf("req status error: %d", gresp.StatusCode)
+			app.JobErrorf(ctx, "req status error: %d", gresp.StatusCode)
 			return
 		}
 		var resp StRespGasPrice
 		err = json.Unmarshal([]byte(body), &resp)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		toUserGasPrice := resp.FastestFee
@@ -1751,7 +1999,7 @@ func CheckGasPrice() {
 			toColdGasPrice = maxValue
 		}
 		_, err = app.SQLUpdateTAppStatusIntByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			&model.DBTAppStatusInt{
 				K: "to_user_gas_price_btc",
@@ -1759,11 +2007,11 @@ func CheckGasPrice() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		_, err = app.SQLUpdateTAppStatusIntByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			&model.DBTAppStatusInt{
 				K: "to_cold_gas_price_btc",
@@ -1771,40 +2019,40 @@ func CheckGasPrice() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 	})
 }
 
 // OmniCheckBlockSeek 检测到账
-func OmniCheckBlockSeek() {
+func OmniCheckBlockSeek(ctx context.Context) {
 	lockKey := "OmniCheckBlockSeek"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取配置 延迟确认数
 		confirmValue, err := app.SQLGetTAppConfigIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			"btc_block_confirm_num",
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 获取状态 当前处理完成的最新的block number
 		seekValue, err := app.SQLGetTAppStatusIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			"omni_seek_num",
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 
-		rpcBlockNum, err := omniclient.RPCGetBlockCount()
+		rpcBlockNum, err := omniclient.RPCGetBlockCount(ctx)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		startI := seekValue + 1
@@ -1815,7 +2063,7 @@ func OmniCheckBlockSeek() {
 			var tokenIndexes []int64
 			tokenMap := make(map[int64]*model.DBTAppConfigTokenBtc)
 			tokenRows, err := app.SQLSelectTAppConfigTokenBtcColAll(
-				context.Background(),
+				ctx,
 				xenv.DbCon,
 				[]string{
 					model.DBColTAppConfigTokenBtcID,
@@ -1824,25 +2072,39 @@ func OmniCheckBlockSeek() {
 				},
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			for _, tokenRow := range tokenRows {
 				tokenIndexes = append(tokenIndexes, tokenRow.TokenIndex)
 				tokenMap[tokenRow.TokenIndex] = tokenRow
 			}
+			// 上一个已处理区块的hash，用于检测回滚
+			prevHash, err := getSavedBlockHash(ctx, xenv.DbCon, "omni_seek_num", startI-1)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
 			// 遍历获取需要查询的block信息
 			for i := startI; i < endI; i++ {
 				//mcommon.Log.Debugf("omni check block: %d", i)
-				blockHash, err := omniclient.RPCGetBlockHash(i)
+				blockHash, err := omniclient.RPCGetBlockHash(ctx, i)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				// 一个block
-				rpcBlock, err := omniclient.RPCGetBlockVerbose(blockHash)
+				rpcBlock, err := omniclient.RPCGetBlockVerbose(ctx, blockHash)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					return
+				}
+				if isReorg(prevHash, rpcBlock) {
+					// 父区块不匹配，发生了回滚
+					err = handleReorg(ctx, "omni_seek_num", i-1)
+					if err != nil {
+						app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					}
 					return
 				}
 				// 目标地址
@@ -1864,7 +2126,7 @@ func OmniCheckBlockSeek() {
 								vin,
 							)
 							if err != nil {
-								mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+								app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 								return
 							}
 							if len(vinAddresses) > 0 {
@@ -1898,7 +2160,7 @@ func OmniCheckBlockSeek() {
 				}
 				// 从db中查询这些地址是否是冲币地址中的地址
 				dbAddressRows, err := app.SQLSelectTAddressKeyColByAddress(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					[]string{
 						model.DBColTAddressKeyAddress,
@@ -1907,7 +2169,7 @@ func OmniCheckBlockSeek() {
 					toAddresses,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				now := time.Now().Unix()
@@ -1917,9 +2179,9 @@ func OmniCheckBlockSeek() {
 						// 非fee和hot地址
 						rpcTxes := toAddressTxMap[dbAddressRow.Address]
 						for _, rpcTx := range rpcTxes {
-							rpcTx, err := omniclient.RPCOmniGetTransaction(rpcTx.Txid)
+							rpcTx, err := omniclient.RPCOmniGetTransaction(ctx, rpcTx.Txid)
 							if err != nil {
-								mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+								app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 								return
 							}
 							// type_int 0 Simple Send
@@ -1930,6 +2192,7 @@ func OmniCheckBlockSeek() {
 										ProductID:    dbAddressRow.UseTag,
 										TokenIndex:   rpcTx.Propertyid,
 										TokenSymbol:  tokenRow.TokenSymbol,
+										BlockNumber:  rpcTx.Block,
 										BlockHash:    rpcTx.Blockhash,
 										TxID:         rpcTx.Txid,
 										FromAddress:  rpcTx.Sendingaddress,
@@ -1951,18 +2214,26 @@ func OmniCheckBlockSeek() {
 				}
 				// 插入tx数据
 				_, err = model.SQLCreateManyTTxBtcToken(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					txTokenRows,
 					true,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
+				app.JobAddCount(ctx, int64(len(txTokenRows)))
+				// 记录区块hash
+				err = saveBlock(ctx, xenv.DbCon, "omni_seek_num", rpcBlock)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					return
+				}
+				prevHash = rpcBlock.Hash
 				// 更新block num
 				_, err = app.SQLUpdateTAppStatusIntByKGreater(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					&model.DBTAppStatusInt{
 						K: "omni_seek_num",
@@ -1970,7 +2241,7 @@ func OmniCheckBlockSeek() {
 					},
 				)
 				if err != nil {
-					mcommon.Log.Errorf("SQLUpdateTAppStatusIntByK err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "SQLUpdateTAppStatusIntByK err: [%T] %s", err, err.Error())
 					return
 				}
 			}
@@ -1979,14 +2250,14 @@ func OmniCheckBlockSeek() {
 }
 
 // OmniCheckTxOrg 检测零钱整理
-func OmniCheckTxOrg() {
+func OmniCheckTxOrg(ctx context.Context) {
 	lockKey := "OmniCheckTxOrg"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 开始事物
 		isComment := false
-		dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+		dbTx, err := xenv.DbCon.BeginTxx(ctx, nil)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		defer func() {
@@ -1996,7 +2267,7 @@ func OmniCheckTxOrg() {
 		}()
 		// 查找需要整理的交易
 		txRows, err := app.SQLSelectTTxBtcTokenColByOrgStatusForUpdate(
-			context.Background(),
+			ctx,
 			dbTx,
 			[]string{
 				model.DBColTTxBtcTokenID,
@@ -2010,7 +2281,7 @@ func OmniCheckTxOrg() {
 			app.TxOrgStatusInit,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		type stOrgItem struct {
@@ -2035,7 +2306,7 @@ func OmniCheckTxOrg() {
 			}
 			balance, err := decimal.NewFromString(txRow.Value)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			orgItem.Balance += balance.Mul(decimal.NewFromInt(1e8)).IntPart()
@@ -2054,18 +2325,18 @@ func OmniCheckTxOrg() {
 		if len(orgMap) > 0 {
 			// 获取手续费配置
 			feePriceValue, err := app.SQLGetTAppStatusIntValueByK(
-				context.Background(),
+				ctx,
 				dbTx,
 				"to_cold_gas_price_btc",
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			tokenMap := make(map[int64]*model.DBTAppConfigTokenBtc)
 			var tokenFeeAddresses []string
 			tokenRows, err := app.SQLSelectTAppConfigTokenBtcColByIndexes(
-				context.Background(),
+				ctx,
 				dbTx,
 				[]string{
 					model.DBColTAppConfigTokenBtcID,
@@ -2078,7 +2349,7 @@ func OmniCheckTxOrg() {
 				tokenIndexes,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			for _, tokenRow := range tokenRows {
@@ -2094,17 +2365,17 @@ func OmniCheckTxOrg() {
 				}
 			}
 			addressWifMap, err := GetWifMapByAddresses(
-				context.Background(),
+				ctx,
 				dbTx,
 				keyAddresses,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			omniUxtoMap := make(map[string][]*model.DBTTxBtcUxto)
 			omniUxtoRows, err := app.SQLSelectTTxBtcUxtoColByAddressesAndTypeForUpdate(
-				context.Background(),
+				ctx,
 				dbTx,
 				[]string{
 					model.DBColTTxBtcUxtoID,
@@ -2118,7 +2389,7 @@ func OmniCheckTxOrg() {
 				app.UxtoTypeOmni,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			for _, omniUxtoRow := range omniUxtoRows {
@@ -2129,7 +2400,7 @@ func OmniCheckTxOrg() {
 			}
 			omniHotUxtoMap := make(map[string][]*model.DBTTxBtcUxto)
 			omniHotUxtoRows, err := app.SQLSelectTTxBtcUxtoColByAddressesAndTypeForUpdate(
-				context.Background(),
+				ctx,
 				dbTx,
 				[]string{
 					model.DBColTTxBtcUxtoID,
@@ -2143,7 +2414,7 @@ func OmniCheckTxOrg() {
 				app.UxtoTypeOmniOrgFee,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			for _, omniHotUxtoRow := range omniHotUxtoRows {
@@ -2160,26 +2431,26 @@ func OmniCheckTxOrg() {
 			for _, orgItem := range orgMap {
 				tokenRow, ok := tokenMap[orgItem.TokenIndex]
 				if !ok {
-					mcommon.Log.Errorf("no token: %d", orgItem.TokenIndex)
+					app.JobErrorf(ctx, "no token: %d", orgItem.TokenIndex)
 					break
 				}
 				omniUxtoRows, ok := omniUxtoMap[orgItem.Address]
 				if !ok {
-					mcommon.Log.Errorf("no omni uxto %s", orgItem.Address)
+					app.JobErrorf(ctx, "no omni uxto %s", orgItem.Address)
 					break
 				}
 				omniHotUxtoRows, ok := omniHotUxtoMap[tokenRow.FeeAddress]
 				if !ok {
-					mcommon.Log.Errorf("omni org fee limit")
+					app.JobErrorf(ctx, "omni org fee limit")
 					break
 				}
 				//mcommon.Log.Debugf("omniUxtoRows: %#v, omniHotUxtoRows: %#v", omniUxtoRows, omniHotUxtoRows)
 				if len(omniUxtoRows) <= 0 {
-					mcommon.Log.Errorf("omni org sender uxto limit")
+					app.JobErrorf(ctx, "omni org sender uxto limit")
 					break
 				}
 				if len(omniHotUxtoRows) <= 0 {
-					mcommon.Log.Errorf("omni org fee limit")
+					app.JobErrorf(ctx, "omni org fee limit")
 					break
 				}
 				omniHotUxtoIndex := 0
@@ -2203,14 +2474,14 @@ func OmniCheckTxOrg() {
 					// 输入金额
 					omniUxtoBalance, err := decimal.NewFromString(omniUxtoRows[0].VoutValue)
 					if err != nil {
-						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+						app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 						return
 					}
 					inBalance += omniUxtoBalance.Mul(decimal.NewFromInt(1e8)).IntPart()
 					for _, tmpUxtoHotRow := range tmpUxtoHotRows {
 						balance, err := decimal.NewFromString(tmpUxtoHotRow.VoutValue)
 						if err != nil {
-							mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+							app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 							return
 						}
 						inBalance += balance.Mul(decimal.NewFromInt(1e8)).IntPart()
@@ -2226,7 +2497,7 @@ func OmniCheckTxOrg() {
 					omniHotUxtoIndex++
 				}
 				if !isOmniInputOK {
-					mcommon.Log.Errorf("omni org fee limit")
+					app.JobErrorf(ctx, "omni org fee limit")
 					break
 				}
 				// 生成交易
@@ -2242,7 +2513,7 @@ func OmniCheckTxOrg() {
 					omniHotUxtoRows[:omniHotUxtoIndex+1],
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				txSize := tx.SerializeSize()
@@ -2250,7 +2521,7 @@ func OmniCheckTxOrg() {
 				b.Grow(txSize)
 				err = tx.Serialize(b)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				//mcommon.Log.Debugf("raw tx: %s", hex.EncodeToString(b.Bytes()))
@@ -2315,28 +2586,28 @@ func OmniCheckTxOrg() {
 			}
 			// 添加发送
 			_, err = model.SQLCreateManyTSendBtc(
-				context.Background(),
+				ctx,
 				dbTx,
 				sendRows,
 				true,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			// 更新uxto状态
 			_, err = app.SQLCreateManyTTxBtcUxtoUpdate(
-				context.Background(),
+				ctx,
 				dbTx,
 				usedUxtoRows,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			// 更新整理状态
 			_, err = app.SQLUpdateTTxBtcTokenOrgStatusByIDs(
-				context.Background(),
+				ctx,
 				dbTx,
 				sendTxIDs,
 				model.DBTTxBtcToken{
@@ -2346,13 +2617,14 @@ func OmniCheckTxOrg() {
 				},
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
+			app.JobAddCount(ctx, int64(len(sendTxIDs)))
 		}
 		err = dbTx.Commit()
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		isComment = true
@@ -2360,15 +2632,15 @@ func OmniCheckTxOrg() {
 }
 
 // OmniCheckWithdraw 检测提现
-func OmniCheckWithdraw() {
+func OmniCheckWithdraw(ctx context.Context) {
 	lockKey := "OmniCheckWithdraw"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		var symbols []string
 		var tokenHotAddresses []string
 		tokenMap := make(map[string]*model.DBTAppConfigTokenBtc)
 		tokenHotBalance := make(map[int64]int64)
 		tokenBtcRows, err := app.SQLSelectTAppConfigTokenBtcColAll(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTAppConfigTokenBtcID,
@@ -2378,7 +2650,7 @@ func OmniCheckWithdraw() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		for _, tokenRow := range tokenBtcRows {
@@ -2388,20 +2660,21 @@ func OmniCheckWithdraw() {
 				tokenHotAddresses = append(tokenHotAddresses, tokenRow.HotAddress)
 			}
 			balanceRealStr, err := omniclient.RPCOmniGetBalance(
+				ctx,
 				tokenRow.HotAddress,
 				tokenRow.TokenIndex,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			balance, err := RealStrToBalanceInt64(balanceRealStr.Balance)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			pendingRealStr, err := app.SQLGetTSendBtcPendingBalanceReal(
-				context.Background(),
+				ctx,
 				xenv.DbCon,
 				tokenRow.HotAddress,
 				tokenRow.TokenIndex,
@@ -2412,9 +2685,9 @@ func OmniCheckWithdraw() {
 		}
 		// 开始事物
 		isComment := false
-		dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+		dbTx, err := xenv.DbCon.BeginTxx(ctx, nil)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		defer func() {
@@ -2424,7 +2697,7 @@ func OmniCheckWithdraw() {
 		}()
 		// 获取提币信息
 		withdrawRows, err := app.SQLSelectTWithdrawColByStatusForUpdate(
-			context.Background(),
+			ctx,
 			dbTx,
 			[]string{
 				model.DBColTWithdrawID,
@@ -2438,7 +2711,7 @@ func OmniCheckWithdraw() {
 			symbols,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		if len(withdrawRows) == 0 {
@@ -2446,28 +2719,28 @@ func OmniCheckWithdraw() {
 		}
 		// 获取手续费配置
 		feePriceValue, err := app.SQLGetTAppStatusIntValueByK(
-			context.Background(),
+			ctx,
 			dbTx,
 			"to_user_gas_price_btc",
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 获取私钥
 		addressWifMap, err := GetWifMapByAddresses(
-			context.Background(),
+			ctx,
 			dbTx,
 			tokenHotAddresses,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 
 		omniHotUxtoMap := make(map[string][]*model.DBTTxBtcUxto)
 		omniHotUxtoRows, err := app.SQLSelectTTxBtcUxtoColByAddressesAndTypeForUpdate(
-			context.Background(),
+			ctx,
 			dbTx,
 			[]string{
 				model.DBColTTxBtcUxtoID,
@@ -2481,7 +2754,7 @@ func OmniCheckWithdraw() {
 			app.UxtoTypeOmniHot,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		for _, omniHotUxtoRow := range omniHotUxtoRows {
@@ -2497,29 +2770,29 @@ func OmniCheckWithdraw() {
 		for _, withdrawRow := range withdrawRows {
 			tokenRow, ok := tokenMap[withdrawRow.Symbol]
 			if !ok {
-				mcommon.Log.Errorf("no token: %s", withdrawRow.Symbol)
+				app.JobErrorf(ctx, "no token: %s", withdrawRow.Symbol)
 				return
 			}
 			// 检测token余额
 			withdrawBalance, err := RealStrToBalanceInt64(withdrawRow.BalanceReal)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			tmp := tokenHotBalance[tokenRow.TokenIndex] - withdrawBalance
 			if tmp < 0 {
-				mcommon.Log.Errorf("omni token balance limit %d", tokenRow.TokenIndex)
+				app.JobErrorf(ctx, "omni token balance limit %d", tokenRow.TokenIndex)
 				continue
 			}
 			tokenHotBalance[tokenRow.TokenIndex] -= withdrawBalance
 			omniHotUxtoRows, ok := omniHotUxtoMap[tokenRow.HotAddress]
 			if !ok {
-				mcommon.Log.Errorf("no omni hot %s", tokenRow.HotAddress)
+				app.JobErrorf(ctx, "no omni hot %s", tokenRow.HotAddress)
 				return
 			}
 			//mcommon.Log.Debugf("omniHotUxtoRows: %#v", omniHotUxtoRows)
 			if len(omniHotUxtoRows) <= 0 {
-				mcommon.Log.Errorf("no omni hot uxto limit %d", withdrawRow.ID)
+				app.JobErrorf(ctx, "no omni hot uxto limit %d", withdrawRow.ID)
 				continue
 			}
 			omniHotUxtoIndex := 0
@@ -2536,7 +2809,7 @@ func OmniCheckWithdraw() {
 					true,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				fee := txSize * feePriceValue
@@ -2548,7 +2821,7 @@ func OmniCheckWithdraw() {
 				for _, tmpUxtoHotRow := range tmpUxtoHotRows {
 					balance, err := decimal.NewFromString(tmpUxtoHotRow.VoutValue)
 					if err != nil {
-						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+						app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 						return
 					}
 					inBalance += balance.Mul(decimal.NewFromInt(1e8)).IntPart()
@@ -2564,13 +2837,13 @@ func OmniCheckWithdraw() {
 				omniHotUxtoIndex++
 			}
 			if !isOmniInputOK {
-				mcommon.Log.Errorf("omni withdraw fee limit")
+				app.JobErrorf(ctx, "omni withdraw fee limit")
 				break
 			}
 			// 生成交易
 			balance, err := decimal.NewFromString(withdrawRow.BalanceReal)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			tx, err := OmniTxMake(
@@ -2585,7 +2858,7 @@ func OmniCheckWithdraw() {
 				omniHotUxtoRows[1:omniHotUxtoIndex+1],
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			txSize := tx.SerializeSize()
@@ -2593,7 +2866,7 @@ func OmniCheckWithdraw() {
 			b.Grow(txSize)
 			err = tx.Serialize(b)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			//mcommon.Log.Debugf("raw tx: %s", hex.EncodeToString(b.Bytes()))
@@ -2641,39 +2914,40 @@ func OmniCheckWithdraw() {
 		}
 		// 插入发送
 		_, err = model.SQLCreateManyTSendBtc(
-			context.Background(),
+			ctx,
 			dbTx,
 			sendRows,
 			true,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新uxto
 		_, err = app.SQLCreateManyTTxBtcUxtoUpdate(
-			context.Background(),
+			ctx,
 			dbTx,
 			updateUxtoRows,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新提币
 		_, err = app.SQLCreateManyTWithdrawUpdate(
-			context.Background(),
+			ctx,
 			dbTx,
 			updateWithdraws,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
+		app.JobAddCount(ctx, int64(len(updateWithdraws)))
 		// 提交事物
 		err = dbTx.Commit()
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		isComment = true
@@ -2681,17 +2955,19 @@ func OmniCheckWithdraw() {
 }
 
 // OmniCheckTxNotify 创建omni冲币通知
-func OmniCheckTxNotify() {
+func OmniCheckTxNotify(ctx context.Context) {
 	lockKey := "OmniCheckTxNotify"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		txRows, err := app.SQLSelectTTxBtcTokenColByHandleStatus(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTTxBtcTokenID,
 				model.DBColTTxBtcTokenProductID,
 				model.DBColTTxBtcTokenTokenIndex,
 				model.DBColTTxBtcTokenTokenSymbol,
+				model.DBColTTxBtcTokenBlockNumber,
+				model.DBColTTxBtcTokenBlockHash,
 				model.DBColTTxBtcTokenTxID,
 				model.DBColTTxBtcTokenFromAddress,
 				model.DBColTTxBtcTokenToAddress,
@@ -2700,7 +2976,16 @@ func OmniCheckTxNotify() {
 			app.TxStatusInit,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(txRows) == 0 {
+			return
+		}
+		// 当前高度 用于计算确认数
+		rpcBlockNum, err := omniclient.RPCGetBlockCount(ctx)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		var productIDs []int64
@@ -2710,18 +2995,19 @@ func OmniCheckTxNotify() {
 			}
 		}
 		productMap, err := app.SQLGetProductMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTProductID,
 				model.DBColTProductAppName,
 				model.DBColTProductCbURL,
 				model.DBColTProductAppSk,
+				model.DBColTProductNotifyVersion,
 			},
 			productIDs,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		var notifyTxIDs []int64
@@ -2734,49 +3020,44 @@ func OmniCheckTxNotify() {
 				notifyTxIDs = append(notifyTxIDs, txRow.ID)
 				continue
 			}
-			nonce := mcommon.GetUUIDStr()
-			reqObj := gin.H{
-				"tx_hash":     txRow.TxID,
-				"app_name":    productRow.AppName,
-				"address":     txRow.ToAddress,
-				"balance":     txRow.Value,
-				"symbol":      txRow.TokenSymbol,
-				"notify_type": app.NotifyTypeTx,
-			}
-			reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
-			req, err := json.Marshal(reqObj)
+			notifyRow, err := app.GetNotifyRow(
+				productRow,
+				&app.StNotifyData{
+					ProductID:     txRow.ProductID,
+					ItemType:      app.SendRelationTypeTx,
+					ItemID:        txRow.ID,
+					NotifyType:    app.NotifyTypeTx,
+					Symbol:        txRow.TokenSymbol,
+					TxHash:        txRow.TxID,
+					Address:       txRow.ToAddress,
+					FromAddress:   txRow.FromAddress,
+					Balance:       txRow.Value,
+					TokenIndex:    txRow.TokenIndex,
+					BlockNumber:   txRow.BlockNumber,
+					BlockHash:     txRow.BlockHash,
+					Confirmations: rpcBlockNum - txRow.BlockNumber + 1,
+				},
+				now,
+			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				continue
 			}
-			notifyRows = append(notifyRows, &model.DBTProductNotify{
-				Nonce:        nonce,
-				ProductID:    txRow.ProductID,
-				ItemType:     app.SendRelationTypeTx,
-				ItemID:       txRow.ID,
-				NotifyType:   app.NotifyTypeTx,
-				TokenSymbol:  txRow.TokenSymbol,
-				URL:          productRow.CbURL,
-				Msg:          string(req),
-				HandleStatus: app.NotifyStatusInit,
-				HandleMsg:    "",
-				CreateTime:   now,
-				UpdateTime:   now,
-			})
+			notifyRows = append(notifyRows, notifyRow)
 			notifyTxIDs = append(notifyTxIDs, txRow.ID)
 		}
 		_, err = model.SQLCreateManyTProductNotify(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			notifyRows,
 			true,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		_, err = app.SQLUpdateTTxBtcTokenHandleStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			notifyTxIDs,
 			model.DBTTxBtcToken{
@@ -2786,32 +3067,33 @@ func OmniCheckTxNotify() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
+		app.JobAddCount(ctx, int64(len(notifyTxIDs)))
 	})
 
 }
 
 // CheckBlockSeekHotAndFee 检测到账
-func CheckBlockSeekHotAndFee() {
+func CheckBlockSeekHotAndFee(ctx context.Context) {
 	lockKey := "BtcCheckBlockSeekHotAndFee"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取状态 当前处理完成的最新的block number
 		seekValue, err := app.SQLGetTAppStatusIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			"btc_hot_fee_seek_num",
 		)
 		if err != nil {
 			if strings.Contains(err.Error(), "no app status int of") {
-				rpcBlockNum, err := omniclient.RPCGetBlockCount()
+				rpcBlockNum, err := omniclient.RPCGetBlockCount(ctx)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				_, err = model.SQLCreateTAppStatusInt(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					&model.DBTAppStatusInt{
 						K: "btc_hot_fee_seek_num",
@@ -2820,13 +3102,13 @@ func CheckBlockSeekHotAndFee() {
 					true,
 				)
 			} else {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 		}
-		rpcBlockNum, err := omniclient.RPCGetBlockCount()
+		rpcBlockNum, err := omniclient.RPCGetBlockCount(ctx)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		startI := seekValue + 1
@@ -2834,19 +3116,19 @@ func CheckBlockSeekHotAndFee() {
 		if startI < endI {
 			// 获取btc热钱包地址
 			hotAddress, err := app.SQLGetTAppConfigStrValueByK(
-				context.Background(),
+				ctx,
 				xenv.DbCon,
 				"hot_wallet_address_btc",
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			// 获取所有token
 			var tokenHotAddresses []string
 			var tokenFeeAddresses []string
 			tokenRows, err := app.SQLSelectTAppConfigTokenBtcColAll(
-				context.Background(),
+				ctx,
 				xenv.DbCon,
 				[]string{
 					model.DBColTAppConfigTokenBtcID,
@@ -2855,7 +3137,7 @@ func CheckBlockSeekHotAndFee() {
 				},
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			for _, tokenRow := range tokenRows {
@@ -2866,17 +3148,31 @@ func CheckBlockSeekHotAndFee() {
 					tokenFeeAddresses = append(tokenFeeAddresses, tokenRow.FeeAddress)
 				}
 			}
+			// 上一个已处理区块的hash，用于检测回滚
+			prevHash, err := getSavedBlockHash(ctx, xenv.DbCon, "btc_hot_fee_seek_num", startI-1)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
 			// 遍历获取需要查询的block信息
 			for curBlockNum := startI; curBlockNum < endI; curBlockNum++ {
-				blockHash, err := omniclient.RPCGetBlockHash(curBlockNum)
+				blockHash, err := omniclient.RPCGetBlockHash(ctx, curBlockNum)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				// 一个block
-				rpcBlock, err := omniclient.RPCGetBlockVerbose(blockHash)
+				rpcBlock, err := omniclient.RPCGetBlockVerbose(ctx, blockHash)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					return
+				}
+				if isReorg(prevHash, rpcBlock) {
+					// 父区块不匹配，发生了回滚
+					err = handleReorg(ctx, "btc_hot_fee_seek_num", curBlockNum-1)
+					if err != nil {
+						app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					}
 					return
 				}
 				// 所有输入数据
@@ -2950,7 +3246,7 @@ func CheckBlockSeekHotAndFee() {
 								vin,
 							)
 							if err != nil {
-								mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+								app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 								return
 							}
 							if len(vinAddresses) > 0 {
@@ -3022,7 +3318,7 @@ func CheckBlockSeekHotAndFee() {
 				// 从uxto中查询txhash
 				var updateUxtoRows []*model.DBTTxBtcUxto
 				uxtoRows, err := app.SQLSelectTTxBtcUxtoColByTxIDs(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					[]string{
 						model.DBColTTxBtcUxtoID,
@@ -3032,7 +3328,7 @@ func CheckBlockSeekHotAndFee() {
 					vinTxHashes,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				for _, uxtoRow := range uxtoRows {
@@ -3053,28 +3349,36 @@ func CheckBlockSeekHotAndFee() {
 				}
 				// 创建uxto
 				_, err = model.SQLCreateManyTTxBtcUxto(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					txBtcUxtoRows,
 					true,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
+				app.JobAddCount(ctx, int64(len(txBtcUxtoRows)))
 				// 更新uxto状态
 				_, err = app.SQLCreateManyTTxBtcUxtoUpdate(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					updateUxtoRows,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					return
+				}
+				// 记录区块hash
+				err = saveBlock(ctx, xenv.DbCon, "btc_hot_fee_seek_num", rpcBlock)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
+				prevHash = rpcBlock.Hash
 				// 更新block num
 				_, err = app.SQLUpdateTAppStatusIntByKGreater(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					&model.DBTAppStatusInt{
 						K: "btc_hot_fee_seek_num",
@@ -3082,7 +3386,7 @@ func CheckBlockSeekHotAndFee() {
 					},
 				)
 				if err != nil {
-					mcommon.Log.Errorf("SQLUpdateTAppStatusIntByK err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "SQLUpdateTAppStatusIntByK err: [%T] %s", err, err.Error())
 					return
 				}
 			}
@@ -10,6 +10,7 @@ import (
 	"go-dc-wallet/omniclient"
 	"go-dc-wallet/xenv"
 	"math"
+	"strings"
 
 	"github.com/moremorefun/mcommon"
 	"github.com/shopspring/decimal"
@@ -50,6 +51,24 @@ var network = map[string]Network{
 	"btc-test": {Params: &chaincfg.TestNet3Params},
 }
 
+// sendRejectedErrors 交易本身无效、重试也无法发送的错误，
+// 内存池冲突可能是之前的广播，与手续费不足一样继续重试
+var sendRejectedErrors = []string{
+	"bad-txns",
+	"mandatory-script-verify-flag",
+	"dust",
+}
+
+// IsSendRejected 判断交易是否被节点拒绝
+func IsSendRejected(err error) bool {
+	for _, msg := range sendRejectedErrors {
+		if strings.Contains(err.Error(), msg) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetNetwork 获取对象
 func GetNetwork(coinType string) Network {
 	n, ok := network[coinType]
@@ -0,0 +1,335 @@
+package hbtc
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/model"
+	"go-dc-wallet/omniclient"
+	"go-dc-wallet/xenv"
+	"time"
+
+	"github.com/moremorefun/mcommon"
+)
+
+// BlockKeepNum 保留的区块hash记录数，超过该深度的回滚无法自动处理
+const BlockKeepNum = 1000
+
+// getSavedBlockHash 获取检测任务已记录的区块hash，没有记录时返回空字符串
+// 各检测任务进度不同，seekKey 为任务的进度键，只读取该任务自己记录的hash
+func getSavedBlockHash(ctx context.Context, tx mcommon.DbExeAble, seekKey string, blockNumber int64) (string, error) {
+	blockRow, err := model.SQLGetTBtcBlockColKV(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTBtcBlockBlockHash,
+		},
+		[]string{
+			model.DBColShortTBtcBlockSeekKey,
+			model.DBColShortTBtcBlockBlockNumber,
+		},
+		[]interface{}{
+			seekKey,
+			blockNumber,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	if blockRow == nil {
+		return "", nil
+	}
+	return blockRow.BlockHash, nil
+}
+
+// saveBlock 记录检测任务已处理的区块hash，并清理过旧的记录
+func saveBlock(ctx context.Context, tx mcommon.DbExeAble, seekKey string, block *omniclient.StBlockResult) error {
+	_, err := model.SQLCreateTBtcBlockDuplicate(
+		ctx,
+		tx,
+		&model.DBTBtcBlock{
+			SeekKey:     seekKey,
+			BlockNumber: block.Height,
+			BlockHash:   block.Hash,
+			ParentHash:  block.Previousblockhash,
+			CreateTime:  time.Now().Unix(),
+		},
+		[]string{
+			model.DBColShortTBtcBlockBlockHash,
+			model.DBColShortTBtcBlockParentHash,
+			model.DBColShortTBtcBlockCreateTime,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if block.Height%100 == 0 {
+		_, err = app.SQLDeleteTBtcBlockByBlockNumberLess(
+			ctx,
+			tx,
+			seekKey,
+			block.Height-BlockKeepNum,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isReorg 区块的父hash与已记录的上一个区块不同时视为发生回滚
+func isReorg(prevHash string, block *omniclient.StBlockResult) bool {
+	return prevHash != "" && block.Previousblockhash != prevHash
+}
+
+// handleReorg 检测任务已记录的区块 blockNumber 不在主链上，
+// 向前查找分叉点，作废分叉后区块中的充币和uxto，该任务的检测进度回退到分叉点
+// 其他检测任务记录了相同的分叉区块时，由其自身检测到回滚后回退
+func handleReorg(ctx context.Context, seekKey string, blockNumber int64) error {
+	forkNum := blockNumber
+	for {
+		savedHash, err := getSavedBlockHash(ctx, xenv.DbCon, seekKey, forkNum)
+		if err != nil {
+			return err
+		}
+		if savedHash == "" {
+			return fmt.Errorf("btc reorg deeper than saved blocks at: %d", forkNum)
+		}
+		rpcHash, err := omniclient.RPCGetBlockHash(ctx, forkNum)
+		if err != nil {
+			return err
+		}
+		if rpcHash == savedHash {
+			break
+		}
+		forkNum--
+	}
+	mcommon.Log.Warnf("btc reorg of %s at: %d, rewind to: %d", seekKey, forkNum+1, forkNum)
+	return mcommon.DbTransaction(ctx, xenv.DbCon, func(tx mcommon.DbExeAble) error {
+		// 被回滚的区块
+		blockRows, err := app.SQLSelectTBtcBlockColByBlockNumberGreater(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTBtcBlockBlockHash,
+			},
+			seekKey,
+			forkNum,
+		)
+		if err != nil {
+			return err
+		}
+		var blockHashes []string
+		for _, blockRow := range blockRows {
+			blockHashes = append(blockHashes, blockRow.BlockHash)
+		}
+		txRows, err := app.SQLSelectTTxBtcColByBlockHashes(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTTxBtcID,
+				model.DBColTTxBtcProductID,
+				model.DBColTTxBtcBlockNumber,
+				model.DBColTTxBtcBlockHash,
+				model.DBColTTxBtcTxID,
+				model.DBColTTxBtcVoutN,
+				model.DBColTTxBtcVoutAddress,
+				model.DBColTTxBtcVoutValue,
+				model.DBColTTxBtcHandleStatus,
+			},
+			blockHashes,
+		)
+		if err != nil {
+			return err
+		}
+		txTokenRows, err := app.SQLSelectTTxBtcTokenColByBlockHashes(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTTxBtcTokenID,
+				model.DBColTTxBtcTokenProductID,
+				model.DBColTTxBtcTokenTokenIndex,
+				model.DBColTTxBtcTokenTokenSymbol,
+				model.DBColTTxBtcTokenBlockNumber,
+				model.DBColTTxBtcTokenBlockHash,
+				model.DBColTTxBtcTokenTxID,
+				model.DBColTTxBtcTokenFromAddress,
+				model.DBColTTxBtcTokenToAddress,
+				model.DBColTTxBtcTokenValue,
+				model.DBColTTxBtcTokenHandleStatus,
+			},
+			blockHashes,
+		)
+		if err != nil {
+			return err
+		}
+		var productIDs []int64
+		for _, txRow := range txRows {
+			if !mcommon.IsIntInSlice(productIDs, txRow.ProductID) {
+				productIDs = append(productIDs, txRow.ProductID)
+			}
+		}
+		for _, txRow := range txTokenRows {
+			if !mcommon.IsIntInSlice(productIDs, txRow.ProductID) {
+				productIDs = append(productIDs, txRow.ProductID)
+			}
+		}
+		productMap, err := app.SQLGetProductMap(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTProductID,
+				model.DBColTProductAppName,
+				model.DBColTProductCbURL,
+				model.DBColTProductAppSk,
+				model.DBColTProductNotifyVersion,
+			},
+			productIDs,
+		)
+		if err != nil {
+			return err
+		}
+		// 已通知的充币发送回滚通知
+		var notifyRows []*model.DBTProductNotify
+		var txIDs []int64
+		var txTokenIDs []int64
+		now := time.Now().Unix()
+		for _, txRow := range txRows {
+			txIDs = append(txIDs, txRow.ID)
+			if txRow.HandleStatus != app.TxStatusNotify {
+				continue
+			}
+			productRow, ok := productMap[txRow.ProductID]
+			if !ok {
+				mcommon.Log.Warnf("no productMap: %d", txRow.ProductID)
+				continue
+			}
+			notifyRow, err := app.GetNotifyRow(
+				productRow,
+				&app.StNotifyData{
+					ProductID:   txRow.ProductID,
+					ItemType:    app.SendRelationTypeTx,
+					ItemID:      txRow.ID,
+					NotifyType:  app.NotifyTypeTxReorg,
+					Symbol:      CoinSymbol,
+					TxHash:      fmt.Sprintf("%s_%d", txRow.TxID, txRow.VoutN),
+					Address:     txRow.VoutAddress,
+					Balance:     txRow.VoutValue,
+					BlockNumber: txRow.BlockNumber,
+					BlockHash:   txRow.BlockHash,
+					Reason:      app.NotifyReasonReorg,
+				},
+				now,
+			)
+			if err != nil {
+				return err
+			}
+			notifyRows = append(notifyRows, notifyRow)
+		}
+		for _, txRow := range txTokenRows {
+			txTokenIDs = append(txTokenIDs, txRow.ID)
+			if txRow.HandleStatus != app.TxStatusNotify {
+				continue
+			}
+			productRow, ok := productMap[txRow.ProductID]
+			if !ok {
+				mcommon.Log.Warnf("no productMap: %d", txRow.ProductID)
+				continue
+			}
+			notifyRow, err := app.GetNotifyRow(
+				productRow,
+				&app.StNotifyData{
+					ProductID:   txRow.ProductID,
+					ItemType:    app.SendRelationTypeTx,
+					ItemID:      txRow.ID,
+					NotifyType:  app.NotifyTypeTxReorg,
+					Symbol:      txRow.TokenSymbol,
+					TxHash:      txRow.TxID,
+					Address:     txRow.ToAddress,
+					FromAddress: txRow.FromAddress,
+					Balance:     txRow.Value,
+					TokenIndex:  txRow.TokenIndex,
+					BlockNumber: txRow.BlockNumber,
+					BlockHash:   txRow.BlockHash,
+					Reason:      app.NotifyReasonReorg,
+				},
+				now,
+			)
+			if err != nil {
+				return err
+			}
+			notifyRows = append(notifyRows, notifyRow)
+		}
+		_, err = model.SQLCreateManyTProductNotify(
+			ctx,
+			tx,
+			notifyRows,
+			true,
+		)
+		if err != nil {
+			return err
+		}
+		_, err = app.SQLUpdateTTxBtcReorgByIDs(
+			ctx,
+			tx,
+			txIDs,
+			now,
+		)
+		if err != nil {
+			return err
+		}
+		_, err = app.SQLUpdateTTxBtcTokenReorgByIDs(
+			ctx,
+			tx,
+			txTokenIDs,
+			now,
+		)
+		if err != nil {
+			return err
+		}
+		// 未使用的uxto直接删除，区块重新打包时会重新记录
+		_, err = app.SQLDeleteTTxBtcUxtoUnspentByBlockHashes(
+			ctx,
+			tx,
+			blockHashes,
+		)
+		if err != nil {
+			return err
+		}
+		// 已使用的uxto标记后报警
+		spentCount, err := app.SQLUpdateTTxBtcUxtoReorgByBlockHashes(
+			ctx,
+			tx,
+			blockHashes,
+			now,
+		)
+		if err != nil {
+			return err
+		}
+		if spentCount > 0 {
+			mcommon.Log.Warnf("btc reorg spent uxto count: %d", spentCount)
+		}
+		_, err = app.SQLDeleteTBtcBlockByBlockNumberGreater(
+			ctx,
+			tx,
+			seekKey,
+			forkNum,
+		)
+		if err != nil {
+			return err
+		}
+		_, err = app.SQLUpdateTAppStatusIntByKLess(
+			ctx,
+			tx,
+			&model.DBTAppStatusInt{
+				K: seekKey,
+				V: forkNum,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		app.JobAddCount(ctx, int64(len(txIDs)+len(txTokenIDs)))
+		return nil
+	})
+}
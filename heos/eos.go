@@ -16,31 +16,30 @@ import (
 	"github.com/moremorefun/mcommon"
 	"github.com/shopspring/decimal"
 
-	"github.com/gin-gonic/gin"
 )
 
 // CheckAddressFree 检测剩余地址数
-func CheckAddressFree() {
+func CheckAddressFree(ctx context.Context) {
 	lockKey := "EosCheckAddressFree"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取配置 允许的最小剩余地址数
 		minFreeValue, err := app.SQLGetTAppConfigIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			"min_free_address",
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 获取当前剩余可用地址数
 		freeCount, err := app.SQLGetTAddressKeyFreeCount(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			CoinSymbol,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 如果数据库中剩余可用地址小于最小允许可用地址
@@ -48,7 +47,7 @@ func CheckAddressFree() {
 			var rows []*model.DBTAddressKey
 			// 获取最大值
 			maxAddress, err := app.SQLGetTAddressMaxIntOfEos(
-				context.Background(),
+				ctx,
 				xenv.DbCon,
 			)
 			if maxAddress < MiniAddress {
@@ -67,36 +66,37 @@ func CheckAddressFree() {
 			}
 			// 一次性将生成的地址存入数据库
 			_, err = model.SQLCreateManyTAddressKey(
-				context.Background(),
+				ctx,
 				xenv.DbCon,
 				rows,
 				true,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
+			app.JobAddCount(ctx, int64(len(rows)))
 		}
 	})
 }
 
 // CheckBlockSeek 检测到账
-func CheckBlockSeek() {
+func CheckBlockSeek(ctx context.Context) {
 	lockKey := "EosCheckBlockSeek"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取状态 当前处理完成的最新的block number
 		seekValue, err := app.SQLGetTAppStatusIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			"eos_seek_num",
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
-		rpcChainInfo, err := eosclient.RPCChainGetInfo()
+		rpcChainInfo, err := eosclient.RPCChainGetInfo(ctx)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		startI := seekValue + 1
@@ -105,23 +105,40 @@ func CheckBlockSeek() {
 		if startI < endI {
 			// 获取冷钱包地址
 			eosColdAddressValue, err := app.SQLGetTAppConfigStrValueByK(
-				context.Background(),
+				ctx,
 				xenv.DbCon,
 				"cold_wallet_address_eos",
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
+			// 并发预取block信息
+			concurrency, err := app.GetBlockFetchConcurrency(ctx)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
+			fetcher := app.NewBlockFetcher(
+				ctx,
+				startI,
+				endI,
+				concurrency,
+				func(ctx context.Context, num int64) (interface{}, error) {
+					return eosclient.RPCChainGetBlock(ctx, num)
+				},
+			)
+			defer fetcher.Close()
 			// 遍历获取需要查询的block信息
 			now := time.Now().Unix()
 			for i := startI; i < endI; i++ {
 				mcommon.Log.Debugf("eos check block: %d", i)
-				rpcBlock, err := eosclient.RPCChainGetBlock(i)
+				fetchBlock, err := fetcher.Get(i)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
+				rpcBlock := fetchBlock.(*eosclient.StBlock)
 				var memos []string
 				type stAction struct {
 					rpcTrx        eosclient.StTransactionTrx
@@ -159,7 +176,7 @@ func CheckBlockSeek() {
 						if err != nil {
 							_, ok := err.(*json.UnmarshalTypeError)
 							if !ok {
-								mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+								app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 								return
 							} else {
 								continue
@@ -182,7 +199,7 @@ func CheckBlockSeek() {
 								}
 								rpcActionData.Quantity, err = EosValueToStr(rpcActionData.Quantity)
 								if err != nil {
-									mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+									app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 									return
 								}
 								memosMap[rpcActionData.Memo] = append(
@@ -199,7 +216,7 @@ func CheckBlockSeek() {
 				}
 				// 从db中查询这些地址是否是冲币地址中的地址
 				dbAddressRows, err := app.SQLSelectTAddressKeyColByAddress(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					[]string{
 						model.DBColTAddressKeyAddress,
@@ -208,7 +225,7 @@ func CheckBlockSeek() {
 					memos,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				var txRows []*model.DBTTxEos
@@ -219,6 +236,8 @@ func CheckBlockSeek() {
 							txRows,
 							&model.DBTTxEos{
 								ProductID:    dbAddressRow.UseTag,
+								BlockNumber:  i,
+								BlockHash:    rpcBlock.ID,
 								TxHash:       tAction.rpcTrx.ID,
 								LogIndex:     tAction.actionIndex,
 								FromAddress:  tAction.rpcActionData.From,
@@ -234,18 +253,19 @@ func CheckBlockSeek() {
 					}
 				}
 				_, err = model.SQLCreateManyTTxEos(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					txRows,
 					true,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
+				app.JobAddCount(ctx, int64(len(txRows)))
 				// 更新block num
 				_, err = app.SQLUpdateTAppStatusIntByKGreater(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					&model.DBTAppStatusInt{
 						K: "eos_seek_num",
@@ -253,7 +273,7 @@ func CheckBlockSeek() {
 					},
 				)
 				if err != nil {
-					mcommon.Log.Errorf("SQLUpdateTAppStatusIntByK err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "SQLUpdateTAppStatusIntByK err: [%T] %s", err, err.Error())
 					return
 				}
 			}
@@ -262,15 +282,17 @@ func CheckBlockSeek() {
 }
 
 // CheckTxNotify 创建冲币通知
-func CheckTxNotify() {
+func CheckTxNotify(ctx context.Context) {
 	lockKey := "EosCheckTxNotify"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		txRows, err := app.SQLSelectTTxEosColByStatus(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTTxEosID,
 				model.DBColTTxEosProductID,
+				model.DBColTTxEosBlockNumber,
+				model.DBColTTxEosBlockHash,
 				model.DBColTTxEosTxHash,
 				model.DBColTTxEosLogIndex,
 				model.DBColTTxEosFromAddress,
@@ -281,7 +303,16 @@ func CheckTxNotify() {
 			app.TxStatusInit,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(txRows) == 0 {
+			return
+		}
+		// 当前高度 用于计算确认数
+		rpcChainInfo, err := eosclient.RPCChainGetInfo(ctx)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		var productIDs []int64
@@ -291,18 +322,19 @@ func CheckTxNotify() {
 			}
 		}
 		productMap, err := app.SQLGetProductMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTProductID,
 				model.DBColTProductAppName,
 				model.DBColTProductCbURL,
 				model.DBColTProductAppSk,
+				model.DBColTProductNotifyVersion,
 			},
 			productIDs,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		var notifyTxIDs []int64
@@ -315,50 +347,44 @@ func CheckTxNotify() {
 				notifyTxIDs = append(notifyTxIDs, txRow.ID)
 				continue
 			}
-			nonce := mcommon.GetUUIDStr()
-			reqObj := gin.H{
-				"tx_hash":     fmt.Sprintf("%s_%d", txRow.TxHash, txRow.LogIndex),
-				"app_name":    productRow.AppName,
-				"address":     txRow.ToAddress,
-				"memo":        txRow.Memo,
-				"balance":     txRow.BalanceReal,
-				"symbol":      CoinSymbol,
-				"notify_type": app.NotifyTypeTx,
-			}
-			reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
-			req, err := json.Marshal(reqObj)
+			notifyRow, err := app.GetNotifyRow(
+				productRow,
+				&app.StNotifyData{
+					ProductID:     txRow.ProductID,
+					ItemType:      app.SendRelationTypeTx,
+					ItemID:        txRow.ID,
+					NotifyType:    app.NotifyTypeTx,
+					Symbol:        CoinSymbol,
+					TxHash:        fmt.Sprintf("%s_%d", txRow.TxHash, txRow.LogIndex),
+					Address:       txRow.ToAddress,
+					FromAddress:   txRow.FromAddress,
+					Balance:       txRow.BalanceReal,
+					Memo:          txRow.Memo,
+					BlockNumber:   txRow.BlockNumber,
+					BlockHash:     txRow.BlockHash,
+					Confirmations: rpcChainInfo.HeadBlockNum - txRow.BlockNumber + 1,
+				},
+				now,
+			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				continue
 			}
-			notifyRows = append(notifyRows, &model.DBTProductNotify{
-				Nonce:        nonce,
-				ProductID:    txRow.ProductID,
-				ItemType:     app.SendRelationTypeTx,
-				ItemID:       txRow.ID,
-				NotifyType:   app.NotifyTypeTx,
-				TokenSymbol:  CoinSymbol,
-				URL:          productRow.CbURL,
-				Msg:          string(req),
-				HandleStatus: app.NotifyStatusInit,
-				HandleMsg:    "",
-				CreateTime:   now,
-				UpdateTime:   now,
-			})
+			notifyRows = append(notifyRows, notifyRow)
 			notifyTxIDs = append(notifyTxIDs, txRow.ID)
 		}
 		_, err = model.SQLCreateManyTProductNotify(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			notifyRows,
 			true,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		_, err = app.SQLUpdateTTxEosStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			notifyTxIDs,
 			model.DBTTxEos{
@@ -368,19 +394,20 @@ func CheckTxNotify() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
+		app.JobAddCount(ctx, int64(len(notifyTxIDs)))
 	})
 }
 
 // CheckWithdraw 检测提现
-func CheckWithdraw() {
+func CheckWithdraw(ctx context.Context) {
 	lockKey := "EosCheckWithdraw"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取需要处理的提币数据
 		withdrawRows, err := app.SQLSelectTWithdrawColByStatus(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTWithdrawID,
@@ -389,7 +416,7 @@ func CheckWithdraw() {
 			[]string{CoinSymbol},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		if len(withdrawRows) == 0 {
@@ -398,80 +425,82 @@ func CheckWithdraw() {
 		}
 		// 获取热钱包地址
 		hotAddressValue, err := app.SQLGetTAppConfigStrValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			"hot_wallet_address_eos",
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 获取热钱包私钥
 		hotKeyValue, err := app.SQLGetTAppConfigStrValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			"hot_wallet_key_eos",
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		key, err := mcommon.AesDecrypt(hotKeyValue, xenv.Cfg.AESKey)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		if len(key) == 0 {
-			mcommon.Log.Errorf("error key of eos")
+			app.JobErrorf(ctx, "error key of eos")
 			return
 		}
 		// 获取热钱包余额
 		rpcAccount, err := eosclient.RPCChainGetAccount(
+			ctx,
 			hotAddressValue,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		rpcHotBalance, err := EosValueToDecimal(rpcAccount.CoreLiquidBalance)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		pendingBalanceRealStr, err := app.SQLGetTSendEosPendingBalanceReal(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			hotAddressValue,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		pendingBalanceReal, err := StrToEosDecimal(pendingBalanceRealStr)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		rpcHotBalance = rpcHotBalance.Sub(pendingBalanceReal)
 		// 获取链信息
-		rpcChainInfo, err := eosclient.RPCChainGetInfo()
+		rpcChainInfo, err := eosclient.RPCChainGetInfo(ctx)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		for _, withdrawRow := range withdrawRows {
-			err = handleWithdraw(rpcChainInfo, withdrawRow.ID, hotAddressValue, key, &rpcHotBalance)
+			err = handleWithdraw(ctx, rpcChainInfo, withdrawRow.ID, hotAddressValue, key, &rpcHotBalance)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				continue
 			}
+			app.JobAddCount(ctx, 1)
 		}
 	})
 }
 
-func handleWithdraw(rpcChainInfo *eosclient.StChainGetInfo, withdrawID int64, hotAddressValue string, hotKey string, hotBalance *decimal.Decimal) error {
+func handleWithdraw(ctx context.Context, rpcChainInfo *eosclient.StChainGetInfo, withdrawID int64, hotAddressValue string, hotKey string, hotBalance *decimal.Decimal) error {
 	isComment := false
-	dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+	dbTx, err := xenv.DbCon.BeginTxx(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -482,7 +511,7 @@ func handleWithdraw(rpcChainInfo *eosclient.StChainGetInfo, withdrawID int64, ho
 	}()
 	// 处理业务
 	withdrawRow, err := app.SQLGetTWithdrawColForUpdate(
-		context.Background(),
+		ctx,
 		dbTx,
 		[]string{
 			model.DBColTWithdrawID,
@@ -501,19 +530,19 @@ func handleWithdraw(rpcChainInfo *eosclient.StChainGetInfo, withdrawID int64, ho
 	}
 	withdrawBalance, err := StrToEosDecimal(withdrawRow.BalanceReal)
 	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 		return nil
 	}
 	*hotBalance = (*hotBalance).Sub(withdrawBalance)
 	if (*hotBalance).Cmp(decimal.NewFromInt(0)) < 0 {
 		// 金额不够
-		mcommon.Log.Errorf("eos hot balance limit")
+		app.JobErrorf(ctx, "eos hot balance limit")
 		*hotBalance = (*hotBalance).Add(withdrawBalance)
 		return nil
 	}
 	eosAesset, err := eos.NewEOSAssetFromString(withdrawRow.BalanceReal)
 	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 		return err
 	}
 	action := token.NewTransfer(
@@ -527,12 +556,12 @@ func handleWithdraw(rpcChainInfo *eosclient.StChainGetInfo, withdrawID int64, ho
 	// 设置tx属性
 	chainID, err := hex.DecodeString(rpcChainInfo.ChainID)
 	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 		return err
 	}
 	headBlockID, err := hex.DecodeString(rpcChainInfo.HeadBlockID)
 	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 		return err
 	}
 	opts := &eos.TxOptions{
@@ -556,39 +585,39 @@ func handleWithdraw(rpcChainInfo *eosclient.StChainGetInfo, withdrawID int64, ho
 	kb := eos.NewKeyBag()
 	err = kb.Add(hotKey)
 	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 		return err
 	}
 	keys, err := kb.AvailableKeys()
 	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 		return err
 	}
 	_, err = kb.Sign(signTx, chainID, keys[0])
 	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 		return err
 	}
 	// 打包tx
 	packedTx, err := signTx.Pack(eos.CompressionNone)
 	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 		return err
 	}
 	packedTxBs, err := json.Marshal(packedTx)
 	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 		return err
 	}
 	packedHash, err := packedTx.ID()
 	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 		return err
 	}
 	txHash := packedHash.String()
 	now := time.Now().Unix()
 	_, err = app.SQLUpdateTWithdrawGenTx(
-		context.Background(),
+		ctx,
 		dbTx,
 		&model.DBTWithdraw{
 			ID:           withdrawID,
@@ -602,7 +631,7 @@ func handleWithdraw(rpcChainInfo *eosclient.StChainGetInfo, withdrawID int64, ho
 		return err
 	}
 	_, err = model.SQLCreateTSendEos(
-		context.Background(),
+		ctx,
 		dbTx,
 		&model.DBTSendEos{
 			WithdrawID:   withdrawID,
@@ -632,23 +661,24 @@ func handleWithdraw(rpcChainInfo *eosclient.StChainGetInfo, withdrawID int64, ho
 }
 
 // CheckRawTxSend 发送交易
-func CheckRawTxSend() {
+func CheckRawTxSend(ctx context.Context) {
 	lockKey := "EosCheckRawTxSend"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取待发送的数据
 		sendRows, err := app.SQLSelectTSendEosColByStatus(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTSendEosID,
 				model.DBColTSendEosTxHash,
+				model.DBColTSendEosFromAddress,
 				model.DBColTSendEosHex,
 				model.DBColTSendEosWithdrawID,
 			},
 			app.SendStatusInit,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 首先单独处理提币，提取提币通知要使用的数据
@@ -659,7 +689,7 @@ func CheckRawTxSend() {
 			}
 		}
 		withdrawMap, err := app.SQLGetWithdrawMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTWithdrawID,
@@ -668,6 +698,7 @@ func CheckRawTxSend() {
 				model.DBColTWithdrawToAddress,
 				model.DBColTWithdrawBalanceReal,
 				model.DBColTWithdrawSymbol,
+				model.DBColTWithdrawMemo,
 			},
 			withdrawIDs,
 		)
@@ -679,13 +710,14 @@ func CheckRawTxSend() {
 			}
 		}
 		productMap, err := app.SQLGetProductMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTProductID,
 				model.DBColTProductAppName,
 				model.DBColTProductCbURL,
 				model.DBColTProductAppSk,
+				model.DBColTProductNotifyVersion,
 			},
 			productIDs,
 		)
@@ -703,62 +735,70 @@ func CheckRawTxSend() {
 			// 如果是提币，创建通知信息
 			withdrawRow, ok := withdrawMap[sendRow.WithdrawID]
 			if !ok {
-				mcommon.Log.Errorf("withdrawMap no: %d", sendRow.WithdrawID)
+				app.JobErrorf(ctx, "withdrawMap no: %d", sendRow.WithdrawID)
 				return nil
 			}
 			productRow, ok := productMap[withdrawRow.ProductID]
 			if !ok {
-				mcommon.Log.Errorf("productMap no: %d", withdrawRow.ProductID)
+				app.JobErrorf(ctx, "productMap no: %d", withdrawRow.ProductID)
 				return nil
 			}
-			nonce := mcommon.GetUUIDStr()
-			reqObj := gin.H{
-				"tx_hash":     sendRow.TxHash,
-				"balance":     withdrawRow.BalanceReal,
-				"app_name":    productRow.AppName,
-				"out_serial":  withdrawRow.OutSerial,
-				"address":     withdrawRow.ToAddress,
-				"symbol":      withdrawRow.Symbol,
-				"notify_type": app.NotifyTypeWithdrawSend,
-			}
-			reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
-			req, err := json.Marshal(reqObj)
+			notifyRow, err := app.GetNotifyRow(
+				productRow,
+				&app.StNotifyData{
+					ProductID:   withdrawRow.ProductID,
+					ItemType:    app.SendRelationTypeWithdraw,
+					ItemID:      withdrawRow.ID,
+					NotifyType:  app.NotifyTypeWithdrawSend,
+					Symbol:      withdrawRow.Symbol,
+					TxHash:      sendRow.TxHash,
+					Address:     withdrawRow.ToAddress,
+					FromAddress: sendRow.FromAddress,
+					Balance:     withdrawRow.BalanceReal,
+					OutSerial:   withdrawRow.OutSerial,
+					Memo:        withdrawRow.Memo,
+				},
+				now,
+			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return err
 			}
-			notifyRows = append(notifyRows, &model.DBTProductNotify{
-				Nonce:        nonce,
-				ProductID:    withdrawRow.ProductID,
-				ItemType:     app.SendRelationTypeWithdraw,
-				ItemID:       withdrawRow.ID,
-				NotifyType:   app.NotifyTypeWithdrawSend,
-				TokenSymbol:  withdrawRow.Symbol,
-				URL:          productRow.CbURL,
-				Msg:          string(req),
-				HandleStatus: app.NotifyStatusInit,
-				HandleMsg:    "",
-				CreateTime:   now,
-				UpdateTime:   now,
-			})
+			notifyRows = append(notifyRows, notifyRow)
 			withdrawIDs = append(withdrawIDs, withdrawRow.ID)
 			return nil
 		}
+		// 发送失败的数据
+		var failRows []*model.DBTSendEos
+		failMap := make(map[string]string)
 		for _, sendRow := range sendRows {
 			// 判定是否已经发送过
 			isSend := false
-			_, err := eosclient.RPCHistoryGetTransaction(sendRow.TxHash)
+			_, err := eosclient.RPCHistoryGetTransaction(ctx, sendRow.TxHash)
 			if err != nil {
 				rpcErr, ok := err.(*eosclient.StRPCRespError)
 				if !ok {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				if rpcErr.ErrorInv.Code == 3040011 {
 					// tx_not_found
 					// 还没有发送
+					if sendRow.Hex != "" {
+						expiration, err := GetTxExpiration(sendRow.Hex)
+						if err != nil {
+							app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+							continue
+						}
+						if time.Now().After(expiration) {
+							// 已经过期无法再发送
+							failMap[sendRow.TxHash] = fmt.Sprintf("tx expired at %s", expiration.Format(time.RFC3339))
+							failRows = append(failRows, sendRow)
+							continue
+						}
+					}
 				} else {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 			} else {
@@ -766,7 +806,7 @@ func CheckRawTxSend() {
 				isSend = true
 				err = onSendOk(sendRow)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				}
 			}
 			// 发送数据中需要排除占位数据
@@ -774,63 +814,72 @@ func CheckRawTxSend() {
 				var args eosclient.StPushTransactionArg
 				err := json.Unmarshal([]byte(sendRow.Hex), &args)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					continue
 				}
 				_, err = eosclient.RPCChainPushTransaction(
+					ctx,
 					args,
 				)
 				if err != nil {
 					rpcErr, ok := err.(*eosclient.StRPCRespError)
 					if !ok {
-						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+						app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 						continue
 					}
 					switch rpcErr.ErrorInv.Code {
 					case 3080001:
 						// account using more than allotted RAM usage
 						// rom 不足
-						mcommon.Log.Errorf("eos hot rom limit")
+						app.JobErrorf(ctx, "eos hot rom limit")
 						return
 					case 3080002:
 						// Transaction exceeded the current network usage limit imposed on the transaction
 						// net 不足
-						mcommon.Log.Errorf("eos hot net limit")
+						app.JobErrorf(ctx, "eos hot net limit")
 						return
 					case 3080004:
 						// Transaction exceeded the current CPU usage limit imposed on the transaction
 						// cpu 不足
-						mcommon.Log.Errorf("eos hot cpu limit")
+						app.JobErrorf(ctx, "eos hot cpu limit")
 						return
 					case 3040008:
 						// Duplicate transaction
 						// 已经发送
+					case 3040005, 3040007, 3050003, 3090003:
+						// expired_tx_exception invalid_ref_block_exception
+						// eosio_assert_message_exception unsatisfied_authorization
+						// 交易被拒绝
+						app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+						failMap[sendRow.TxHash] = fmt.Sprintf("send rejected: %d %s", rpcErr.ErrorInv.Code, rpcErr.ErrorInv.What)
+						failRows = append(failRows, sendRow)
+						continue
 					default:
-						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+						app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 						continue
 					}
 				}
 				err = onSendOk(sendRow)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 			}
 		}
 		// 插入通知
 		_, err = model.SQLCreateManyTProductNotify(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			notifyRows,
 			true,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新提币状态
 		_, err = app.SQLUpdateTWithdrawStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			withdrawIDs,
 			&model.DBTWithdraw{
@@ -840,12 +889,12 @@ func CheckRawTxSend() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新发送状态
 		_, err = app.SQLUpdateTSendEosStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			sendIDs,
 			model.DBTSendEos{
@@ -855,30 +904,38 @@ func CheckRawTxSend() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		app.JobAddCount(ctx, int64(len(sendIDs)))
+		// 处理发送失败的交易
+		err = handleSendFailed(ctx, failRows, failMap)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 	})
 }
 
 // CheckRawTxConfirm 确认tx是否打包完成
-func CheckRawTxConfirm() {
+func CheckRawTxConfirm(ctx context.Context) {
 	lockKey := "EosCheckRawTxConfirm"
-	app.LockWrap(lockKey, func() {
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取待发送的数据
 		sendRows, err := app.SQLSelectTSendEosColByStatus(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTSendEosID,
 				model.DBColTSendEosTxHash,
+				model.DBColTSendEosFromAddress,
 				model.DBColTSendEosHex,
 				model.DBColTSendEosWithdrawID,
 			},
 			app.SendStatusSend,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		var withdrawIDs []int64
@@ -889,7 +946,7 @@ func CheckRawTxConfirm() {
 			}
 		}
 		withdrawMap, err := app.SQLGetWithdrawMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTWithdrawID,
@@ -898,11 +955,12 @@ func CheckRawTxConfirm() {
 				model.DBColTWithdrawToAddress,
 				model.DBColTWithdrawBalanceReal,
 				model.DBColTWithdrawSymbol,
+				model.DBColTWithdrawMemo,
 			},
 			withdrawIDs,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		var productIDs []int64
@@ -912,18 +970,19 @@ func CheckRawTxConfirm() {
 			}
 		}
 		productMap, err := app.SQLGetProductMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTProductID,
 				model.DBColTProductAppName,
 				model.DBColTProductCbURL,
 				model.DBColTProductAppSk,
+				model.DBColTProductNotifyVersion,
 			},
 			productIDs,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 
@@ -931,55 +990,83 @@ func CheckRawTxConfirm() {
 		var notifyRows []*model.DBTProductNotify
 		var sendIDs []int64
 		withdrawIDs = []int64{}
+		// 过期未打包的数据
+		var failRows []*model.DBTSendEos
+		failMap := make(map[string]string)
 		for _, sendRow := range sendRows {
-			_, err := eosclient.RPCHistoryGetTransaction(
+			rpcTx, err := eosclient.RPCHistoryGetTransaction(
+				ctx,
 				sendRow.TxHash,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				rpcErr, ok := err.(*eosclient.StRPCRespError)
+				if ok && rpcErr.ErrorInv.Code == 3040011 && sendRow.Hex != "" {
+					// 过期后留出历史节点同步的时间
+					expiration, err := GetTxExpiration(sendRow.Hex)
+					if err != nil {
+						app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+						continue
+					}
+					if time.Now().After(expiration.Add(time.Minute * 10)) {
+						failMap[sendRow.TxHash] = fmt.Sprintf("tx expired at %s", expiration.Format(time.RFC3339))
+						failRows = append(failRows, sendRow)
+					}
+				}
 				continue
 			}
 			// 提币
 			withdrawRow, ok := withdrawMap[sendRow.WithdrawID]
 			if !ok {
-				mcommon.Log.Errorf("no withdrawMap: %d", sendRow.WithdrawID)
+				app.JobErrorf(ctx, "no withdrawMap: %d", sendRow.WithdrawID)
 				return
 			}
 			productRow, ok := productMap[withdrawRow.ProductID]
 			if !ok {
-				mcommon.Log.Errorf("no productMap: %d", withdrawRow.ProductID)
+				app.JobErrorf(ctx, "no productMap: %d", withdrawRow.ProductID)
 				return
 			}
-			nonce := mcommon.GetUUIDStr()
-			reqObj := gin.H{
-				"tx_hash":     sendRow.WithdrawID,
-				"balance":     withdrawRow.BalanceReal,
-				"app_name":    productRow.AppName,
-				"out_serial":  withdrawRow.OutSerial,
-				"address":     withdrawRow.ToAddress,
-				"symbol":      withdrawRow.Symbol,
-				"notify_type": app.NotifyTypeWithdrawConfirm,
+			// eos 转账不消耗手续费
+			withdrawRow.Fee = "0"
+			withdrawRow.BlockNumber = rpcTx.BlockNum
+			if len(rpcTx.Traces) > 0 {
+				withdrawRow.BlockHash = rpcTx.Traces[0].ProducerBlockID
+			}
+			_, err = app.SQLUpdateTWithdrawBlockByID(
+				ctx,
+				xenv.DbCon,
+				withdrawRow,
+			)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
 			}
-			reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
-			req, err := json.Marshal(reqObj)
+			notifyRow, err := app.GetNotifyRow(
+				productRow,
+				&app.StNotifyData{
+					ProductID:     withdrawRow.ProductID,
+					ItemType:      app.SendRelationTypeWithdraw,
+					ItemID:        withdrawRow.ID,
+					NotifyType:    app.NotifyTypeWithdrawConfirm,
+					Symbol:        withdrawRow.Symbol,
+					TxHash:        sendRow.TxHash,
+					Address:       withdrawRow.ToAddress,
+					FromAddress:   sendRow.FromAddress,
+					Balance:       withdrawRow.BalanceReal,
+					OutSerial:     withdrawRow.OutSerial,
+					Memo:          withdrawRow.Memo,
+					BlockNumber:   withdrawRow.BlockNumber,
+					BlockHash:     withdrawRow.BlockHash,
+					Confirmations: rpcTx.HeadBlockNum - rpcTx.BlockNum + 1,
+					Fee:           withdrawRow.Fee,
+				},
+				now,
+			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
-			notifyRows = append(notifyRows, &model.DBTProductNotify{
-				Nonce:        nonce,
-				ProductID:    withdrawRow.ProductID,
-				ItemType:     app.SendRelationTypeWithdraw,
-				ItemID:       withdrawRow.ID,
-				NotifyType:   app.NotifyTypeWithdrawConfirm,
-				TokenSymbol:  withdrawRow.Symbol,
-				URL:          productRow.CbURL,
-				Msg:          string(req),
-				HandleStatus: app.NotifyStatusInit,
-				HandleMsg:    "",
-				CreateTime:   now,
-				UpdateTime:   now,
-			})
+			notifyRows = append(notifyRows, notifyRow)
 			// 将发送成功和占位数据计入数组
 			if !mcommon.IsIntInSlice(sendIDs, sendRow.ID) {
 				sendIDs = append(sendIDs, sendRow.ID)
@@ -990,18 +1077,18 @@ func CheckRawTxConfirm() {
 		}
 		// 添加通知信息
 		_, err = model.SQLCreateManyTProductNotify(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			notifyRows,
 			false,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新提币状态
 		_, err = app.SQLUpdateTWithdrawStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			withdrawIDs,
 			&model.DBTWithdraw{
@@ -1011,12 +1098,12 @@ func CheckRawTxConfirm() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新发送状态
 		_, err = app.SQLUpdateTSendEosStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			sendIDs,
 			model.DBTSendEos{
@@ -1025,5 +1112,106 @@ func CheckRawTxConfirm() {
 				HandleAt:     now,
 			},
 		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		app.JobAddCount(ctx, int64(len(sendIDs)))
+		// 处理过期的交易
+		err = handleSendFailed(ctx, failRows, failMap)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
 	})
 }
+
+// handleSendFailed 处理发送失败的交易
+func handleSendFailed(ctx context.Context, sendRows []*model.DBTSendEos, failMap map[string]string) error {
+	if len(sendRows) == 0 {
+		return nil
+	}
+	var withdrawIDs []int64
+	for _, sendRow := range sendRows {
+		if !mcommon.IsIntInSlice(withdrawIDs, sendRow.WithdrawID) {
+			withdrawIDs = append(withdrawIDs, sendRow.WithdrawID)
+		}
+	}
+	withdrawMap, err := app.SQLGetWithdrawMap(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTWithdrawID,
+			model.DBColTWithdrawProductID,
+			model.DBColTWithdrawOutSerial,
+			model.DBColTWithdrawToAddress,
+			model.DBColTWithdrawBalanceReal,
+			model.DBColTWithdrawSymbol,
+			model.DBColTWithdrawMemo,
+		},
+		withdrawIDs,
+	)
+	if err != nil {
+		return err
+	}
+	var productIDs []int64
+	for _, withdrawRow := range withdrawMap {
+		if !mcommon.IsIntInSlice(productIDs, withdrawRow.ProductID) {
+			productIDs = append(productIDs, withdrawRow.ProductID)
+		}
+	}
+	productMap, err := app.SQLGetProductMap(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTProductID,
+			model.DBColTProductAppName,
+			model.DBColTProductCbURL,
+			model.DBColTProductAppSk,
+			model.DBColTProductNotifyVersion,
+		},
+		productIDs,
+	)
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	for _, sendRow := range sendRows {
+		reason := failMap[sendRow.TxHash]
+		// 失败的发送不再占用热钱包余额
+		_, err = app.SQLUpdateTSendEosStatusByIDs(
+			ctx,
+			xenv.DbCon,
+			[]int64{sendRow.ID},
+			model.DBTSendEos{
+				HandleStatus: app.SendStatusFailed,
+				HandleMsg:    reason,
+				HandleAt:     now,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		withdrawRow, ok := withdrawMap[sendRow.WithdrawID]
+		if !ok {
+			app.JobErrorf(ctx, "no withdrawMap: %d", sendRow.WithdrawID)
+			continue
+		}
+		productRow, ok := productMap[withdrawRow.ProductID]
+		if !ok {
+			app.JobErrorf(ctx, "no productMap: %d", withdrawRow.ProductID)
+		}
+		err = app.WithdrawFailed(
+			ctx,
+			xenv.DbCon,
+			withdrawRow,
+			productRow,
+			sendRow.TxHash,
+			reason,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
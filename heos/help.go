@@ -1,9 +1,12 @@
 package heos
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/eoscanada/eos-go"
 	"github.com/shopspring/decimal"
 )
 
@@ -52,3 +55,17 @@ func StrToEosDecimal(balanceReal string) (decimal.Decimal, error) {
 	v = v.RoundBank(4)
 	return v, nil
 }
+
+// GetTxExpiration 获取交易过期时间
+func GetTxExpiration(txHex string) (time.Time, error) {
+	var packedTx eos.PackedTransaction
+	err := json.Unmarshal([]byte(txHex), &packedTx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	signedTx, err := packedTx.Unpack()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return signedTx.Expiration.Time, nil
+}
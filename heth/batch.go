@@ -0,0 +1,377 @@
+package heth
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/moremorefun/mcommon"
+	"github.com/shopspring/decimal"
+)
+
+// 批量提币默认配置
+const (
+	// WithdrawBatchMaxDefault 单笔批量交易最多包含的提币数
+	WithdrawBatchMaxDefault = 50
+	// WithdrawBatchGasEthDefault 批量eth提币每个收款地址预留的gas
+	WithdrawBatchGasEthDefault = 40000
+)
+
+// stWithdrawBatch 已生成的批量提币交易，事务提交后扣除热钱包余额
+type stWithdrawBatch struct {
+	withdrawIDs []int64
+	hotWallet   *StHotWallet
+	// total 提币总额，eth为wei，erc20为token最小单位
+	total    *big.Int
+	feeValue *big.Int
+}
+
+// getBatchContract 获取批量转账合约地址，为空时不批量提币
+func getBatchContract(ctx context.Context) (string, error) {
+	return getConfigStr(ctx, "withdraw_batch_contract_eth")
+}
+
+// getSendWithdrawMap 获取批量提币发送关联的提币 map[send_id] => []withdraw_id
+func getSendWithdrawMap(ctx context.Context, sendRows []*model.DBTSend) (map[int64][]int64, error) {
+	var sendIDs []int64
+	for _, sendRow := range sendRows {
+		if sendRow.RelatedType == app.SendRelationTypeWithdrawBatch {
+			sendIDs = append(sendIDs, sendRow.ID)
+		}
+	}
+	linkRows, err := app.SQLSelectTSendWithdrawColBySendIDs(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTSendWithdrawSendID,
+			model.DBColTSendWithdrawWithdrawID,
+		},
+		sendIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	sendWithdrawMap := make(map[int64][]int64)
+	for _, linkRow := range linkRows {
+		sendWithdrawMap[linkRow.SendID] = append(sendWithdrawMap[linkRow.SendID], linkRow.WithdrawID)
+	}
+	return sendWithdrawMap, nil
+}
+
+// sendWithdrawIDs 发送数据关联的提币
+func sendWithdrawIDs(sendRow *model.DBTSend, sendWithdrawMap map[int64][]int64) []int64 {
+	switch sendRow.RelatedType {
+	case app.SendRelationTypeWithdraw:
+		return []int64{sendRow.RelatedID}
+	case app.SendRelationTypeWithdrawBatch:
+		return sendWithdrawMap[sendRow.ID]
+	}
+	return nil
+}
+
+// splitFee 批量提币的手续费平均分摊到每笔提币
+func splitFee(fee string, count int) string {
+	if count <= 1 {
+		return fee
+	}
+	feeObj, err := decimal.NewFromString(fee)
+	if err != nil {
+		return fee
+	}
+	return feeObj.DivRound(decimal.NewFromInt(int64(count)), 18).String()
+}
+
+// batchWithdraw 将同一币种的提币通过批量转账合约合并发送，
+// tokenRow 为空时为eth提币，返回未能批量发送的提币，由调用方逐笔发送，
+// 曾经批量发送失败的提币不再批量
+func batchWithdraw(ctx context.Context, chainID int64, withdrawRows []*model.DBTWithdraw, hotWallets []*StHotWallet, tokenRow *model.DBTAppConfigToken, addressTokenBalanceMap map[string]*big.Int, gasPerItem int64, fee *StTxFee) ([]*model.DBTWithdraw, error) {
+	contractAddress, err := getBatchContract(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if contractAddress == "" || len(withdrawRows) < 2 {
+		return withdrawRows, nil
+	}
+	_, err = StrToAddressBytes(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	batchMax, err := getConfigInt(ctx, "withdraw_batch_max", WithdrawBatchMaxDefault)
+	if err != nil {
+		return nil, err
+	}
+	if batchMax < 2 {
+		return withdrawRows, nil
+	}
+	var withdrawIDs []int64
+	for _, withdrawRow := range withdrawRows {
+		withdrawIDs = append(withdrawIDs, withdrawRow.ID)
+	}
+	linkRows, err := app.SQLSelectTSendWithdrawColByWithdrawIDs(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTSendWithdrawWithdrawID,
+		},
+		withdrawIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var linkedIDs []int64
+	for _, linkRow := range linkRows {
+		linkedIDs = append(linkedIDs, linkRow.WithdrawID)
+	}
+	var batchIDs []int64
+	for _, withdrawID := range withdrawIDs {
+		if !mcommon.IsIntInSlice(linkedIDs, withdrawID) {
+			batchIDs = append(batchIDs, withdrawID)
+		}
+	}
+	var sentIDs []int64
+	for start := 0; start < len(batchIDs); start += int(batchMax) {
+		end := start + int(batchMax)
+		if end > len(batchIDs) {
+			end = len(batchIDs)
+		}
+		if end-start < 2 {
+			break
+		}
+		var batch *stWithdrawBatch
+		err = mcommon.DbTransaction(ctx, xenv.DbCon, func(tx mcommon.DbExeAble) error {
+			var err error
+			batch, err = handleWithdrawBatch(ctx, tx, chainID, contractAddress, batchIDs[start:end], hotWallets, tokenRow, addressTokenBalanceMap, gasPerItem, fee)
+			return err
+		})
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			continue
+		}
+		if batch == nil {
+			continue
+		}
+		// 事务提交后扣除本次使用的余额
+		if tokenRow == nil {
+			batch.hotWallet.Balance.Sub(batch.hotWallet.Balance, batch.total)
+		} else {
+			tokenBalanceKey := fmt.Sprintf("%s-%s", batch.hotWallet.Address, tokenRow.TokenSymbol)
+			addressTokenBalanceMap[tokenBalanceKey].Sub(addressTokenBalanceMap[tokenBalanceKey], batch.total)
+		}
+		batch.hotWallet.Balance.Sub(batch.hotWallet.Balance, batch.feeValue)
+		batch.hotWallet.PendingCount++
+		sentIDs = append(sentIDs, batch.withdrawIDs...)
+		app.JobAddCount(ctx, int64(len(batch.withdrawIDs)))
+	}
+	var remainRows []*model.DBTWithdraw
+	for _, withdrawRow := range withdrawRows {
+		if !mcommon.IsIntInSlice(sentIDs, withdrawRow.ID) {
+			remainRows = append(remainRows, withdrawRow)
+		}
+	}
+	return remainRows, nil
+}
+
+// handleWithdrawBatch 生成一笔批量提币交易，返回已生成的交易，
+// 余额不足或模拟执行失败时不处理，返回空
+func handleWithdrawBatch(ctx context.Context, tx mcommon.DbExeAble, chainID int64, contractAddress string, withdrawIDs []int64, hotWallets []*StHotWallet, tokenRow *model.DBTAppConfigToken, addressTokenBalanceMap map[string]*big.Int, gasPerItem int64, fee *StTxFee) (*stWithdrawBatch, error) {
+	var lockedRows []*model.DBTWithdraw
+	var recipients []common.Address
+	var values []*big.Int
+	total := new(big.Int)
+	for _, withdrawID := range withdrawIDs {
+		withdrawRow, err := app.SQLGetTWithdrawColForUpdate(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTWithdrawID,
+				model.DBColTWithdrawBalanceReal,
+				model.DBColTWithdrawToAddress,
+			},
+			withdrawID,
+			app.WithdrawStatusInit,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if withdrawRow == nil {
+			continue
+		}
+		toAddress, err := StrToAddressBytes(withdrawRow.ToAddress)
+		if err != nil {
+			return nil, err
+		}
+		var value *big.Int
+		if tokenRow == nil {
+			value, err = EthStrToWeiBigInit(withdrawRow.BalanceReal)
+		} else {
+			value, err = TokenEthStrToWeiBigInit(withdrawRow.BalanceReal, tokenRow.TokenDecimals)
+		}
+		if err != nil {
+			return nil, err
+		}
+		lockedRows = append(lockedRows, withdrawRow)
+		recipients = append(recipients, toAddress)
+		values = append(values, value)
+		total.Add(total, value)
+	}
+	if len(lockedRows) < 2 {
+		return nil, nil
+	}
+	// 多预留一项的gas用于合约调用本身
+	gasLimit := gasPerItem * int64(len(lockedRows)+1)
+	feeValue := new(big.Int).Mul(big.NewInt(gasLimit), big.NewInt(fee.GasPrice))
+	contractAbi, err := abi.JSON(strings.NewReader(ethclient.DisperseABI))
+	if err != nil {
+		return nil, err
+	}
+	var input []byte
+	txValue := new(big.Int)
+	var balanceReal string
+	var hotWallet *StHotWallet
+	if tokenRow == nil {
+		input, err = contractAbi.Pack("disperseEther", recipients, values)
+		if err != nil {
+			return nil, err
+		}
+		txValue.Set(total)
+		balanceReal, err = WeiBigIntToEthStr(total)
+		if err != nil {
+			return nil, err
+		}
+		needBalance := new(big.Int).Add(total, feeValue)
+		hotWallet = selectHotWallet(hotWallets, func(hotWallet *StHotWallet) bool {
+			return hotWallet.Balance.Cmp(needBalance) >= 0
+		})
+	} else {
+		input, err = contractAbi.Pack("disperseToken", common.HexToAddress(tokenRow.TokenAddress), recipients, values)
+		if err != nil {
+			return nil, err
+		}
+		balanceReal, err = TokenWeiBigIntToEthStr(total, tokenRow.TokenDecimals)
+		if err != nil {
+			return nil, err
+		}
+		// 合约通过 transferFrom 转出token，需要热钱包预先授权
+		allowanceMap := make(map[string]*big.Int)
+		for _, hotWallet := range hotWallets {
+			allowance, err := ethclient.RPCTokenAllowance(ctx, tokenRow.TokenAddress, hotWallet.Address, contractAddress)
+			if err != nil {
+				return nil, err
+			}
+			allowanceMap[hotWallet.Address] = allowance
+		}
+		hotWallet = selectHotWallet(hotWallets, func(hotWallet *StHotWallet) bool {
+			if hotWallet.Balance.Cmp(feeValue) < 0 || allowanceMap[hotWallet.Address].Cmp(total) < 0 {
+				return false
+			}
+			tokenBalanceKey := fmt.Sprintf("%s-%s", hotWallet.Address, tokenRow.TokenSymbol)
+			tokenBalance, ok := addressTokenBalanceMap[tokenBalanceKey]
+			return ok && tokenBalance.Cmp(total) >= 0
+		})
+	}
+	if hotWallet == nil {
+		mcommon.Log.Warnf("no hot wallet for withdraw batch: %s", balanceReal)
+		return nil, nil
+	}
+	// 模拟执行，会回滚的批量交易改为逐笔发送
+	_, err = ethclient.RPCCallContract(ctx, hotWallet.Address, contractAddress, txValue, input)
+	if err != nil {
+		mcommon.Log.Warnf("withdraw batch call err: [%T] %s", err, err.Error())
+		return nil, nil
+	}
+	nonce, err := GetNonce(ctx, tx, hotWallet.Address)
+	if err != nil {
+		return nil, err
+	}
+	signedTx, rawTxHex, err := SignTx(
+		chainID,
+		nonce,
+		common.HexToAddress(contractAddress),
+		txValue,
+		gasLimit,
+		fee,
+		input,
+		hotWallet.PrivateKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+	txHash := strings.ToLower(signedTx.Hash().Hex())
+	now := time.Now().Unix()
+	var handledIDs []int64
+	for _, withdrawRow := range lockedRows {
+		_, err = app.SQLUpdateTWithdrawGenTx(
+			ctx,
+			tx,
+			&model.DBTWithdraw{
+				ID:           withdrawRow.ID,
+				TxHash:       txHash,
+				HandleStatus: app.WithdrawStatusHex,
+				HandleMsg:    "hex batch",
+				HandleTime:   now,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		handledIDs = append(handledIDs, withdrawRow.ID)
+	}
+	sendID, err := model.SQLCreateTSend(
+		ctx,
+		tx,
+		&model.DBTSend{
+			Chain:                chainName(ctx),
+			RelatedType:          app.SendRelationTypeWithdrawBatch,
+			RelatedID:            0,
+			TxID:                 txHash,
+			FromAddress:          hotWallet.Address,
+			ToAddress:            strings.ToLower(contractAddress),
+			BalanceReal:          balanceReal,
+			Gas:                  gasLimit,
+			GasPrice:             fee.GasPrice,
+			MaxFeePerGas:         fee.MaxFeePerGas,
+			MaxPriorityFeePerGas: fee.MaxPriorityFeePerGas,
+			Nonce:                nonce,
+			Hex:                  rawTxHex,
+			CreateTime:           now,
+			HandleStatus:         app.SendStatusInit,
+			HandleMsg:            "",
+			HandleTime:           now,
+		},
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var linkRows []*model.DBTSendWithdraw
+	for _, withdrawID := range handledIDs {
+		linkRows = append(linkRows, &model.DBTSendWithdraw{
+			SendID:     sendID,
+			WithdrawID: withdrawID,
+			CreateTime: now,
+		})
+	}
+	_, err = model.SQLCreateManyTSendWithdraw(
+		ctx,
+		tx,
+		linkRows,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &stWithdrawBatch{
+		withdrawIDs: handledIDs,
+		hotWallet:   hotWallet,
+		total:       total,
+		feeValue:    feeValue,
+	}, nil
+}
@@ -0,0 +1,525 @@
+package heth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"database/sql/driver"
+	"encoding/hex"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/jmoiron/sqlx"
+)
+
+// 测试合约，部署代码为复制运行代码的构造函数加运行代码
+const (
+	// testDisperseCode 批量转账合约，与 disperse 的接口相同
+	// disperseEther(address[] recipients, uint256[] values) 逐个转出 eth，剩余的退回调用者
+	// disperseToken(address token, address[] recipients, uint256[] values) 逐个 transferFrom(msg.sender, recipient, value)，
+	// 任一转账失败时整笔交易回滚
+	testDisperseCode = "6101318061000d6000396000f360003560e01c8063e63d38ed14610023578063c73a2d60146100a1575b600080fd5b005b60043560040160805260243560040160a0526080513560c052600060e0525b60c05160e051101561008757600060006000600060e05160051b60a051016020013560e05160051b60805101602001355af11561001c5760e05160010160e052610042565b47801561002157600060006000600084335af11561001c57005b60243560040160805260443560040160a0526080513560c052600060e0525b60c05160e0511015610021576323b872dd60e01b61010052336101045260e05160051b60805101602001356101245260e05160051b60a051016020013561014452600060005260206000606461010060006004355af11561001c576000511561001c5760e05160010160e0526100c056"
+	// testTokenCode 最简 erc20，包含 balanceOf、allowance、approve、transfer、transferFrom、decimals，
+	// 以及不限制调用者的 mint(address to, uint256 value)，余额或授权不足时回滚
+	testTokenCode = "61012d8061000d6000396000f360003560e01c806370a0823114610058578063dd62ed3e14610065578063095ea7b314610080578063a9059cbb146100b757806323b872dd146100cc57806340c10f19146100a9578063313ce5671461009e575b600080fd5b6004355460005260206000f35b60043560005260243560205260406000205460005260206000f35b33600052600435602052602435604060002055600160005260206000f35b601260005260206000f35b602435600435540160043555005b3360805260043560a05260243560c052610102565b60043560805260243560a05260443560c052608051600052336020526040600020805460c0518181116100535790039055610102565b6080515460c0518181116100535790036080515560c05160a051540160a05155600160005260206000f3"
+	// testTokenABI 测试 erc20 额外的 mint 接口
+	testTokenABI = `[{"inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"name":"mint","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+	// testChainID 模拟链的链id
+	testChainID = 1337
+)
+
+// simCallArgs eth_call 和 eth_estimateGas 的参数
+type simCallArgs struct {
+	From  common.Address  `json:"from"`
+	To    *common.Address `json:"to"`
+	Value *hexutil.Big    `json:"value"`
+	Data  hexutil.Bytes   `json:"data"`
+}
+
+// simService 将模拟链以 json-rpc 接口提供给 ethclient
+type simService struct {
+	backend *backends.SimulatedBackend
+}
+
+func (s *simService) callMsg(args simCallArgs) ethereum.CallMsg {
+	msg := ethereum.CallMsg{
+		From: args.From,
+		To:   args.To,
+		Data: args.Data,
+	}
+	if args.Value != nil {
+		msg.Value = args.Value.ToInt()
+	}
+	return msg
+}
+
+// Call eth_call
+func (s *simService) Call(ctx context.Context, args simCallArgs, block string) (hexutil.Bytes, error) {
+	return s.backend.CallContract(ctx, s.callMsg(args), nil)
+}
+
+// EstimateGas eth_estimateGas
+func (s *simService) EstimateGas(ctx context.Context, args simCallArgs) (hexutil.Uint64, error) {
+	gas, err := s.backend.EstimateGas(ctx, s.callMsg(args))
+	return hexutil.Uint64(gas), err
+}
+
+// GetTransactionCount eth_getTransactionCount
+func (s *simService) GetTransactionCount(ctx context.Context, address common.Address, block string) (hexutil.Uint64, error) {
+	nonce, err := s.backend.PendingNonceAt(ctx, address)
+	return hexutil.Uint64(nonce), err
+}
+
+// batchTestEnv 模拟链、批量转账合约和 mock 数据库
+type batchTestEnv struct {
+	t        *testing.T
+	ctx      context.Context
+	backend  *backends.SimulatedBackend
+	mock     sqlmock.Sqlmock
+	owner    *ecdsa.PrivateKey
+	hotKey   *ecdsa.PrivateKey
+	hot      *StHotWallet
+	disperse common.Address
+	fee      *StTxFee
+}
+
+func newBatchTestEnv(t *testing.T, name string) *batchTestEnv {
+	owner, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key err: %s", err.Error())
+	}
+	hotKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key err: %s", err.Error())
+	}
+	initBalance := new(big.Int).Mul(big.NewInt(100), big.NewInt(EthToWei))
+	backend := backends.NewSimulatedBackend(
+		core.GenesisAlloc{
+			crypto.PubkeyToAddress(owner.PublicKey):  {Balance: initBalance},
+			crypto.PubkeyToAddress(hotKey.PublicKey): {Balance: initBalance},
+		},
+		10000000,
+	)
+	t.Cleanup(func() {
+		_ = backend.Close()
+	})
+	// 模拟链的 json-rpc 接口
+	server := rpc.NewServer()
+	err = server.RegisterName("eth", &simService{backend: backend})
+	if err != nil {
+		t.Fatalf("register rpc err: %s", err.Error())
+	}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	t.Cleanup(server.Stop)
+	err = ethclient.InitChainClient(name, httpServer.URL, 0)
+	if err != nil {
+		t.Fatalf("init chain client err: %s", err.Error())
+	}
+	// mock 数据库
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock err: %s", err.Error())
+	}
+	oldDbCon := xenv.DbCon
+	xenv.DbCon = sqlx.NewDb(db, "mysql")
+	t.Cleanup(func() {
+		xenv.DbCon = oldDbCon
+		_ = db.Close()
+	})
+	env := &batchTestEnv{
+		t:       t,
+		ctx:     ethclient.WithChain(context.Background(), name),
+		backend: backend,
+		mock:    mock,
+		owner:   owner,
+		hotKey:  hotKey,
+		hot: &StHotWallet{
+			Address:    AddressBytesToStr(crypto.PubkeyToAddress(hotKey.PublicKey)),
+			PrivateKey: hotKey,
+			Balance:    new(big.Int).Set(initBalance),
+		},
+		fee: &StTxFee{
+			GasPrice: 10000000000,
+		},
+	}
+	env.disperse = env.deploy(testDisperseCode)
+	return env
+}
+
+// sendTx 发送交易到模拟链的待打包区块
+func (env *batchTestEnv) sendTx(key *ecdsa.PrivateKey, to *common.Address, data []byte) *types.Transaction {
+	nonce, err := env.backend.PendingNonceAt(env.ctx, crypto.PubkeyToAddress(key.PublicKey))
+	if err != nil {
+		env.t.Fatalf("nonce err: %s", err.Error())
+	}
+	var tx *types.Transaction
+	if to == nil {
+		tx = types.NewContractCreation(nonce, big.NewInt(0), 1000000, big.NewInt(env.fee.GasPrice), data)
+	} else {
+		tx = types.NewTransaction(nonce, *to, big.NewInt(0), 200000, big.NewInt(env.fee.GasPrice), data)
+	}
+	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(big.NewInt(testChainID)), key)
+	if err != nil {
+		env.t.Fatalf("sign tx err: %s", err.Error())
+	}
+	err = env.backend.SendTransaction(env.ctx, signedTx)
+	if err != nil {
+		env.t.Fatalf("send tx err: %s", err.Error())
+	}
+	return signedTx
+}
+
+// sendHex 广播 t_send 中记录的交易
+func (env *batchTestEnv) sendHex(rawTxHex string) *types.Transaction {
+	rawTxBytes, err := hex.DecodeString(rawTxHex)
+	if err != nil {
+		env.t.Fatalf("decode hex err: %s", err.Error())
+	}
+	tx := new(types.Transaction)
+	err = tx.UnmarshalBinary(rawTxBytes)
+	if err != nil {
+		env.t.Fatalf("unmarshal tx err: %s", err.Error())
+	}
+	err = env.backend.SendTransaction(env.ctx, tx)
+	if err != nil {
+		env.t.Fatalf("send tx err: %s", err.Error())
+	}
+	return tx
+}
+
+// receiptStatus 获取已打包交易的执行结果
+func (env *batchTestEnv) receiptStatus(tx *types.Transaction) uint64 {
+	receipt, err := env.backend.TransactionReceipt(env.ctx, tx.Hash())
+	if err != nil {
+		env.t.Fatalf("receipt err: %s", err.Error())
+	}
+	return receipt.Status
+}
+
+// deploy 部署合约
+func (env *batchTestEnv) deploy(code string) common.Address {
+	codeBytes, err := hex.DecodeString(code)
+	if err != nil {
+		env.t.Fatalf("decode code err: %s", err.Error())
+	}
+	tx := env.sendTx(env.owner, nil, codeBytes)
+	env.backend.Commit()
+	receipt, err := env.backend.TransactionReceipt(env.ctx, tx.Hash())
+	if err != nil {
+		env.t.Fatalf("receipt err: %s", err.Error())
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		env.t.Fatalf("deploy failed")
+	}
+	return receipt.ContractAddress
+}
+
+// tokenBalance 获取 erc20 余额
+func (env *batchTestEnv) tokenBalance(token common.Address, address string) *big.Int {
+	balance, err := ethclient.RPCTokenBalance(env.ctx, AddressBytesToStr(token), address)
+	if err != nil {
+		env.t.Fatalf("token balance err: %s", err.Error())
+	}
+	return balance
+}
+
+// packToken 生成 erc20 调用数据
+func (env *batchTestEnv) packToken(abiStr string, method string, args ...interface{}) []byte {
+	contractAbi, err := abi.JSON(strings.NewReader(abiStr))
+	if err != nil {
+		env.t.Fatalf("abi err: %s", err.Error())
+	}
+	input, err := contractAbi.Pack(method, args...)
+	if err != nil {
+		env.t.Fatalf("pack err: %s", err.Error())
+	}
+	return input
+}
+
+// newRecipient 生成收款地址
+func (env *batchTestEnv) newRecipient() string {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		env.t.Fatalf("generate key err: %s", err.Error())
+	}
+	return AddressBytesToStr(crypto.PubkeyToAddress(key.PublicKey))
+}
+
+// captureArg 记录 sql 参数
+type captureArg struct {
+	value *driver.Value
+}
+
+func (a captureArg) Match(v driver.Value) bool {
+	*a.value = v
+	return true
+}
+
+// expectBatchConfig 批量提币读取合约地址和每批数量
+func (env *batchTestEnv) expectBatchConfig() {
+	env.mock.ExpectQuery("FROM t_app_config_str").
+		WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(AddressBytesToStr(env.disperse)))
+	env.mock.ExpectQuery("FROM t_app_config_int").
+		WillReturnRows(sqlmock.NewRows([]string{"v"}))
+}
+
+// expectNonce 分配 nonce
+func (env *batchTestEnv) expectNonce() {
+	env.mock.ExpectQuery("FROM t_eth_nonce .* FOR UPDATE").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "address", "nonce", "update_time"}).AddRow(1, env.hot.Address, -1, 0))
+	env.mock.ExpectQuery("MAX\\(nonce\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(-1))
+	env.mock.ExpectExec("UPDATE t_eth_nonce").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+// expectInsertSend 创建 t_send，返回记录的参数
+func (env *batchTestEnv) expectInsertSend(sendID int64) []driver.Value {
+	values := make([]driver.Value, 20)
+	var args []driver.Value
+	for i := range values {
+		args = append(args, captureArg{value: &values[i]})
+	}
+	env.mock.ExpectExec("INSERT INTO t_send \\(").
+		WithArgs(args...).
+		WillReturnResult(sqlmock.NewResult(sendID, 1))
+	return values
+}
+
+// t_send 插入参数的位置
+const (
+	sendArgGas         = 8
+	sendArgGasEstimate = 9
+	sendArgHex         = 15
+)
+
+// expectWithdrawBatch 生成批量提币交易
+func (env *batchTestEnv) expectWithdrawBatch(withdrawRows []*model.DBTWithdraw, sendID int64) []driver.Value {
+	env.expectBatchConfig()
+	env.mock.ExpectQuery("FROM t_send_withdraw WHERE withdraw_id IN").
+		WillReturnRows(sqlmock.NewRows([]string{"withdraw_id"}))
+	env.mock.ExpectBegin()
+	for _, withdrawRow := range withdrawRows {
+		env.mock.ExpectQuery("FROM t_withdraw WHERE id=\\? AND handle_status=\\? FOR UPDATE").
+			WithArgs(withdrawRow.ID, app.WithdrawStatusInit).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "balance_real", "to_address"}).AddRow(withdrawRow.ID, withdrawRow.BalanceReal, withdrawRow.ToAddress))
+	}
+	env.expectNonce()
+	for _, withdrawRow := range withdrawRows {
+		env.mock.ExpectExec("UPDATE t_withdraw SET tx_hash").
+			WithArgs(sqlmock.AnyArg(), app.WithdrawStatusHex, "hex batch", sqlmock.AnyArg(), withdrawRow.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	values := env.expectInsertSend(sendID)
+	env.mock.ExpectExec("INSERT INTO t_send_withdraw").
+		WillReturnResult(sqlmock.NewResult(1, int64(len(withdrawRows))))
+	env.mock.ExpectCommit()
+	return values
+}
+
+func (env *batchTestEnv) checkMock() {
+	err := env.mock.ExpectationsWereMet()
+	if err != nil {
+		env.t.Fatalf("sql expectations: %s", err.Error())
+	}
+}
+
+func TestWithdrawBatchEther(t *testing.T) {
+	env := newBatchTestEnv(t, "batchether")
+	withdrawRows := []*model.DBTWithdraw{
+		{ID: 1, BalanceReal: "0.5", ToAddress: env.newRecipient()},
+		{ID: 2, BalanceReal: "1.25", ToAddress: env.newRecipient()},
+	}
+	values := env.expectWithdrawBatch(withdrawRows, 10)
+	hotBalance := new(big.Int).Set(env.hot.Balance)
+	remainRows, err := batchWithdraw(env.ctx, testChainID, withdrawRows, []*StHotWallet{env.hot}, nil, nil, WithdrawBatchGasEthDefault, env.fee)
+	if err != nil {
+		t.Fatalf("batch withdraw err: %s", err.Error())
+	}
+	env.checkMock()
+	if len(remainRows) != 0 {
+		t.Fatalf("remain rows: %d", len(remainRows))
+	}
+	// 事务提交后扣除热钱包余额
+	total := new(big.Int).Mul(big.NewInt(175), big.NewInt(EthToWei/100))
+	gasLimit := values[sendArgGas].(int64)
+	hotBalance.Sub(hotBalance, total)
+	hotBalance.Sub(hotBalance, big.NewInt(gasLimit*env.fee.GasPrice))
+	if env.hot.Balance.Cmp(hotBalance) != 0 || env.hot.PendingCount != 1 {
+		t.Fatalf("hot balance: %s, pending: %d", env.hot.Balance.String(), env.hot.PendingCount)
+	}
+	tx := env.sendHex(values[sendArgHex].(string))
+	env.backend.Commit()
+	if env.receiptStatus(tx) != types.ReceiptStatusSuccessful {
+		t.Fatalf("disperseEther failed")
+	}
+	for _, withdrawRow := range withdrawRows {
+		balance, err := env.backend.BalanceAt(env.ctx, common.HexToAddress(withdrawRow.ToAddress), nil)
+		if err != nil {
+			t.Fatalf("balance err: %s", err.Error())
+		}
+		withdrawBalance, err := EthStrToWeiBigInit(withdrawRow.BalanceReal)
+		if err != nil {
+			t.Fatalf("balance err: %s", err.Error())
+		}
+		if balance.Cmp(withdrawBalance) != 0 {
+			t.Fatalf("recipient balance: %s, withdraw: %s", balance.String(), withdrawRow.BalanceReal)
+		}
+	}
+}
+
+func TestWithdrawBatchTokenRevert(t *testing.T) {
+	env := newBatchTestEnv(t, "batchtoken")
+	token := env.deploy(testTokenCode)
+	tokenRow := &model.DBTAppConfigToken{
+		TokenAddress:  AddressBytesToStr(token),
+		TokenDecimals: 18,
+		TokenSymbol:   "tkn",
+	}
+	// 热钱包持有token并授权批量转账合约
+	hotTokenBalance := new(big.Int).Mul(big.NewInt(1000), big.NewInt(EthToWei))
+	env.sendTx(env.owner, &token, env.packToken(testTokenABI, "mint", common.HexToAddress(env.hot.Address), hotTokenBalance))
+	env.sendTx(env.hotKey, &token, env.packToken(ethclient.EthABI, "approve", env.disperse, hotTokenBalance))
+	env.backend.Commit()
+	tokenBalanceKey := env.hot.Address + "-" + tokenRow.TokenSymbol
+	addressTokenBalanceMap := map[string]*big.Int{
+		tokenBalanceKey: new(big.Int).Set(hotTokenBalance),
+	}
+	withdrawRows := []*model.DBTWithdraw{
+		{ID: 3, BalanceReal: "10", ToAddress: env.newRecipient(), Symbol: tokenRow.TokenSymbol},
+		{ID: 4, BalanceReal: "20.5", ToAddress: env.newRecipient(), Symbol: tokenRow.TokenSymbol},
+	}
+	// 生成批量交易时授权仍有效，打包前授权被撤销
+	revokeTx := env.sendTx(env.hotKey, &token, env.packToken(ethclient.EthABI, "approve", env.disperse, big.NewInt(0)))
+	values := env.expectWithdrawBatch(withdrawRows, 11)
+	remainRows, err := batchWithdraw(env.ctx, testChainID, withdrawRows, []*StHotWallet{env.hot}, tokenRow, addressTokenBalanceMap, 60000, env.fee)
+	if err != nil {
+		t.Fatalf("batch withdraw err: %s", err.Error())
+	}
+	env.checkMock()
+	if len(remainRows) != 0 {
+		t.Fatalf("remain rows: %d", len(remainRows))
+	}
+	total := new(big.Int).Mul(big.NewInt(305), big.NewInt(EthToWei/10))
+	if addressTokenBalanceMap[tokenBalanceKey].Cmp(new(big.Int).Sub(hotTokenBalance, total)) != 0 {
+		t.Fatalf("hot token balance: %s", addressTokenBalanceMap[tokenBalanceKey].String())
+	}
+	batchTx := env.sendHex(values[sendArgHex].(string))
+	env.backend.Commit()
+	if env.receiptStatus(revokeTx) != types.ReceiptStatusSuccessful {
+		t.Fatalf("revoke approve failed")
+	}
+	if env.receiptStatus(batchTx) != types.ReceiptStatusFailed {
+		t.Fatalf("disperseToken should revert without allowance")
+	}
+
+	// 批量交易执行失败，关联的提币改为待处理
+	txHash := strings.ToLower(batchTx.Hash().Hex())
+	reason := "tx reverted in block 3"
+	env.mock.ExpectQuery("FROM t_send_withdraw WHERE send_id IN").
+		WithArgs(int64(11)).
+		WillReturnRows(sqlmock.NewRows([]string{"send_id", "withdraw_id"}).AddRow(11, 3).AddRow(11, 4))
+	env.mock.ExpectExec("UPDATE t_send SET handle_status").
+		WithArgs(app.SendStatusFailed, reason, sqlmock.AnyArg(), int64(11)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	env.mock.ExpectExec("UPDATE t_withdraw SET handle_status").
+		WithArgs(app.WithdrawStatusInit, "batch failed", sqlmock.AnyArg(), int64(3), int64(4)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	err = handleSendFailed(
+		env.ctx,
+		[]*model.DBTSend{
+			{
+				ID:          11,
+				RelatedType: app.SendRelationTypeWithdrawBatch,
+				TxID:        txHash,
+			},
+		},
+		map[string]string{
+			txHash: reason,
+		},
+	)
+	if err != nil {
+		t.Fatalf("handle send failed err: %s", err.Error())
+	}
+	env.checkMock()
+	// 批量失败的链上余额未转出
+	addressTokenBalanceMap[tokenBalanceKey] = env.tokenBalance(token, env.hot.Address)
+
+	// 曾经批量失败的提币不再批量
+	env.expectBatchConfig()
+	env.mock.ExpectQuery("FROM t_send_withdraw WHERE withdraw_id IN").
+		WillReturnRows(sqlmock.NewRows([]string{"withdraw_id"}).AddRow(3).AddRow(4))
+	remainRows, err = batchWithdraw(env.ctx, testChainID, withdrawRows, []*StHotWallet{env.hot}, tokenRow, addressTokenBalanceMap, 60000, env.fee)
+	if err != nil {
+		t.Fatalf("batch withdraw err: %s", err.Error())
+	}
+	env.checkMock()
+	if len(remainRows) != 2 {
+		t.Fatalf("remain rows: %d", len(remainRows))
+	}
+
+	// 逐笔发送
+	tokenMap := map[string]*model.DBTAppConfigToken{
+		tokenRow.TokenSymbol: tokenRow,
+	}
+	tokenHotWalletMap := map[string][]*StHotWallet{
+		tokenRow.TokenSymbol: {env.hot},
+	}
+	var singleTxes []*types.Transaction
+	for i, withdrawRow := range remainRows {
+		env.mock.ExpectBegin()
+		env.mock.ExpectQuery("FROM t_withdraw WHERE id=\\? AND handle_status=\\? FOR UPDATE").
+			WithArgs(withdrawRow.ID, app.WithdrawStatusInit).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "balance_real", "to_address", "symbol"}).AddRow(withdrawRow.ID, withdrawRow.BalanceReal, withdrawRow.ToAddress, withdrawRow.Symbol))
+		env.mock.ExpectQuery("FROM t_app_config_int").
+			WillReturnRows(sqlmock.NewRows([]string{"v"}))
+		env.mock.ExpectQuery("FROM t_app_config_int").
+			WillReturnRows(sqlmock.NewRows([]string{"v"}))
+		env.expectNonce()
+		env.mock.ExpectExec("UPDATE t_withdraw SET tx_hash").
+			WithArgs(sqlmock.AnyArg(), app.WithdrawStatusHex, "hex", sqlmock.AnyArg(), withdrawRow.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		values := env.expectInsertSend(int64(12 + i))
+		env.mock.ExpectCommit()
+		err = handleErc20Withdraw(env.ctx, withdrawRow.ID, testChainID, &tokenMap, &tokenHotWalletMap, &addressTokenBalanceMap, 60000, env.fee)
+		if err != nil {
+			t.Fatalf("erc20 withdraw err: %s", err.Error())
+		}
+		env.checkMock()
+		gasEstimate := values[sendArgGasEstimate].(int64)
+		if gasEstimate <= 0 || values[sendArgGas].(int64) < gasEstimate {
+			t.Fatalf("gas: %v, estimate: %v", values[sendArgGas], gasEstimate)
+		}
+		singleTxes = append(singleTxes, env.sendHex(values[sendArgHex].(string)))
+	}
+	env.backend.Commit()
+	for i, withdrawRow := range remainRows {
+		if env.receiptStatus(singleTxes[i]) != types.ReceiptStatusSuccessful {
+			t.Fatalf("erc20 withdraw %d failed", withdrawRow.ID)
+		}
+		balance := env.tokenBalance(token, withdrawRow.ToAddress)
+		withdrawBalance, err := TokenEthStrToWeiBigInit(withdrawRow.BalanceReal, tokenRow.TokenDecimals)
+		if err != nil {
+			t.Fatalf("balance err: %s", err.Error())
+		}
+		if balance.Cmp(withdrawBalance) != 0 {
+			t.Fatalf("recipient balance: %s, withdraw: %s", balance.String(), withdrawRow.BalanceReal)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package heth
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"regexp"
+)
+
+// StChain evm链
+type StChain struct {
+	Name       string
+	CoinSymbol string
+}
+
+// defaultChain 默认链，使用环境变量中的节点
+var defaultChain = &StChain{
+	Name:       ethclient.DefaultChain,
+	CoinSymbol: CoinSymbol,
+}
+
+// extraChains t_app_config_chain 中开启的其他evm链
+var extraChains []*StChain
+
+// chainNameRe 链名称，作为配置键和任务名的前缀
+var chainNameRe = regexp.MustCompile("^[a-z][a-z0-9]*$")
+
+// InitChains 加载 t_app_config_chain 中开启的evm链并初始化节点连接
+func InitChains(ctx context.Context) error {
+	if !xenv.Cfg.EthEnable {
+		return nil
+	}
+	chainRows, err := app.SQLSelectTAppConfigChainColEnable(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTAppConfigChainName,
+			model.DBColTAppConfigChainCoinSymbol,
+			model.DBColTAppConfigChainChainID,
+			model.DBColTAppConfigChainRPC,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	var chains []*StChain
+	for _, chainRow := range chainRows {
+		if !chainNameRe.MatchString(chainRow.Name) || chainRow.Name == ethclient.DefaultChain {
+			return fmt.Errorf("chain name error: %s", chainRow.Name)
+		}
+		if chainRow.CoinSymbol == "" || chainRow.CoinSymbol == CoinSymbol {
+			return fmt.Errorf("chain %s coin symbol error: %s", chainRow.Name, chainRow.CoinSymbol)
+		}
+		err = ethclient.InitChainClient(chainRow.Name, chainRow.RPC, chainRow.ChainID)
+		if err != nil {
+			return fmt.Errorf("chain %s: %w", chainRow.Name, err)
+		}
+		chains = append(chains, &StChain{
+			Name:       chainRow.Name,
+			CoinSymbol: chainRow.CoinSymbol,
+		})
+	}
+	extraChains = chains
+	return nil
+}
+
+// GetChains 获取运行的evm链，默认链在前，未开启 eth 时为空
+func GetChains() []*StChain {
+	if !xenv.Cfg.EthEnable {
+		return nil
+	}
+	chains := []*StChain{defaultChain}
+	return append(chains, extraChains...)
+}
+
+// GetChain 获取 ctx 所属的链，未加载的链原生币符号为空
+func GetChain(ctx context.Context) *StChain {
+	name := ethclient.ChainOf(ctx)
+	if name == ethclient.DefaultChain {
+		return defaultChain
+	}
+	for _, chain := range extraChains {
+		if chain.Name == name {
+			return chain
+		}
+	}
+	return &StChain{
+		Name: name,
+	}
+}
+
+// chainName 获取 ctx 所属的链名称
+func chainName(ctx context.Context) string {
+	return ethclient.ChainOf(ctx)
+}
+
+// chainSymbol 获取 ctx 所属链的原生币符号
+func chainSymbol(ctx context.Context) string {
+	return GetChain(ctx).CoinSymbol
+}
+
+// chainKey 配置、状态和锁的键名，默认链保持原键名，其他链以链名称为前缀
+func chainKey(ctx context.Context, k string) string {
+	return ChainKey(chainName(ctx), k)
+}
+
+// ChainKey 指定链的键名
+func ChainKey(name string, k string) string {
+	if name == ethclient.DefaultChain {
+		return k
+	}
+	return name + "_" + k
+}
@@ -4,28 +4,20 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"encoding/hex"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"go-dc-wallet/app"
 	"go-dc-wallet/ethclient"
 	"go-dc-wallet/model"
 	"go-dc-wallet/xenv"
-	"math"
 	"math/big"
-	"net/http"
 	"strings"
 	"time"
 
 	"github.com/moremorefun/mcommon"
-	"github.com/parnurzeal/gorequest"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
-	"github.com/gin-gonic/gin"
-
-	"github.com/ethereum/go-ethereum/rlp"
-
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/ethereum/go-ethereum/core/types"
@@ -60,7 +52,7 @@ func genAddressAndAesKey() (string, string, error) {
 }
 
 // CreateHotAddress 创建自用地址
-func CreateHotAddress(num int64) ([]string, error) {
+func CreateHotAddress(ctx context.Context, num int64) ([]string, error) {
 	var rows []*model.DBTAddressKey
 	var addresses []string
 	// 遍历差值次数
@@ -71,7 +63,7 @@ func CreateHotAddress(num int64) ([]string, error) {
 		}
 		// 存入待添加队列
 		rows = append(rows, &model.DBTAddressKey{
-			Symbol:  CoinSymbol,
+			Symbol:  chainSymbol(ctx),
 			Address: address,
 			Pwd:     privateKeyStrEn,
 			UseTag:  -1,
@@ -80,7 +72,7 @@ func CreateHotAddress(num int64) ([]string, error) {
 	}
 	// 一次性将生成的地址存入数据库
 	_, err := model.SQLCreateManyTAddressKey(
-		context.Background(),
+		ctx,
 		xenv.DbCon,
 		rows,
 		true,
@@ -92,42 +84,57 @@ func CreateHotAddress(num int64) ([]string, error) {
 }
 
 // CheckAddressFree 检测是否有充足的备用地址
-func CheckAddressFree() {
-	lockKey := "EthCheckAddressFree"
-	app.LockWrap(lockKey, func() {
+func CheckAddressFree(ctx context.Context) {
+	lockKey := chainKey(ctx, "EthCheckAddressFree")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取配置 允许的最小剩余地址数
 		minFreeCount, err := app.SQLGetTAppConfigIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
-			"min_free_address",
+			chainKey(ctx, "min_free_address"),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 获取当前剩余可用地址数
 		freeCount, err := app.SQLGetTAddressKeyFreeCount(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
-			CoinSymbol,
+			chainSymbol(ctx),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 如果数据库中剩余可用地址小于最小允许可用地址
 		if freeCount < minFreeCount {
+			// 配置了forwarder工厂合约时生成forwarder地址
+			factoryAddress, err := getForwarderFactory(ctx)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
+			if factoryAddress != "" {
+				count, err := createForwarderAddresses(ctx, factoryAddress, minFreeCount-freeCount)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					return
+				}
+				app.JobAddCount(ctx, count)
+				return
+			}
 			var rows []*model.DBTAddressKey
 			// 遍历差值次数
 			for i := int64(0); i < minFreeCount-freeCount; i++ {
 				address, privateKeyStrEn, err := genAddressAndAesKey()
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				// 存入待添加队列
 				rows = append(rows, &model.DBTAddressKey{
-					Symbol:  CoinSymbol,
+					Symbol:  chainSymbol(ctx),
 					Address: address,
 					Pwd:     privateKeyStrEn,
 					UseTag:  0,
@@ -135,47 +142,48 @@ func CheckAddressFree() {
 			}
 			// 一次性将生成的地址存入数据库
 			_, err = model.SQLCreateManyTAddressKey(
-				context.Background(),
+				ctx,
 				xenv.DbCon,
 				rows,
 				true,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
+			app.JobAddCount(ctx, int64(len(rows)))
 		}
 	})
 }
 
 // CheckBlockSeek 检测到账
-func CheckBlockSeek() {
-	lockKey := "EthCheckBlockSeek"
-	app.LockWrap(lockKey, func() {
+func CheckBlockSeek(ctx context.Context) {
+	lockKey := chainKey(ctx, "EthCheckBlockSeek")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取配置 延迟确认数
 		confirmValue, err := app.SQLGetTAppConfigIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
-			"block_confirm_num",
+			chainKey(ctx, "block_confirm_num"),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 获取状态 当前处理完成的最新的block number
 		seekValue, err := app.SQLGetTAppStatusIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
-			"eth_seek_num",
+			chainKey(ctx, "eth_seek_num"),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// rpc 获取当前最新区块数
-		rpcBlockNum, err := ethclient.RPCBlockNumber(context.Background())
+		rpcBlockNum, err := ethclient.RPCBlockNumber(ctx)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		startI := seekValue + 1
@@ -183,12 +191,12 @@ func CheckBlockSeek() {
 		if startI < endI {
 			// 手续费钱包列表
 			feeAddressValue, err := app.SQLGetTAppConfigStrValueByK(
-				context.Background(),
+				ctx,
 				xenv.DbCon,
-				"fee_wallet_address_list_erc20",
+				chainKey(ctx, "fee_wallet_address_list_erc20"),
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			addresses := strings.Split(feeAddressValue, ",")
@@ -199,13 +207,51 @@ func CheckBlockSeek() {
 				}
 				feeAddresses = append(feeAddresses, address)
 			}
+			// 内部转账检测方式，为空时不检测
+			traceMethod, err := getTraceMethod(ctx)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
+			// 并发预取block信息
+			concurrency, err := app.GetBlockFetchConcurrency(ctx)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
+			fetcher := app.NewBlockFetcher(
+				ctx,
+				startI,
+				endI,
+				concurrency,
+				func(ctx context.Context, num int64) (interface{}, error) {
+					return fetchSeekBlock(ctx, num, traceMethod)
+				},
+			)
+			defer fetcher.Close()
+			// 上一个已处理区块的hash，用于检测回滚
+			prevHash, err := getSavedBlockHash(ctx, xenv.DbCon, startI-1)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
 			// 遍历获取需要查询的block信息
 			for i := startI; i < endI; i++ {
 				// rpc获取block信息
 				//mcommon.Log.Debugf("eth check block: %d", i)
-				rpcBlock, err := ethclient.RPCBlockByNum(context.Background(), i)
+				fetchBlock, err := fetcher.Get(i)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					return
+				}
+				seekBlock := fetchBlock.(*stSeekBlock)
+				rpcBlock := seekBlock.block
+				if prevHash != "" && rpcBlock.ParentHash().Hex() != prevHash {
+					// 父区块不匹配，发生了回滚
+					err = handleReorg(ctx, i-1)
+					if err != nil {
+						app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					}
 					return
 				}
 				// 接收地址列表
@@ -217,9 +263,9 @@ func CheckBlockSeek() {
 					// TODO : check big num error
 					// 转账数额大于0 and 不是创建合约交易
 					if rpcTx.Value().Int64() != 0 && rpcTx.To() != nil {
-						msg, err := rpcTx.AsMessage(types.NewEIP155Signer(rpcTx.ChainId()))
+						msg, err := rpcTx.AsMessage(types.LatestSignerForChainID(rpcTx.ChainId()), rpcBlock.BaseFee())
 						if err != nil {
-							mcommon.Log.Errorf("AsMessage err: [%T] %s", err, err.Error())
+							app.JobErrorf(ctx, "AsMessage err: [%T] %s", err, err.Error())
 							return
 						}
 						if mcommon.IsStringInSlice(feeAddresses, AddressBytesToStr(msg.From())) {
@@ -233,9 +279,18 @@ func CheckBlockSeek() {
 						}
 					}
 				}
+				// map[接收地址] => []内部转账
+				toAddressTransferMap := make(map[string][]*ethclient.StInternalTransfer)
+				for _, transfer := range seekBlock.transfers {
+					toAddress := AddressBytesToStr(common.HexToAddress(transfer.To))
+					toAddressTransferMap[toAddress] = append(toAddressTransferMap[toAddress], transfer)
+					if !mcommon.IsStringInSlice(toAddresses, toAddress) {
+						toAddresses = append(toAddresses, toAddress)
+					}
+				}
 				// 从db中查询这些地址是否是冲币地址中的地址
 				dbAddressRows, err := app.SQLSelectTAddressKeyColByAddress(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					[]string{
 						model.DBColTAddressKeyAddress,
@@ -244,7 +299,7 @@ func CheckBlockSeek() {
 					toAddresses,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				// 待插入数据
@@ -264,20 +319,23 @@ func CheckBlockSeek() {
 					// 获取地址对应的交易列表
 					txes := toAddressTxMap[dbAddressRow.Address]
 					for _, tx := range txes {
-						msg, err := tx.AsMessage(types.NewEIP155Signer(tx.ChainId()))
+						msg, err := tx.AsMessage(types.LatestSignerForChainID(tx.ChainId()), rpcBlock.BaseFee())
 						if err != nil {
-							mcommon.Log.Errorf("AsMessage err: [%T] %s", err, err.Error())
+							app.JobErrorf(ctx, "AsMessage err: [%T] %s", err, err.Error())
 							return
 						}
 						fromAddress := AddressBytesToStr(msg.From())
 						toAddress := AddressBytesToStr(*(tx.To()))
 						balanceReal, err := WeiBigIntToEthStr(tx.Value())
 						if err != nil {
-							mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+							app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 							return
 						}
 						dbTxRows = append(dbTxRows, &model.DBTTx{
+							Chain:        chainName(ctx),
 							ProductID:    addressProductMap[toAddress],
+							BlockNumber:  i,
+							BlockHash:    rpcBlock.Hash().Hex(),
 							TxID:         tx.Hash().String(),
 							FromAddress:  fromAddress,
 							ToAddress:    toAddress,
@@ -291,29 +349,63 @@ func CheckBlockSeek() {
 							OrgTime:      now,
 						})
 					}
+					// 合约内部转账
+					for _, transfer := range toAddressTransferMap[dbAddressRow.Address] {
+						balanceReal, err := WeiBigIntToEthStr(transfer.Value)
+						if err != nil {
+							app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+							return
+						}
+						dbTxRows = append(dbTxRows, &model.DBTTx{
+							Chain:        chainName(ctx),
+							ProductID:    dbAddressRow.UseTag,
+							BlockNumber:  i,
+							BlockHash:    rpcBlock.Hash().Hex(),
+							TxID:         transfer.TxHash,
+							TraceAddress: transfer.TraceAddress,
+							FromAddress:  AddressBytesToStr(common.HexToAddress(transfer.From)),
+							ToAddress:    dbAddressRow.Address,
+							BalanceReal:  balanceReal,
+							CreateTime:   now,
+							HandleStatus: app.TxStatusInit,
+							HandleMsg:    "",
+							HandleTime:   now,
+							OrgStatus:    app.TxOrgStatusInit,
+							OrgMsg:       "",
+							OrgTime:      now,
+						})
+					}
 				}
 				// 插入交易数据
 				_, err = model.SQLCreateManyTTx(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					dbTxRows,
 					true,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
+				app.JobAddCount(ctx, int64(len(dbTxRows)))
+				// 记录区块hash
+				err = saveBlock(ctx, xenv.DbCon, rpcBlock)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					return
+				}
+				prevHash = rpcBlock.Hash().Hex()
 				// 更新检查到的最新区块数
 				_, err = app.SQLUpdateTAppStatusIntByKGreater(
-					context.Background(),
+					ctx,
 					xenv.DbCon,
 					&model.DBTAppStatusInt{
-						K: "eth_seek_num",
+						K: chainKey(ctx, "eth_seek_num"),
 						V: i,
 					},
 				)
 				if err != nil {
-					mcommon.Log.Errorf("SQLUpdateTAppStatusIntByK err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "SQLUpdateTAppStatusIntByK err: [%T] %s", err, err.Error())
 					return
 				}
 			}
@@ -322,29 +414,30 @@ func CheckBlockSeek() {
 }
 
 // CheckAddressOrg 零钱整理到冷钱包
-func CheckAddressOrg() {
-	lockKey := "EthCheckAddressOrg"
-	app.LockWrap(lockKey, func() {
+func CheckAddressOrg(ctx context.Context) {
+	lockKey := chainKey(ctx, "EthCheckAddressOrg")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取冷钱包地址
 		coldAddressValue, err := app.SQLGetTAppConfigStrValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
-			"cold_wallet_address_eth",
+			chainKey(ctx, "cold_wallet_address_eth"),
 		)
 		if err != nil {
 			mcommon.Log.Warnf("SQLGetTAppConfigInt err: [%T] %s", err, err.Error())
 			return
 		}
-		coldAddress, err := StrToAddressBytes(coldAddressValue)
+		err = checkColdAddress(coldAddressValue)
 		if err != nil {
-			mcommon.Log.Errorf("eth organize cold address err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "eth organize cold address err: [%T] %s", err, err.Error())
 			return
 		}
+		coldAddress := common.HexToAddress(coldAddressValue)
 		// 开启事物
 		isComment := false
-		dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+		dbTx, err := xenv.DbCon.BeginTxx(ctx, nil)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		defer func() {
@@ -354,7 +447,7 @@ func CheckAddressOrg() {
 		}()
 		// 获取待整理的交易列表
 		txRows, err := app.SQLSelectTTxColByOrgForUpdate(
-			context.Background(),
+			ctx,
 			dbTx,
 			[]string{
 				model.DBColTTxID,
@@ -362,9 +455,10 @@ func CheckAddressOrg() {
 				model.DBColTTxBalanceReal,
 			},
 			app.TxOrgStatusInit,
+			chainName(ctx),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		if len(txRows) <= 0 {
@@ -373,21 +467,25 @@ func CheckAddressOrg() {
 		}
 		// 获取gap price
 		gasPriceValue, err := app.SQLGetTAppStatusIntValueByK(
-			context.Background(),
+			ctx,
 			dbTx,
-			"to_cold_gas_price_eth",
+			chainKey(ctx, "to_cold_gas_price_eth"),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		fee, err := GetTxFee(ctx, gasPriceValue)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
-		gasPrice := gasPriceValue
 		gasLimit := int64(21000)
-		feeValue := big.NewInt(gasLimit * gasPrice)
+		feeValue := big.NewInt(gasLimit * fee.GasPrice)
 		// chain id
-		chainID, err := ethclient.RPCNetworkID(context.Background())
+		chainID, err := ethclient.RPCNetworkID(ctx)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 当前时间
@@ -413,7 +511,7 @@ func CheckAddressOrg() {
 			info.RowIDs = append(info.RowIDs, txRow.ID)
 			txWei, err := EthStrToWeiBigInit(txRow.BalanceReal)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			info.Balance.Add(info.Balance, txWei)
@@ -422,27 +520,51 @@ func CheckAddressOrg() {
 				addresses = append(addresses, txRow.ToAddress)
 			}
 		}
+		// forwarder地址通过工厂合约合并整理
+		forwarderMap, err := getForwarderMap(ctx, dbTx, addresses)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		var forwarderOrgs []*stForwarderOrg
+		var keyAddresses []string
+		for _, address := range addresses {
+			if _, ok := forwarderMap[address]; ok {
+				forwarderOrgs = append(forwarderOrgs, &stForwarderOrg{
+					Address: address,
+					RowIDs:  addressMap[address].RowIDs,
+				})
+				delete(addressMap, address)
+				continue
+			}
+			keyAddresses = append(keyAddresses, address)
+		}
+		err = orgForwarders(ctx, dbTx, chainID, fee, forwarderMap, nil, AddressBytesToStr(coldAddress), forwarderOrgs)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
 		// 获取地址私钥
 		addressPKMap, err := GetPKMapOfAddresses(
-			context.Background(),
+			ctx,
 			dbTx,
-			addresses,
+			keyAddresses,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		for address, info := range addressMap {
 			// 获取私钥
 			privateKey, ok := addressPKMap[address]
 			if !ok {
-				mcommon.Log.Errorf("no key of: %s", address)
+				app.JobErrorf(ctx, "no key of: %s", address)
 				continue
 			}
 			// 获取nonce值
-			nonce, err := GetNonce(dbTx, address)
+			nonce, err := GetNonce(ctx, dbTx, address)
 			if err != nil {
-				mcommon.Log.Errorf("GetNonce err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "GetNonce err: [%T] %s", err, err.Error())
 				return
 			}
 			// 发送数量
@@ -454,28 +576,25 @@ func CheckAddressOrg() {
 			}
 			sendBalanceReal, err := WeiBigIntToEthStr(sendBalance)
 			if err != nil {
-				mcommon.Log.Errorf("GetNonce err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "GetNonce err: [%T] %s", err, err.Error())
 				return
 			}
-			// 生成tx
+			// 生成tx并签名
 			var data []byte
-			tx := types.NewTransaction(
-				uint64(nonce),
+			signedTx, rawTxHex, err := SignTx(
+				chainID,
+				nonce,
 				coldAddress,
 				sendBalance,
-				uint64(gasLimit),
-				big.NewInt(gasPrice),
+				gasLimit,
+				fee,
 				data,
+				privateKey,
 			)
-			// 签名
-			signedTx, err := types.SignTx(tx, types.NewEIP155Signer(big.NewInt(chainID)), privateKey)
 			if err != nil {
 				mcommon.Log.Warnf("RPCNetworkID err: [%T] %s", err, err.Error())
 				return
 			}
-			ts := types.Transactions{signedTx}
-			rawTxBytes := ts.GetRlp(0)
-			rawTxHex := hex.EncodeToString(rawTxBytes)
 			txHash := strings.ToLower(signedTx.Hash().Hex())
 			// 创建存入数据
 			var sendRows []*model.DBTSend
@@ -483,24 +602,28 @@ func CheckAddressOrg() {
 				if rowIndex == 0 {
 					// 只有第一条数据需要发送，其余数据为占位数据
 					sendRows = append(sendRows, &model.DBTSend{
-						RelatedType:  app.SendRelationTypeTx,
-						RelatedID:    rowID,
-						TxID:         txHash,
-						FromAddress:  address,
-						ToAddress:    coldAddressValue,
-						BalanceReal:  sendBalanceReal,
-						Gas:          gasLimit,
-						GasPrice:     gasPrice,
-						Nonce:        nonce,
-						Hex:          rawTxHex,
-						CreateTime:   now,
-						HandleStatus: app.SendStatusInit,
-						HandleMsg:    "",
-						HandleTime:   now,
+						Chain:                chainName(ctx),
+						RelatedType:          app.SendRelationTypeTx,
+						RelatedID:            rowID,
+						TxID:                 txHash,
+						FromAddress:          address,
+						ToAddress:            coldAddressValue,
+						BalanceReal:          sendBalanceReal,
+						Gas:                  gasLimit,
+						GasPrice:             fee.GasPrice,
+						MaxFeePerGas:         fee.MaxFeePerGas,
+						MaxPriorityFeePerGas: fee.MaxPriorityFeePerGas,
+						Nonce:                nonce,
+						Hex:                  rawTxHex,
+						CreateTime:           now,
+						HandleStatus:         app.SendStatusInit,
+						HandleMsg:            "",
+						HandleTime:           now,
 					})
 				} else {
 					// 占位数据
 					sendRows = append(sendRows, &model.DBTSend{
+						Chain:        chainName(ctx),
 						RelatedType:  app.SendRelationTypeTx,
 						RelatedID:    rowID,
 						TxID:         txHash,
@@ -520,18 +643,19 @@ func CheckAddressOrg() {
 			}
 			// 插入发送数据
 			_, err = model.SQLCreateManyTSend(
-				context.Background(),
+				ctx,
 				dbTx,
 				sendRows,
 				true,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
+			app.JobAddCount(ctx, int64(len(sendRows)))
 			// 更改tx整理状态
 			_, err = app.SQLUpdateTTxOrgStatusByIDs(
-				context.Background(),
+				ctx,
 				dbTx,
 				info.RowIDs,
 				model.DBTTx{
@@ -541,14 +665,14 @@ func CheckAddressOrg() {
 				},
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 		}
 		// 提交事物
 		err = dbTx.Commit()
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		isComment = true
@@ -556,38 +680,45 @@ func CheckAddressOrg() {
 }
 
 // CheckRawTxSend 发送交易
-func CheckRawTxSend() {
-	lockKey := "EthCheckRawTxSend"
-	app.LockWrap(lockKey, func() {
+func CheckRawTxSend(ctx context.Context) {
+	lockKey := chainKey(ctx, "EthCheckRawTxSend")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取待发送的数据
 		sendRows, err := app.SQLSelectTSendColByStatus(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTSendID,
 				model.DBColTSendTxID,
+				model.DBColTSendFromAddress,
 				model.DBColTSendHex,
 				model.DBColTSendRelatedType,
 				model.DBColTSendRelatedID,
 			},
 			app.SendStatusInit,
+			chainName(ctx),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		// 批量提币关联的提币
+		sendWithdrawMap, err := getSendWithdrawMap(ctx, sendRows)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 首先单独处理提币，提取提币通知要使用的数据
 		var withdrawIDs []int64
 		for _, sendRow := range sendRows {
-			switch sendRow.RelatedType {
-			case app.SendRelationTypeWithdraw:
-				if !mcommon.IsIntInSlice(withdrawIDs, sendRow.RelatedID) {
-					withdrawIDs = append(withdrawIDs, sendRow.RelatedID)
+			for _, withdrawID := range sendWithdrawIDs(sendRow, sendWithdrawMap) {
+				if !mcommon.IsIntInSlice(withdrawIDs, withdrawID) {
+					withdrawIDs = append(withdrawIDs, withdrawID)
 				}
 			}
 		}
 		withdrawMap, err := app.SQLGetWithdrawMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTWithdrawID,
@@ -596,6 +727,7 @@ func CheckRawTxSend() {
 				model.DBColTWithdrawToAddress,
 				model.DBColTWithdrawSymbol,
 				model.DBColTWithdrawBalanceReal,
+				model.DBColTWithdrawTokenID,
 			},
 			withdrawIDs,
 		)
@@ -607,13 +739,14 @@ func CheckRawTxSend() {
 			}
 		}
 		productMap, err := app.SQLGetProductMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTProductID,
 				model.DBColTProductAppName,
 				model.DBColTProductCbURL,
 				model.DBColTProductAppSk,
+				model.DBColTProductNotifyVersion,
 			},
 			productIDs,
 		)
@@ -622,11 +755,16 @@ func CheckRawTxSend() {
 		var txIDs []int64
 		var erc20TxIDs []int64
 		var erc20TxFeeIDs []int64
+		var nftTxIDs []int64
+		var nftTxFeeIDs []int64
 		withdrawIDs = []int64{}
 		// 通知数据
 		var notifyRows []*model.DBTProductNotify
 		now := time.Now().Unix()
 		var sendTxHashes []string
+		// 发送失败的数据
+		var failRows []*model.DBTSend
+		failMap := make(map[string]string)
 		onSendOk := func(sendRow *model.DBTSend) error {
 			// 将发送成功和占位数据计入数组
 			if !mcommon.IsIntInSlice(sendIDs, sendRow.ID) {
@@ -637,10 +775,6 @@ func CheckRawTxSend() {
 				if !mcommon.IsIntInSlice(txIDs, sendRow.RelatedID) {
 					txIDs = append(txIDs, sendRow.RelatedID)
 				}
-			case app.SendRelationTypeWithdraw:
-				if !mcommon.IsIntInSlice(withdrawIDs, sendRow.RelatedID) {
-					withdrawIDs = append(withdrawIDs, sendRow.RelatedID)
-				}
 			case app.SendRelationTypeTxErc20:
 				if !mcommon.IsIntInSlice(erc20TxIDs, sendRow.RelatedID) {
 					erc20TxIDs = append(erc20TxIDs, sendRow.RelatedID)
@@ -649,49 +783,52 @@ func CheckRawTxSend() {
 				if !mcommon.IsIntInSlice(erc20TxFeeIDs, sendRow.RelatedID) {
 					erc20TxFeeIDs = append(erc20TxFeeIDs, sendRow.RelatedID)
 				}
+			case app.SendRelationTypeTxNft:
+				if !mcommon.IsIntInSlice(nftTxIDs, sendRow.RelatedID) {
+					nftTxIDs = append(nftTxIDs, sendRow.RelatedID)
+				}
+			case app.SendRelationTypeTxNftFee:
+				if !mcommon.IsIntInSlice(nftTxFeeIDs, sendRow.RelatedID) {
+					nftTxFeeIDs = append(nftTxFeeIDs, sendRow.RelatedID)
+				}
 			}
 			// 如果是提币，创建通知信息
-			if sendRow.RelatedType == app.SendRelationTypeWithdraw {
-				withdrawRow, ok := withdrawMap[sendRow.RelatedID]
+			for _, withdrawID := range sendWithdrawIDs(sendRow, sendWithdrawMap) {
+				if !mcommon.IsIntInSlice(withdrawIDs, withdrawID) {
+					withdrawIDs = append(withdrawIDs, withdrawID)
+				}
+				withdrawRow, ok := withdrawMap[withdrawID]
 				if !ok {
-					mcommon.Log.Errorf("withdrawMap no: %d", sendRow.RelatedID)
+					app.JobErrorf(ctx, "withdrawMap no: %d", withdrawID)
 					return nil
 				}
 				productRow, ok := productMap[withdrawRow.ProductID]
 				if !ok {
-					mcommon.Log.Errorf("productMap no: %d", withdrawRow.ProductID)
+					app.JobErrorf(ctx, "productMap no: %d", withdrawRow.ProductID)
 					return nil
 				}
-				nonce := mcommon.GetUUIDStr()
-				reqObj := gin.H{
-					"tx_hash":     sendRow.TxID,
-					"balance":     withdrawRow.BalanceReal,
-					"app_name":    productRow.AppName,
-					"out_serial":  withdrawRow.OutSerial,
-					"address":     withdrawRow.ToAddress,
-					"symbol":      withdrawRow.Symbol,
-					"notify_type": app.NotifyTypeWithdrawSend,
-				}
-				reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
-				req, err := json.Marshal(reqObj)
+				notifyRow, err := app.GetNotifyRow(
+					productRow,
+					&app.StNotifyData{
+						ProductID:   withdrawRow.ProductID,
+						ItemType:    app.SendRelationTypeWithdraw,
+						ItemID:      withdrawRow.ID,
+						NotifyType:  app.NotifyTypeWithdrawSend,
+						Symbol:      withdrawRow.Symbol,
+						TxHash:      sendRow.TxID,
+						Address:     withdrawRow.ToAddress,
+						FromAddress: sendRow.FromAddress,
+						Balance:     withdrawRow.BalanceReal,
+						TokenID:     withdrawRow.TokenID,
+						OutSerial:   withdrawRow.OutSerial,
+					},
+					now,
+				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return err
 				}
-				notifyRows = append(notifyRows, &model.DBTProductNotify{
-					Nonce:        nonce,
-					ProductID:    withdrawRow.ProductID,
-					ItemType:     app.SendRelationTypeWithdraw,
-					ItemID:       withdrawRow.ID,
-					NotifyType:   app.NotifyTypeWithdrawSend,
-					TokenSymbol:  withdrawRow.Symbol,
-					URL:          productRow.CbURL,
-					Msg:          string(req),
-					HandleStatus: app.NotifyStatusInit,
-					HandleMsg:    "",
-					CreateTime:   now,
-					UpdateTime:   now,
-				})
+				notifyRows = append(notifyRows, notifyRow)
 			}
 			return nil
 		}
@@ -700,54 +837,75 @@ func CheckRawTxSend() {
 			if sendRow.Hex != "" {
 				rawTxBytes, err := hex.DecodeString(sendRow.Hex)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					continue
 				}
 				tx := new(types.Transaction)
-				err = rlp.DecodeBytes(rawTxBytes, &tx)
+				err = tx.UnmarshalBinary(rawTxBytes)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					continue
 				}
 				err = ethclient.RPCSendTransaction(
-					context.Background(),
+					ctx,
 					tx,
 				)
 				if err != nil {
 					if !strings.Contains(err.Error(), "known transaction") {
-						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-						continue
+						app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+						if IsSendRejected(err) {
+							// 交易可能已经打包
+							rpcTx, rpcErr := ethclient.RPCTransactionByHash(
+								ctx,
+								sendRow.TxID,
+							)
+							if rpcErr != nil || rpcTx == nil {
+								failMap[sendRow.TxID] = fmt.Sprintf("send rejected: %s", err.Error())
+								failRows = append(failRows, sendRow)
+								continue
+							}
+						} else {
+							continue
+						}
 					}
 				}
 				sendTxHashes = append(sendTxHashes, sendRow.TxID)
 
 				err = onSendOk(sendRow)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 			} else if mcommon.IsStringInSlice(sendTxHashes, sendRow.TxID) {
 				err = onSendOk(sendRow)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
+			} else if _, ok := failMap[sendRow.TxID]; ok {
+				failRows = append(failRows, sendRow)
 			}
 		}
+		// 处理被拒绝的交易
+		err = handleSendFailed(ctx, failRows, failMap)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
 		// 插入通知
 		_, err = model.SQLCreateManyTProductNotify(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			notifyRows,
 			true,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新提币状态
 		_, err = app.SQLUpdateTWithdrawStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			withdrawIDs,
 			&model.DBTWithdraw{
@@ -757,12 +915,12 @@ func CheckRawTxSend() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新eth零钱整理状态
 		_, err = app.SQLUpdateTTxOrgStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			txIDs,
 			model.DBTTx{
@@ -772,12 +930,12 @@ func CheckRawTxSend() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新erc20零钱整理状态
 		_, err = app.SQLUpdateTTxErc20OrgStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			erc20TxIDs,
 			model.DBTTxErc20{
@@ -787,12 +945,12 @@ func CheckRawTxSend() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新erc20手续费状态
 		_, err = app.SQLUpdateTTxErc20OrgStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			erc20TxFeeIDs,
 			model.DBTTxErc20{
@@ -802,12 +960,42 @@ func CheckRawTxSend() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		// 更新nft零钱整理状态
+		_, err = app.SQLUpdateTTxNftOrgStatusByIDs(
+			ctx,
+			xenv.DbCon,
+			nftTxIDs,
+			model.DBTTxNft{
+				OrgStatus: app.TxOrgStatusSend,
+				OrgMsg:    "send",
+				OrgTime:   now,
+			},
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		// 更新nft手续费状态
+		_, err = app.SQLUpdateTTxNftOrgStatusByIDs(
+			ctx,
+			xenv.DbCon,
+			nftTxFeeIDs,
+			model.DBTTxNft{
+				OrgStatus: app.TxOrgStatusFeeSend,
+				OrgMsg:    "fee send",
+				OrgTime:   now,
+			},
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新发送状态
 		_, err = app.SQLUpdateTSendStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			sendIDs,
 			model.DBTSend{
@@ -817,43 +1005,63 @@ func CheckRawTxSend() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
+		app.JobAddCount(ctx, int64(len(sendIDs)))
 	})
 }
 
 // CheckRawTxConfirm 确认tx是否打包完成
-func CheckRawTxConfirm() {
-	lockKey := "EthCheckRawTxConfirm"
-	app.LockWrap(lockKey, func() {
+func CheckRawTxConfirm(ctx context.Context) {
+	lockKey := chainKey(ctx, "EthCheckRawTxConfirm")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		sendRows, err := app.SQLSelectTSendColByStatus(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTSendID,
 				model.DBColTSendRelatedType,
 				model.DBColTSendRelatedID,
-				model.DBColTSendID,
 				model.DBColTSendTxID,
+				model.DBColTSendFromAddress,
+				model.DBColTSendBalanceReal,
+				model.DBColTSendGasPrice,
+				model.DBColTSendMaxPriorityFeePerGas,
+				model.DBColTSendNonce,
+				model.DBColTSendHex,
+				model.DBColTSendHandleTime,
 			},
 			app.SendStatusSend,
+			chainName(ctx),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		// 被替换的交易已打包时改用该交易
+		err = resolveReplacedTx(ctx, sendRows)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		// 批量提币关联的提币
+		sendWithdrawMap, err := getSendWithdrawMap(ctx, sendRows)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		var withdrawIDs []int64
 		for _, sendRow := range sendRows {
-			if sendRow.RelatedType == app.SendRelationTypeWithdraw {
-				// 提币
-				if !mcommon.IsIntInSlice(withdrawIDs, sendRow.RelatedID) {
-					withdrawIDs = append(withdrawIDs, sendRow.RelatedID)
+			// 提币
+			for _, withdrawID := range sendWithdrawIDs(sendRow, sendWithdrawMap) {
+				if !mcommon.IsIntInSlice(withdrawIDs, withdrawID) {
+					withdrawIDs = append(withdrawIDs, withdrawID)
 				}
 			}
 		}
 		withdrawMap, err := app.SQLGetWithdrawMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTWithdrawID,
@@ -862,11 +1070,12 @@ func CheckRawTxConfirm() {
 				model.DBColTWithdrawToAddress,
 				model.DBColTWithdrawBalanceReal,
 				model.DBColTWithdrawSymbol,
+				model.DBColTWithdrawTokenID,
 			},
 			withdrawIDs,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		var productIDs []int64
@@ -876,87 +1085,170 @@ func CheckRawTxConfirm() {
 			}
 		}
 		productMap, err := app.SQLGetProductMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTProductID,
 				model.DBColTProductAppName,
 				model.DBColTProductCbURL,
 				model.DBColTProductAppSk,
+				model.DBColTProductNotifyVersion,
 			},
 			productIDs,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 
+		// 当前高度 用于计算确认数
+		rpcBlockNum, err := ethclient.RPCBlockNumber(ctx)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
 		now := time.Now().Unix()
 		var notifyRows []*model.DBTProductNotify
 		var sendIDs []int64
 		var txIDs []int64
 		var erc20TxIDs []int64
 		var erc20TxFeeIDs []int64
+		var nftTxIDs []int64
+		var nftTxFeeIDs []int64
 		withdrawIDs = []int64{}
 		var sendHashes []string
+		// 打包信息
+		receiptMap := make(map[string]*types.Receipt)
+		feeMap := make(map[string]string)
+		// 执行失败的数据
+		var failRows []*model.DBTSend
+		failMap := make(map[string]string)
 		for _, sendRow := range sendRows {
 			if !mcommon.IsStringInSlice(sendHashes, sendRow.TxID) {
 				rpcTx, err := ethclient.RPCTransactionByHash(
-					context.Background(),
+					ctx,
 					sendRow.TxID,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					continue
 				}
 				if rpcTx == nil {
 					continue
 				}
+				// 检测执行结果
+				rpcReceipt, err := ethclient.RPCTransactionReceipt(
+					ctx,
+					sendRow.TxID,
+				)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					continue
+				}
+				if rpcReceipt.Status != types.ReceiptStatusSuccessful {
+					if rpcReceipt.GasUsed >= rpcTx.Gas() {
+						failMap[sendRow.TxID] = fmt.Sprintf("tx out of gas in block %s", rpcReceipt.BlockNumber.String())
+					} else {
+						failMap[sendRow.TxID] = fmt.Sprintf("tx reverted in block %s", rpcReceipt.BlockNumber.String())
+					}
+				}
+				// 记录实际消耗的gas
+				_, err = app.SQLUpdateTSendGasUsedByTxID(
+					ctx,
+					xenv.DbCon,
+					sendRow.TxID,
+					int64(rpcReceipt.GasUsed),
+				)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					continue
+				}
+				// 实际手续费
+				gasPrice, err := GetEffectiveGasPrice(ctx, rpcTx, rpcReceipt)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					continue
+				}
+				fee, err := WeiBigIntToEthStr(
+					new(big.Int).Mul(
+						new(big.Int).SetUint64(rpcReceipt.GasUsed),
+						gasPrice,
+					),
+				)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					continue
+				}
+				receiptMap[sendRow.TxID] = rpcReceipt
+				feeMap[sendRow.TxID] = fee
 				sendHashes = append(sendHashes, sendRow.TxID)
 			}
-			if sendRow.RelatedType == app.SendRelationTypeWithdraw {
+			rpcReceipt := receiptMap[sendRow.TxID]
+			// 批量提币的手续费平均分摊到每笔提币
+			relatedWithdrawIDs := sendWithdrawIDs(sendRow, sendWithdrawMap)
+			withdrawFee := splitFee(feeMap[sendRow.TxID], len(relatedWithdrawIDs))
+			for _, withdrawID := range relatedWithdrawIDs {
+				// 记录提币打包信息
+				_, err = app.SQLUpdateTWithdrawBlockByID(
+					ctx,
+					xenv.DbCon,
+					&model.DBTWithdraw{
+						ID:          withdrawID,
+						Fee:         withdrawFee,
+						BlockNumber: rpcReceipt.BlockNumber.Int64(),
+						BlockHash:   rpcReceipt.BlockHash.Hex(),
+					},
+				)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					return
+				}
+			}
+			if _, ok := failMap[sendRow.TxID]; ok {
+				failRows = append(failRows, sendRow)
+				continue
+			}
+			for _, withdrawID := range relatedWithdrawIDs {
 				// 提币
-				withdrawRow, ok := withdrawMap[sendRow.RelatedID]
+				if !mcommon.IsIntInSlice(withdrawIDs, withdrawID) {
+					withdrawIDs = append(withdrawIDs, withdrawID)
+				}
+				withdrawRow, ok := withdrawMap[withdrawID]
 				if !ok {
-					mcommon.Log.Errorf("no withdrawMap: %d", sendRow.RelatedID)
+					app.JobErrorf(ctx, "no withdrawMap: %d", withdrawID)
 					return
 				}
 				productRow, ok := productMap[withdrawRow.ProductID]
 				if !ok {
-					mcommon.Log.Errorf("no productMap: %d", withdrawRow.ProductID)
+					app.JobErrorf(ctx, "no productMap: %d", withdrawRow.ProductID)
 					return
 				}
-				nonce := mcommon.GetUUIDStr()
-				reqObj := gin.H{
-					"tx_hash":     sendRow.TxID,
-					"balance":     withdrawRow.BalanceReal,
-					"app_name":    productRow.AppName,
-					"out_serial":  withdrawRow.OutSerial,
-					"address":     withdrawRow.ToAddress,
-					"symbol":      withdrawRow.Symbol,
-					"notify_type": app.NotifyTypeWithdrawConfirm,
-				}
-				reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
-				req, err := json.Marshal(reqObj)
+				notifyRow, err := app.GetNotifyRow(
+					productRow,
+					&app.StNotifyData{
+						ProductID:     withdrawRow.ProductID,
+						ItemType:      app.SendRelationTypeWithdraw,
+						ItemID:        withdrawRow.ID,
+						NotifyType:    app.NotifyTypeWithdrawConfirm,
+						Symbol:        withdrawRow.Symbol,
+						TxHash:        sendRow.TxID,
+						Address:       withdrawRow.ToAddress,
+						FromAddress:   sendRow.FromAddress,
+						Balance:       withdrawRow.BalanceReal,
+						TokenID:       withdrawRow.TokenID,
+						OutSerial:     withdrawRow.OutSerial,
+						BlockNumber:   rpcReceipt.BlockNumber.Int64(),
+						BlockHash:     rpcReceipt.BlockHash.Hex(),
+						Confirmations: rpcBlockNum - rpcReceipt.BlockNumber.Int64() + 1,
+						Fee:           withdrawFee,
+					},
+					now,
+				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
-				notifyRows = append(notifyRows, &model.DBTProductNotify{
-					Nonce:        nonce,
-					ProductID:    withdrawRow.ProductID,
-					ItemType:     app.SendRelationTypeWithdraw,
-					ItemID:       withdrawRow.ID,
-					NotifyType:   app.NotifyTypeWithdrawConfirm,
-					TokenSymbol:  withdrawRow.Symbol,
-					URL:          productRow.CbURL,
-					Msg:          string(req),
-					HandleStatus: app.NotifyStatusInit,
-					HandleMsg:    "",
-					CreateTime:   now,
-					UpdateTime:   now,
-				})
-
+				notifyRows = append(notifyRows, notifyRow)
 			}
 			// 将发送成功和占位数据计入数组
 			if !mcommon.IsIntInSlice(sendIDs, sendRow.ID) {
@@ -967,10 +1259,6 @@ func CheckRawTxConfirm() {
 				if !mcommon.IsIntInSlice(txIDs, sendRow.RelatedID) {
 					txIDs = append(txIDs, sendRow.RelatedID)
 				}
-			case app.SendRelationTypeWithdraw:
-				if !mcommon.IsIntInSlice(withdrawIDs, sendRow.RelatedID) {
-					withdrawIDs = append(withdrawIDs, sendRow.RelatedID)
-				}
 			case app.SendRelationTypeTxErc20:
 				if !mcommon.IsIntInSlice(erc20TxIDs, sendRow.RelatedID) {
 					erc20TxIDs = append(erc20TxIDs, sendRow.RelatedID)
@@ -979,22 +1267,30 @@ func CheckRawTxConfirm() {
 				if !mcommon.IsIntInSlice(erc20TxFeeIDs, sendRow.RelatedID) {
 					erc20TxFeeIDs = append(erc20TxFeeIDs, sendRow.RelatedID)
 				}
+			case app.SendRelationTypeTxNft:
+				if !mcommon.IsIntInSlice(nftTxIDs, sendRow.RelatedID) {
+					nftTxIDs = append(nftTxIDs, sendRow.RelatedID)
+				}
+			case app.SendRelationTypeTxNftFee:
+				if !mcommon.IsIntInSlice(nftTxFeeIDs, sendRow.RelatedID) {
+					nftTxFeeIDs = append(nftTxFeeIDs, sendRow.RelatedID)
+				}
 			}
 		}
 		// 添加通知信息
 		_, err = model.SQLCreateManyTProductNotify(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			notifyRows,
 			true,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新提币状态
 		_, err = app.SQLUpdateTWithdrawStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			withdrawIDs,
 			&model.DBTWithdraw{
@@ -1004,12 +1300,12 @@ func CheckRawTxConfirm() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新eth零钱整理状态
 		_, err = app.SQLUpdateTTxOrgStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			txIDs,
 			model.DBTTx{
@@ -1019,12 +1315,12 @@ func CheckRawTxConfirm() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新erc20零钱整理状态
 		_, err = app.SQLUpdateTTxErc20OrgStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			erc20TxIDs,
 			model.DBTTxErc20{
@@ -1034,12 +1330,12 @@ func CheckRawTxConfirm() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新erc20零钱整理eth手续费状态
 		_, err = app.SQLUpdateTTxErc20OrgStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			erc20TxFeeIDs,
 			model.DBTTxErc20{
@@ -1049,12 +1345,42 @@ func CheckRawTxConfirm() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		// 更新nft零钱整理状态
+		_, err = app.SQLUpdateTTxNftOrgStatusByIDs(
+			ctx,
+			xenv.DbCon,
+			nftTxIDs,
+			model.DBTTxNft{
+				OrgStatus: app.TxOrgStatusConfirm,
+				OrgMsg:    "confirmed",
+				OrgTime:   now,
+			},
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		// 更新nft零钱整理eth手续费状态
+		_, err = app.SQLUpdateTTxNftOrgStatusByIDs(
+			ctx,
+			xenv.DbCon,
+			nftTxFeeIDs,
+			model.DBTTxNft{
+				OrgStatus: app.TxOrgStatusFeeConfirm,
+				OrgMsg:    "eth fee confirmed",
+				OrgTime:   now,
+			},
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 更新发送状态
 		_, err = app.SQLUpdateTSendStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			sendIDs,
 			model.DBTSend{
@@ -1063,111 +1389,301 @@ func CheckRawTxConfirm() {
 				HandleTime:   now,
 			},
 		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		app.JobAddCount(ctx, int64(len(sendIDs)))
+		// 处理执行失败的交易
+		err = handleSendFailed(ctx, failRows, failMap)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		// 长时间未打包的交易提高单价替换
+		var pendingRows []*model.DBTSend
+		for _, sendRow := range sendRows {
+			if !mcommon.IsStringInSlice(sendHashes, sendRow.TxID) {
+				pendingRows = append(pendingRows, sendRow)
+			}
+		}
+		err = replaceStuckTx(ctx, pendingRows)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
 	})
 }
 
+// handleSendFailed 处理发送失败的交易
+func handleSendFailed(ctx context.Context, sendRows []*model.DBTSend, failMap map[string]string) error {
+	if len(sendRows) == 0 {
+		return nil
+	}
+	// 批量提币关联的提币
+	sendWithdrawMap, err := getSendWithdrawMap(ctx, sendRows)
+	if err != nil {
+		return err
+	}
+	var withdrawIDs []int64
+	for _, sendRow := range sendRows {
+		if sendRow.RelatedType == app.SendRelationTypeWithdraw {
+			if !mcommon.IsIntInSlice(withdrawIDs, sendRow.RelatedID) {
+				withdrawIDs = append(withdrawIDs, sendRow.RelatedID)
+			}
+		}
+	}
+	withdrawMap, err := app.SQLGetWithdrawMap(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTWithdrawID,
+			model.DBColTWithdrawProductID,
+			model.DBColTWithdrawOutSerial,
+			model.DBColTWithdrawToAddress,
+			model.DBColTWithdrawBalanceReal,
+			model.DBColTWithdrawSymbol,
+			model.DBColTWithdrawTokenID,
+			model.DBColTWithdrawFee,
+			model.DBColTWithdrawBlockNumber,
+			model.DBColTWithdrawBlockHash,
+		},
+		withdrawIDs,
+	)
+	if err != nil {
+		return err
+	}
+	var productIDs []int64
+	for _, withdrawRow := range withdrawMap {
+		if !mcommon.IsIntInSlice(productIDs, withdrawRow.ProductID) {
+			productIDs = append(productIDs, withdrawRow.ProductID)
+		}
+	}
+	productMap, err := app.SQLGetProductMap(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTProductID,
+			model.DBColTProductAppName,
+			model.DBColTProductCbURL,
+			model.DBColTProductAppSk,
+			model.DBColTProductNotifyVersion,
+		},
+		productIDs,
+	)
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	var txIDs []int64
+	var erc20TxIDs []int64
+	var nftTxIDs []int64
+	var batchWithdrawIDs []int64
+	for _, sendRow := range sendRows {
+		reason := failMap[sendRow.TxID]
+		// 失败的发送不再占用nonce和余额
+		_, err = app.SQLUpdateTSendStatusByIDs(
+			ctx,
+			xenv.DbCon,
+			[]int64{sendRow.ID},
+			model.DBTSend{
+				HandleStatus: app.SendStatusFailed,
+				HandleMsg:    reason,
+				HandleTime:   now,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		switch sendRow.RelatedType {
+		case app.SendRelationTypeTx:
+			if !mcommon.IsIntInSlice(txIDs, sendRow.RelatedID) {
+				txIDs = append(txIDs, sendRow.RelatedID)
+			}
+		case app.SendRelationTypeWithdraw:
+			withdrawRow, ok := withdrawMap[sendRow.RelatedID]
+			if !ok {
+				app.JobErrorf(ctx, "no withdrawMap: %d", sendRow.RelatedID)
+				continue
+			}
+			productRow, ok := productMap[withdrawRow.ProductID]
+			if !ok {
+				app.JobErrorf(ctx, "no productMap: %d", withdrawRow.ProductID)
+			}
+			err = app.WithdrawFailed(
+				ctx,
+				xenv.DbCon,
+				withdrawRow,
+				productRow,
+				sendRow.TxID,
+				reason,
+			)
+			if err != nil {
+				return err
+			}
+		case app.SendRelationTypeTxErc20, app.SendRelationTypeTxErc20Fee:
+			if !mcommon.IsIntInSlice(erc20TxIDs, sendRow.RelatedID) {
+				erc20TxIDs = append(erc20TxIDs, sendRow.RelatedID)
+			}
+		case app.SendRelationTypeTxNft, app.SendRelationTypeTxNftFee:
+			if !mcommon.IsIntInSlice(nftTxIDs, sendRow.RelatedID) {
+				nftTxIDs = append(nftTxIDs, sendRow.RelatedID)
+			}
+		case app.SendRelationTypeWithdrawBatch:
+			batchWithdrawIDs = append(batchWithdrawIDs, sendWithdrawMap[sendRow.ID]...)
+		}
+	}
+	// 批量提币失败的改为逐笔提币
+	_, err = app.SQLUpdateTWithdrawStatusByIDs(
+		ctx,
+		xenv.DbCon,
+		batchWithdrawIDs,
+		&model.DBTWithdraw{
+			HandleStatus: app.WithdrawStatusInit,
+			HandleMsg:    "batch failed",
+			HandleTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	// 零钱整理失败的重新整理
+	_, err = app.SQLUpdateTTxOrgStatusByIDs(
+		ctx,
+		xenv.DbCon,
+		txIDs,
+		model.DBTTx{
+			OrgStatus: app.TxOrgStatusInit,
+			OrgMsg:    "send failed",
+			OrgTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	_, err = app.SQLUpdateTTxErc20OrgStatusByIDs(
+		ctx,
+		xenv.DbCon,
+		erc20TxIDs,
+		model.DBTTxErc20{
+			OrgStatus: app.TxOrgStatusInit,
+			OrgMsg:    "send failed",
+			OrgTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	_, err = app.SQLUpdateTTxNftOrgStatusByIDs(
+		ctx,
+		xenv.DbCon,
+		nftTxIDs,
+		model.DBTTxNft{
+			OrgStatus: app.TxOrgStatusInit,
+			OrgMsg:    "send failed",
+			OrgTime:   now,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // CheckWithdraw 检测提现
-func CheckWithdraw() {
-	lockKey := "EthCheckWithdraw"
-	app.LockWrap(lockKey, func() {
+func CheckWithdraw(ctx context.Context) {
+	lockKey := chainKey(ctx, "EthCheckWithdraw")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取需要处理的提币数据
 		withdrawRows, err := app.SQLSelectTWithdrawColByStatus(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTWithdrawID,
 			},
 			app.WithdrawStatusInit,
-			[]string{CoinSymbol},
+			[]string{chainSymbol(ctx)},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		if len(withdrawRows) == 0 {
 			// 没有要处理的提币
 			return
 		}
-		// 获取热钱包地址
+		// 获取热钱包地址，多个以逗号分隔
 		hotAddressValue, err := app.SQLGetTAppConfigStrValueByK(
-			context.Background(),
-			xenv.DbCon,
-			"hot_wallet_address_eth",
-		)
-		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-			return
-		}
-		_, err = StrToAddressBytes(hotAddressValue)
-		if err != nil {
-			mcommon.Log.Errorf("eth hot address err: [%T] %s", err, err.Error())
-			return
-		}
-		// 获取私钥
-		privateKey, err := GetPkOfAddress(
-			context.Background(),
-			xenv.DbCon,
-			hotAddressValue,
-		)
-		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-			return
-		}
-		// 获取热钱包余额
-		hotAddressBalance, err := ethclient.RPCBalanceAt(
-			context.Background(),
-			hotAddressValue,
-		)
-		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-			return
-		}
-		pendingBalanceRealStr, err := app.SQLGetTSendPendingBalanceReal(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
-			hotAddressValue,
+			chainKey(ctx, "hot_wallet_address_eth"),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
-		pendingBalance, err := EthStrToWeiBigInit(pendingBalanceRealStr)
+		hotAddresses, err := ParseHotAddresses(hotAddressValue)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "eth hot address err: [%T] %s", err, err.Error())
 			return
 		}
-		hotAddressBalance.Sub(hotAddressBalance, pendingBalance)
+		// 获取热钱包私钥和可用余额
+		var hotWallets []*StHotWallet
+		for _, hotAddress := range hotAddresses {
+			hotWallet, err := getHotWallet(ctx, hotAddress)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
+			hotWallets = append(hotWallets, hotWallet)
+		}
 		// 获取gap price
 		gasPriceValue, err := app.SQLGetTAppStatusIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
-			"to_user_gas_price_eth",
+			chainKey(ctx, "to_user_gas_price_eth"),
 		)
 		if err != nil {
 			mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
 			return
 		}
-		gasPrice := gasPriceValue
-		gasLimit := int64(21000)
-		feeValue := gasLimit * gasPrice
-		chainID, err := ethclient.RPCNetworkID(context.Background())
+		fee, err := GetTxFee(ctx, gasPriceValue)
+		if err != nil {
+			mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
+			return
+		}
+		gasLimit := int64(gasTransfer)
+		chainID, err := ethclient.RPCNetworkID(ctx)
 		if err != nil {
 			mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
 			return
 		}
+		// 配置了批量转账合约时合并发送，未能批量的提币逐笔发送
+		batchGasValue, err := getConfigInt(ctx, "withdraw_batch_gas_eth", WithdrawBatchGasEthDefault)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		withdrawRows, err = batchWithdraw(ctx, chainID, withdrawRows, hotWallets, nil, nil, batchGasValue, fee)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
 		for _, withdrawRow := range withdrawRows {
-			err = handleWithdraw(withdrawRow.ID, chainID, hotAddressValue, privateKey, hotAddressBalance, gasLimit, gasPrice, feeValue)
+			err = handleWithdraw(ctx, withdrawRow.ID, chainID, hotWallets, gasLimit, fee)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				continue
 			}
+			app.JobAddCount(ctx, 1)
 		}
 	})
 }
 
-func handleWithdraw(withdrawID int64, chainID int64, hotAddress string, privateKey *ecdsa.PrivateKey, hotAddressBalance *big.Int, gasLimit, gasPrice, feeValue int64) error {
+// handleWithdraw 处理eth提币，收款地址为合约时按估算的gas发送，估算失败时使用 defaultGas
+func handleWithdraw(ctx context.Context, withdrawID int64, chainID int64, hotWallets []*StHotWallet, defaultGas int64, fee *StTxFee) error {
 	isComment := false
-	dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+	dbTx, err := xenv.DbCon.BeginTxx(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -1178,12 +1694,19 @@ func handleWithdraw(withdrawID int64, chainID int64, hotAddress string, privateK
 	}()
 	// 处理业务
 	withdrawRow, err := app.SQLGetTWithdrawColForUpdate(
-		context.Background(),
+		ctx,
 		dbTx,
 		[]string{
 			model.DBColTWithdrawID,
 			model.DBColTWithdrawBalanceReal,
 			model.DBColTWithdrawToAddress,
+			model.DBColTWithdrawProductID,
+			model.DBColTWithdrawOutSerial,
+			model.DBColTWithdrawSymbol,
+			model.DBColTWithdrawTokenID,
+			model.DBColTWithdrawFee,
+			model.DBColTWithdrawBlockNumber,
+			model.DBColTWithdrawBlockHash,
 		},
 		withdrawID,
 		app.WithdrawStatusInit,
@@ -1198,16 +1721,45 @@ func handleWithdraw(withdrawID int64, chainID int64, hotAddress string, privateK
 	if err != nil {
 		return err
 	}
-	hotAddressBalance.Sub(hotAddressBalance, balanceBigInt)
-	hotAddressBalance.Sub(hotAddressBalance, big.NewInt(feeValue))
-	if hotAddressBalance.Cmp(new(big.Int)) < 0 {
-		mcommon.Log.Errorf("hot balance limit")
-		hotAddressBalance.Add(hotAddressBalance, balanceBigInt)
-		hotAddressBalance.Add(hotAddressBalance, big.NewInt(feeValue))
+	// 选择余额足够且排队交易最少的热钱包
+	needBalance := new(big.Int).Add(balanceBigInt, big.NewInt(defaultGas*fee.GasPrice))
+	hotWallet := selectHotWallet(hotWallets, func(hotWallet *StHotWallet) bool {
+		return hotWallet.Balance.Cmp(needBalance) >= 0
+	})
+	if hotWallet == nil {
+		app.JobErrorf(ctx, "hot balance limit")
+		return nil
+	}
+	hotAddress := hotWallet.Address
+	// 估算gas
+	gasLimit, gasEstimate, err := estimateGasLimit(ctx, hotAddress, withdrawRow.ToAddress, balanceBigInt, nil, defaultGas)
+	if errors.Is(err, ErrGasEstimateOverMax) {
+		app.JobErrorf(ctx, "withdraw %d failed: %s", withdrawRow.ID, err.Error())
+		err = withdrawGasOverMax(ctx, dbTx, withdrawRow, err.Error())
+		if err != nil {
+			return err
+		}
+		err = dbTx.Commit()
+		if err != nil {
+			return err
+		}
+		isComment = true
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	needBalance = new(big.Int).Add(balanceBigInt, big.NewInt(gasLimit*fee.GasPrice))
+	if hotWallet.Balance.Cmp(needBalance) < 0 {
+		app.JobErrorf(ctx, "hot balance limit")
 		return nil
 	}
+	privateKey := hotWallet.PrivateKey
+	hotWallet.Balance.Sub(hotWallet.Balance, needBalance)
+	hotWallet.PendingCount++
 	// nonce
 	nonce, err := GetNonce(
+		ctx,
 		dbTx,
 		hotAddress,
 	)
@@ -1220,25 +1772,23 @@ func handleWithdraw(withdrawID int64, chainID int64, hotAddress string, privateK
 	if err != nil {
 		return err
 	}
-	tx := types.NewTransaction(
-		uint64(nonce),
+	signedTx, rawTxHex, err := SignTx(
+		chainID,
+		nonce,
 		toAddress,
 		balanceBigInt,
-		uint64(gasLimit),
-		big.NewInt(gasPrice),
+		gasLimit,
+		fee,
 		data,
+		privateKey,
 	)
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(big.NewInt(chainID)), privateKey)
 	if err != nil {
 		return err
 	}
-	ts := types.Transactions{signedTx}
-	rawTxBytes := ts.GetRlp(0)
-	rawTxHex := hex.EncodeToString(rawTxBytes)
 	txHash := strings.ToLower(signedTx.Hash().Hex())
 	now := time.Now().Unix()
 	_, err = app.SQLUpdateTWithdrawGenTx(
-		context.Background(),
+		ctx,
 		dbTx,
 		&model.DBTWithdraw{
 			ID:           withdrawID,
@@ -1252,22 +1802,26 @@ func handleWithdraw(withdrawID int64, chainID int64, hotAddress string, privateK
 		return err
 	}
 	_, err = model.SQLCreateTSend(
-		context.Background(),
+		ctx,
 		dbTx,
 		&model.DBTSend{
-			RelatedType:  app.SendRelationTypeWithdraw,
-			RelatedID:    withdrawID,
-			TxID:         txHash,
-			FromAddress:  hotAddress,
-			ToAddress:    withdrawRow.ToAddress,
-			BalanceReal:  withdrawRow.BalanceReal,
-			Gas:          gasLimit,
-			GasPrice:     gasPrice,
-			Nonce:        nonce,
-			Hex:          rawTxHex,
-			HandleStatus: app.SendStatusInit,
-			HandleMsg:    "",
-			HandleTime:   now,
+			Chain:                chainName(ctx),
+			RelatedType:          app.SendRelationTypeWithdraw,
+			RelatedID:            withdrawID,
+			TxID:                 txHash,
+			FromAddress:          hotAddress,
+			ToAddress:            withdrawRow.ToAddress,
+			BalanceReal:          withdrawRow.BalanceReal,
+			Gas:                  gasLimit,
+			GasEstimate:          gasEstimate,
+			GasPrice:             fee.GasPrice,
+			MaxFeePerGas:         fee.MaxFeePerGas,
+			MaxPriorityFeePerGas: fee.MaxPriorityFeePerGas,
+			Nonce:                nonce,
+			Hex:                  rawTxHex,
+			HandleStatus:         app.SendStatusInit,
+			HandleMsg:            "",
+			HandleTime:           now,
 		},
 		false,
 	)
@@ -1284,23 +1838,37 @@ func handleWithdraw(withdrawID int64, chainID int64, hotAddress string, privateK
 }
 
 // CheckTxNotify 创建eth冲币通知
-func CheckTxNotify() {
-	lockKey := "EthCheckTxNotify"
-	app.LockWrap(lockKey, func() {
+func CheckTxNotify(ctx context.Context) {
+	lockKey := chainKey(ctx, "EthCheckTxNotify")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		txRows, err := app.SQLSelectTTxColByStatus(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTTxID,
 				model.DBColTTxProductID,
+				model.DBColTTxBlockNumber,
+				model.DBColTTxBlockHash,
 				model.DBColTTxTxID,
+				model.DBColTTxTraceAddress,
+				model.DBColTTxFromAddress,
 				model.DBColTTxToAddress,
 				model.DBColTTxBalanceReal,
 			},
 			app.TxStatusInit,
+			chainName(ctx),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(txRows) == 0 {
+			return
+		}
+		// 当前高度 用于计算确认数
+		rpcBlockNum, err := ethclient.RPCBlockNumber(ctx)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		var productIDs []int64
@@ -1310,18 +1878,19 @@ func CheckTxNotify() {
 			}
 		}
 		productMap, err := app.SQLGetProductMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTProductID,
 				model.DBColTProductAppName,
 				model.DBColTProductCbURL,
 				model.DBColTProductAppSk,
+				model.DBColTProductNotifyVersion,
 			},
 			productIDs,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 
@@ -1335,49 +1904,43 @@ func CheckTxNotify() {
 				notifyTxIDs = append(notifyTxIDs, txRow.ID)
 				continue
 			}
-			nonce := mcommon.GetUUIDStr()
-			reqObj := gin.H{
-				"tx_hash":     txRow.TxID,
-				"app_name":    productRow.AppName,
-				"address":     txRow.ToAddress,
-				"balance":     txRow.BalanceReal,
-				"symbol":      CoinSymbol,
-				"notify_type": app.NotifyTypeTx,
-			}
-			reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
-			req, err := json.Marshal(reqObj)
+			notifyRow, err := app.GetNotifyRow(
+				productRow,
+				&app.StNotifyData{
+					ProductID:     txRow.ProductID,
+					ItemType:      app.SendRelationTypeTx,
+					ItemID:        txRow.ID,
+					NotifyType:    app.NotifyTypeTx,
+					Symbol:        chainSymbol(ctx),
+					TxHash:        GetTxNotifyHash(txRow),
+					Address:       txRow.ToAddress,
+					FromAddress:   txRow.FromAddress,
+					Balance:       txRow.BalanceReal,
+					BlockNumber:   txRow.BlockNumber,
+					BlockHash:     txRow.BlockHash,
+					Confirmations: rpcBlockNum - txRow.BlockNumber + 1,
+				},
+				now,
+			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				continue
 			}
-			notifyRows = append(notifyRows, &model.DBTProductNotify{
-				Nonce:        nonce,
-				ProductID:    txRow.ProductID,
-				ItemType:     app.SendRelationTypeTx,
-				ItemID:       txRow.ID,
-				NotifyType:   app.NotifyTypeTx,
-				TokenSymbol:  CoinSymbol,
-				URL:          productRow.CbURL,
-				Msg:          string(req),
-				HandleStatus: app.NotifyStatusInit,
-				HandleMsg:    "",
-				CreateTime:   now,
-				UpdateTime:   now,
-			})
+			notifyRows = append(notifyRows, notifyRow)
 			notifyTxIDs = append(notifyTxIDs, txRow.ID)
 		}
 		_, err = model.SQLCreateManyTProductNotify(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			notifyRows,
 			true,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		_, err = app.SQLUpdateTTxStatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			notifyTxIDs,
 			model.DBTTx{
@@ -1387,44 +1950,58 @@ func CheckTxNotify() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
+		app.JobAddCount(ctx, int64(len(notifyTxIDs)))
 	})
 }
 
 // CheckErc20BlockSeek 检测erc20到账
-func CheckErc20BlockSeek() {
-	lockKey := "Erc20CheckBlockSeek"
-	app.LockWrap(lockKey, func() {
+func CheckErc20BlockSeek(ctx context.Context) {
+	lockKey := chainKey(ctx, "Erc20CheckBlockSeek")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取配置 延迟确认数
 		confirmValue, err := app.SQLGetTAppConfigIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
-			"block_confirm_num",
+			chainKey(ctx, "block_confirm_num"),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 获取状态 当前处理完成的最新的block number
 		seekValue, err := app.SQLGetTAppStatusIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
-			"erc20_seek_num",
+			chainKey(ctx, "erc20_seek_num"),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// rpc 获取当前最新区块数
-		rpcBlockNum, err := ethclient.RPCBlockNumber(context.Background())
+		rpcBlockNum, err := ethclient.RPCBlockNumber(ctx)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		// 只检测已记录区块hash的区块，以便发现回滚
+		ethSeekValue, err := app.SQLGetTAppStatusIntValueByK(
+			ctx,
+			xenv.DbCon,
+			chainKey(ctx, "eth_seek_num"),
+		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		startI := seekValue + 1
 		endI := rpcBlockNum - confirmValue + 1
+		if endI > ethSeekValue+1 {
+			endI = ethSeekValue + 1
+		}
 		if startI < endI {
 			// 读取abi
 			type LogTransfer struct {
@@ -1434,14 +2011,14 @@ func CheckErc20BlockSeek() {
 			}
 			contractAbi, err := abi.JSON(strings.NewReader(ethclient.EthABI))
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			// 获取所有token
 			var configTokenRowAddresses []string
 			configTokenRowMap := make(map[string]*model.DBTAppConfigToken)
 			configTokenRows, err := app.SQLSelectTAppConfigTokenColAll(
-				context.Background(),
+				ctx,
 				xenv.DbCon,
 				[]string{
 					model.DBColTAppConfigTokenID,
@@ -1449,9 +2026,10 @@ func CheckErc20BlockSeek() {
 					model.DBColTAppConfigTokenTokenDecimals,
 					model.DBColTAppConfigTokenTokenSymbol,
 				},
+				chainName(ctx),
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			for _, contractRow := range configTokenRows {
@@ -1461,10 +2039,17 @@ func CheckErc20BlockSeek() {
 			// 遍历获取需要查询的block信息
 			for i := startI; i < endI; i++ {
 				//mcommon.Log.Debugf("erc20 check block: %d", i)
+				savedHash, err := getSavedBlockHash(ctx, xenv.DbCon, i)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					return
+				}
+				// 待添加数组
+				var txErc20Rows []*model.DBTTxErc20
 				if len(configTokenRowAddresses) > 0 {
 					// rpc获取block信息
 					logs, err := ethclient.RPCFilterLogs(
-						context.Background(),
+						ctx,
 						i,
 						i,
 						configTokenRowAddresses,
@@ -1482,6 +2067,11 @@ func CheckErc20BlockSeek() {
 						if log.Removed {
 							continue
 						}
+						if savedHash != "" && log.BlockHash.Hex() != savedHash {
+							// 区块已变化，等待eth区块检测处理回滚
+							mcommon.Log.Warnf("erc20 block hash changed: %d %s %s", i, savedHash, log.BlockHash.Hex())
+							return
+						}
 						toAddress := AddressBytesToStr(common.HexToAddress(log.Topics[2].Hex()))
 						if !mcommon.IsStringInSlice(toAddresses, toAddress) {
 							toAddresses = append(toAddresses, toAddress)
@@ -1490,7 +2080,7 @@ func CheckErc20BlockSeek() {
 					}
 					// 从db中查询这些地址是否是冲币地址中的地址
 					dbAddressRows, err := app.SQLSelectTAddressKeyColByAddress(
-						context.Background(),
+						ctx,
 						xenv.DbCon,
 						[]string{
 							model.DBColTAddressKeyAddress,
@@ -1499,7 +2089,7 @@ func CheckErc20BlockSeek() {
 						toAddresses,
 					)
 					if err != nil {
-						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+						app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 						return
 					}
 					// map[接收地址] => 产品id
@@ -1509,8 +2099,6 @@ func CheckErc20BlockSeek() {
 					}
 					// 时间
 					now := time.Now().Unix()
-					// 待添加数组
-					var txErc20Rows []*model.DBTTxErc20
 					// 遍历数据库中有交易的地址
 					for _, dbAddressRow := range dbAddressRows {
 						if dbAddressRow.UseTag < 0 {
@@ -1519,7 +2107,7 @@ func CheckErc20BlockSeek() {
 						// 获取地址对应的交易列表
 						logs, ok := toAddressLogMap[dbAddressRow.Address]
 						if !ok {
-							mcommon.Log.Errorf("toAddressLogMap no: %s", dbAddressRow.Address)
+							app.JobErrorf(ctx, "toAddressLogMap no: %s", dbAddressRow.Address)
 							return
 						}
 						for _, log := range logs {
@@ -1534,26 +2122,26 @@ func CheckErc20BlockSeek() {
 							contractAddress := strings.ToLower(log.Address.Hex())
 							configTokenRow, ok := configTokenRowMap[contractAddress]
 							if !ok {
-								mcommon.Log.Errorf("no configTokenRowMap of: %s", contractAddress)
+								app.JobErrorf(ctx, "no configTokenRowMap of: %s", contractAddress)
 								return
 							}
 							rpcTxReceipt, err := ethclient.RPCTransactionReceipt(
-								context.Background(),
+								ctx,
 								log.TxHash.Hex(),
 							)
 							if err != nil {
-								mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+								app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 								return
 							}
 							if rpcTxReceipt.Status <= 0 {
 								continue
 							}
 							rpcTx, err := ethclient.RPCTransactionByHash(
-								context.Background(),
+								ctx,
 								log.TxHash.Hex(),
 							)
 							if err != nil {
-								mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+								app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 								return
 							}
 							if strings.ToLower(rpcTx.To().Hex()) != contractAddress {
@@ -1567,7 +2155,7 @@ func CheckErc20BlockSeek() {
 								transferEvent.Tokens,
 							)
 							if err != nil {
-								mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+								app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 								return
 							}
 							if hexutil.Encode(input) != hexutil.Encode(rpcTx.Data()) {
@@ -1576,13 +2164,16 @@ func CheckErc20BlockSeek() {
 							}
 							balanceReal, err := TokenWeiBigIntToEthStr(transferEvent.Tokens, configTokenRow.TokenDecimals)
 							if err != nil {
-								mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+								app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 								return
 							}
 							// 放入待插入数组
 							txErc20Rows = append(txErc20Rows, &model.DBTTxErc20{
+								Chain:        chainName(ctx),
 								TokenID:      configTokenRow.ID,
 								ProductID:    addressProductMap[transferEvent.To],
+								BlockNumber:  int64(log.BlockNumber),
+								BlockHash:    log.BlockHash.Hex(),
 								TxID:         log.TxHash.Hex(),
 								FromAddress:  transferEvent.From,
 								ToAddress:    transferEvent.To,
@@ -1597,54 +2188,64 @@ func CheckErc20BlockSeek() {
 							})
 						}
 					}
-					_, err = model.SQLCreateManyTTxErc20(
-						context.Background(),
-						xenv.DbCon,
+				}
+				// 写入充币并更新检查到的最新区块数
+				err = saveSeekBlock(ctx, "erc20_seek_num", i, savedHash, func(tx mcommon.DbExeAble) error {
+					_, err := model.SQLCreateManyTTxErc20(
+						ctx,
+						tx,
 						txErc20Rows,
 						true,
 					)
-					if err != nil {
-						mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-						return
-					}
+					return err
+				})
+				if errors.Is(err, errBlockHashChanged) {
+					// 区块已回滚，等待下次从回退后的进度检测
+					mcommon.Log.Warnf("erc20 %s", err.Error())
+					return
 				}
-				// 更新检查到的最新区块数
-				_, err = app.SQLUpdateTAppStatusIntByKGreater(
-					context.Background(),
-					xenv.DbCon,
-					&model.DBTAppStatusInt{
-						K: "erc20_seek_num",
-						V: i,
-					},
-				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
+				app.JobAddCount(ctx, int64(len(txErc20Rows)))
 			}
 		}
 	})
 }
 
 // CheckErc20TxNotify 创建erc20冲币通知
-func CheckErc20TxNotify() {
-	lockKey := "Erc20CheckTxNotify"
-	app.LockWrap(lockKey, func() {
+func CheckErc20TxNotify(ctx context.Context) {
+	lockKey := chainKey(ctx, "Erc20CheckTxNotify")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		txRows, err := app.SQLSelectTTxErc20ColByStatus(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTTxErc20ID,
 				model.DBColTTxErc20TokenID,
 				model.DBColTTxErc20ProductID,
+				model.DBColTTxErc20BlockNumber,
+				model.DBColTTxErc20BlockHash,
 				model.DBColTTxErc20TxID,
+				model.DBColTTxErc20FromAddress,
 				model.DBColTTxErc20ToAddress,
 				model.DBColTTxErc20BalanceReal,
 			},
 			app.TxStatusInit,
+			chainName(ctx),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(txRows) == 0 {
+			return
+		}
+		// 当前高度 用于计算确认数
+		rpcBlockNum, err := ethclient.RPCBlockNumber(ctx)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		var productIDs []int64
@@ -1658,31 +2259,33 @@ func CheckErc20TxNotify() {
 			}
 		}
 		productMap, err := app.SQLGetProductMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTProductID,
 				model.DBColTProductAppName,
 				model.DBColTProductCbURL,
 				model.DBColTProductAppSk,
+				model.DBColTProductNotifyVersion,
 			},
 			productIDs,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		tokenMap, err := app.SQLGetAppConfigTokenMap(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTAppConfigTokenID,
+				model.DBColTAppConfigTokenTokenAddress,
 				model.DBColTAppConfigTokenTokenSymbol,
 			},
 			tokenIDs,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 
@@ -1698,52 +2301,47 @@ func CheckErc20TxNotify() {
 			}
 			tokenRow, ok := tokenMap[txRow.TokenID]
 			if !ok {
-				mcommon.Log.Errorf("tokenMap no: %d", txRow.TokenID)
+				app.JobErrorf(ctx, "tokenMap no: %d", txRow.TokenID)
 				continue
 			}
-			nonce := mcommon.GetUUIDStr()
-			reqObj := gin.H{
-				"tx_hash":     txRow.TxID,
-				"app_name":    productRow.AppName,
-				"address":     txRow.ToAddress,
-				"balance":     txRow.BalanceReal,
-				"symbol":      tokenRow.TokenSymbol,
-				"notify_type": app.NotifyTypeTx,
-			}
-			reqObj["sign"] = mcommon.WechatGetSign(productRow.AppSk, reqObj)
-			req, err := json.Marshal(reqObj)
+			notifyRow, err := app.GetNotifyRow(
+				productRow,
+				&app.StNotifyData{
+					ProductID:     txRow.ProductID,
+					ItemType:      app.SendRelationTypeTx,
+					ItemID:        txRow.ID,
+					NotifyType:    app.NotifyTypeTx,
+					Symbol:        tokenRow.TokenSymbol,
+					TxHash:        txRow.TxID,
+					Address:       txRow.ToAddress,
+					FromAddress:   txRow.FromAddress,
+					Balance:       txRow.BalanceReal,
+					TokenAddress:  tokenRow.TokenAddress,
+					BlockNumber:   txRow.BlockNumber,
+					BlockHash:     txRow.BlockHash,
+					Confirmations: rpcBlockNum - txRow.BlockNumber + 1,
+				},
+				now,
+			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
-			notifyRows = append(notifyRows, &model.DBTProductNotify{
-				Nonce:        nonce,
-				ProductID:    txRow.ProductID,
-				ItemType:     app.SendRelationTypeTx,
-				ItemID:       txRow.ID,
-				NotifyType:   app.NotifyTypeTx,
-				TokenSymbol:  tokenRow.TokenSymbol,
-				URL:          productRow.CbURL,
-				Msg:          string(req),
-				HandleStatus: app.NotifyStatusInit,
-				HandleMsg:    "",
-				CreateTime:   now,
-				UpdateTime:   now,
-			})
+			notifyRows = append(notifyRows, notifyRow)
 			notifyTxIDs = append(notifyTxIDs, txRow.ID)
 		}
 		_, err = model.SQLCreateManyTProductNotify(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			notifyRows,
 			true,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		_, err = app.SQLUpdateTTxErc20StatusByIDs(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			notifyTxIDs,
 			model.DBTTxErc20{
@@ -1753,40 +2351,46 @@ func CheckErc20TxNotify() {
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
+		app.JobAddCount(ctx, int64(len(notifyTxIDs)))
 	})
 }
 
 // CheckErc20TxOrg erc20零钱整理
-func CheckErc20TxOrg() {
-	lockKey := "Erc20CheckTxOrg"
-	app.LockWrap(lockKey, func() {
+func CheckErc20TxOrg(ctx context.Context) {
+	lockKey := chainKey(ctx, "Erc20CheckTxOrg")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 计算转账token所需的手续费
 		erc20GasUseValue, err := app.SQLGetTAppConfigIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
-			"erc20_gas_use",
+			chainKey(ctx, "erc20_gas_use"),
 		)
 		if err != nil {
 			mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
 			return
 		}
 		gasPriceValue, err := app.SQLGetTAppStatusIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
-			"to_cold_gas_price_eth",
+			chainKey(ctx, "to_cold_gas_price_eth"),
 		)
 		if err != nil {
 			mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
 			return
 		}
-		erc20Fee := big.NewInt(erc20GasUseValue * gasPriceValue)
+		fee, err := GetTxFee(ctx, gasPriceValue)
+		if err != nil {
+			mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
+			return
+		}
+		erc20Fee := big.NewInt(erc20GasUseValue * fee.GasPrice)
 		ethGasUse := int64(21000)
-		ethFee := big.NewInt(ethGasUse * gasPriceValue)
+		ethFee := big.NewInt(ethGasUse * fee.GasPrice)
 		// chainID
-		chainID, err := ethclient.RPCNetworkID(context.Background())
+		chainID, err := ethclient.RPCNetworkID(ctx)
 		if err != nil {
 			mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
 			return
@@ -1794,7 +2398,7 @@ func CheckErc20TxOrg() {
 
 		// 开始事物
 		isComment := false
-		dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+		dbTx, err := xenv.DbCon.BeginTxx(ctx, nil)
 		if err != nil {
 			mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
 			return
@@ -1806,7 +2410,7 @@ func CheckErc20TxOrg() {
 		}()
 		// 查询需要处理的交易
 		txRows, err := app.SQLSelectTTxErc20ColByOrgForUpdate(
-			context.Background(),
+			ctx,
 			dbTx,
 			[]string{
 				model.DBColTTxErc20ID,
@@ -1816,9 +2420,10 @@ func CheckErc20TxOrg() {
 				model.DBColTTxErc20BalanceReal,
 			},
 			[]int64{app.TxOrgStatusInit, app.TxOrgStatusFeeConfirm},
+			chainName(ctx),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		if len(txRows) <= 0 {
@@ -1839,7 +2444,7 @@ func CheckErc20TxOrg() {
 			}
 		}
 		tokenMap, err := app.SQLGetAppConfigTokenMap(
-			context.Background(),
+			ctx,
 			dbTx,
 			[]string{
 				model.DBColTAppConfigTokenID,
@@ -1848,11 +2453,12 @@ func CheckErc20TxOrg() {
 				model.DBColTAppConfigTokenTokenSymbol,
 				model.DBColTAppConfigTokenColdAddress,
 				model.DBColTAppConfigTokenOrgMinBalance,
+				model.DBColTAppConfigTokenOrgRealBalance,
 			},
 			tokenIDs,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 
@@ -1866,20 +2472,26 @@ func CheckErc20TxOrg() {
 		for _, txRow := range txRows {
 			tokenRow, ok := tokenMap[txRow.TokenID]
 			if !ok {
-				mcommon.Log.Errorf("no token of: %d", txRow.TokenID)
+				app.JobErrorf(ctx, "no token of: %d", txRow.TokenID)
 				return
 			}
+			// 冷钱包地址未配置的token不整理
+			err = checkColdAddress(tokenRow.ColdAddress)
+			if err != nil {
+				app.JobErrorf(ctx, "token %s organize cold address err: [%T] %s", tokenRow.TokenSymbol, err, err.Error())
+				continue
+			}
 			// 转换为map
 			txMap[txRow.ID] = txRow
 			// 读取eth余额
 			_, ok = addressEthBalanceMap[txRow.ToAddress]
 			if !ok {
 				balance, err := ethclient.RPCBalanceAt(
-					context.Background(),
+					ctx,
 					txRow.ToAddress,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				addressEthBalanceMap[txRow.ToAddress] = balance
@@ -1898,7 +2510,7 @@ func CheckErc20TxOrg() {
 			orgInfo.TxIDs = append(orgInfo.TxIDs, txRow.ID)
 			txBalance, err := TokenEthStrToWeiBigInit(txRow.BalanceReal, tokenRow.TokenDecimals)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			orgInfo.TokenBalance.Add(orgInfo.TokenBalance, txBalance)
@@ -1907,18 +2519,117 @@ func CheckErc20TxOrg() {
 				toAddresses = append(toAddresses, txRow.ToAddress)
 			}
 		}
+		// forwarder地址通过工厂合约按token合并整理，不需要手续费
+		forwarderMap, err := getForwarderMap(ctx, dbTx, toAddresses)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		// 以链上余额为准的token，按实际余额整理并记录差额
+		now := time.Now().Unix()
+		orgDiffMap := make(map[string]*stOrgBalanceDiff)
+		for orgKey, orgInfo := range orgMap {
+			tokenRow := tokenMap[orgInfo.TokenID]
+			if tokenRow.OrgRealBalance == 0 {
+				continue
+			}
+			_, isForwarder := forwarderMap[orgInfo.ToAddress]
+			realBalance, err := getOrgRealBalance(ctx, dbTx, tokenRow, orgInfo.ToAddress, isForwarder)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				delete(orgMap, orgKey)
+				continue
+			}
+			if realBalance == nil {
+				delete(orgMap, orgKey)
+				continue
+			}
+			diff := &stOrgBalanceDiff{
+				CreditedBalance: orgInfo.TokenBalance,
+				RealBalance:     realBalance,
+			}
+			if realBalance.Sign() <= 0 {
+				// 没有余额，记录差额后不再整理
+				err = createOrgBalanceDiff(ctx, dbTx, tokenRow, orgInfo.ToAddress, "", diff)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					return
+				}
+				_, err = app.SQLUpdateTTxErc20OrgStatusByIDs(
+					ctx,
+					dbTx,
+					orgInfo.TxIDs,
+					model.DBTTxErc20{
+						OrgStatus: app.TxOrgStatusConfirm,
+						OrgMsg:    "no balance",
+						OrgTime:   now,
+					},
+				)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					return
+				}
+				delete(orgMap, orgKey)
+				continue
+			}
+			orgDiffMap[orgKey] = diff
+			orgInfo.TokenBalance = realBalance
+		}
+		tokenForwarderOrgsMap := make(map[int64][]*stForwarderOrg)
+		for _, txRow := range txRows {
+			if _, ok := forwarderMap[txRow.ToAddress]; !ok {
+				continue
+			}
+			orgKey := fmt.Sprintf("%s-%d", txRow.ToAddress, txRow.TokenID)
+			orgInfo, ok := orgMap[orgKey]
+			if !ok {
+				continue
+			}
+			delete(orgMap, orgKey)
+			tokenRow := tokenMap[orgInfo.TokenID]
+			orgMinBalance, err := TokenEthStrToWeiBigInit(tokenRow.OrgMinBalance, tokenRow.TokenDecimals)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				continue
+			}
+			if orgInfo.TokenBalance.Cmp(orgMinBalance) < 0 {
+				continue
+			}
+			tokenForwarderOrgsMap[orgInfo.TokenID] = append(tokenForwarderOrgsMap[orgInfo.TokenID], &stForwarderOrg{
+				Address:     orgInfo.ToAddress,
+				RowIDs:      orgInfo.TxIDs,
+				BalanceDiff: orgDiffMap[orgKey],
+			})
+		}
+		for _, tokenID := range tokenIDs {
+			forwarderOrgs, ok := tokenForwarderOrgsMap[tokenID]
+			if !ok {
+				continue
+			}
+			tokenRow := tokenMap[tokenID]
+			err = orgForwarders(ctx, dbTx, chainID, fee, forwarderMap, tokenRow, tokenRow.ColdAddress, forwarderOrgs)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
+		}
+		var keyAddresses []string
+		for _, toAddress := range toAddresses {
+			if _, ok := forwarderMap[toAddress]; !ok {
+				keyAddresses = append(keyAddresses, toAddress)
+			}
+		}
 		// 整理地址key
 		addressPKMap, err := GetPKMapOfAddresses(
-			context.Background(),
+			ctx,
 			dbTx,
-			toAddresses,
+			keyAddresses,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		// 需要手续费的整理信息
-		now := time.Now().Unix()
 		needEthFeeMap := make(map[string]*StOrgInfo)
 		for k, orgInfo := range orgMap {
 			toAddress := orgInfo.ToAddress
@@ -1932,7 +2643,7 @@ func CheckErc20TxOrg() {
 			}
 			tokenRow, ok := tokenMap[orgInfo.TokenID]
 			if !ok {
-				mcommon.Log.Errorf("no tokenMap: %d", orgInfo.TokenID)
+				app.JobErrorf(ctx, "no tokenMap: %d", orgInfo.TokenID)
 				continue
 			}
 
@@ -1942,25 +2653,25 @@ func CheckErc20TxOrg() {
 				continue
 			}
 			if orgInfo.TokenBalance.Cmp(orgMinBalance) < 0 {
-				mcommon.Log.Errorf("token balance < org min balance")
+				app.JobErrorf(ctx, "token balance < org min balance")
 				continue
 			}
 			// 处理token转账
 			privateKey, ok := addressPKMap[toAddress]
 			if !ok {
-				mcommon.Log.Errorf("addressMap no: %s", toAddress)
+				app.JobErrorf(ctx, "addressMap no: %s", toAddress)
 				continue
 			}
 			// 获取nonce值
-			nonce, err := GetNonce(dbTx, toAddress)
+			nonce, err := GetNonce(ctx, dbTx, toAddress)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				continue
 			}
 			// 生成交易
 			contractAbi, err := abi.JSON(strings.NewReader(ethclient.EthABI))
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			input, err := contractAbi.Pack(
@@ -1969,25 +2680,23 @@ func CheckErc20TxOrg() {
 				orgInfo.TokenBalance,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
-			rpcTx := types.NewTransaction(
-				uint64(nonce),
+			signedTx, rawTxHex, err := SignTx(
+				chainID,
+				nonce,
 				common.HexToAddress(tokenRow.TokenAddress),
 				big.NewInt(0),
-				uint64(erc20GasUseValue),
-				big.NewInt(gasPriceValue),
+				erc20GasUseValue,
+				fee,
 				input,
+				privateKey,
 			)
-			signedTx, err := types.SignTx(rpcTx, types.NewEIP155Signer(big.NewInt(chainID)), privateKey)
 			if err != nil {
 				mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
 				continue
 			}
-			ts := types.Transactions{signedTx}
-			rawTxBytes := ts.GetRlp(0)
-			rawTxHex := hex.EncodeToString(rawTxBytes)
 			txHash := strings.ToLower(signedTx.Hash().Hex())
 			// 创建存入数据
 			balanceReal, err := TokenWeiBigIntToEthStr(orgInfo.TokenBalance, tokenRow.TokenDecimals)
@@ -2000,24 +2709,28 @@ func CheckErc20TxOrg() {
 			for rowIndex, txID := range orgInfo.TxIDs {
 				if rowIndex == 0 {
 					sendRows = append(sendRows, &model.DBTSend{
-						RelatedType:  app.SendRelationTypeTxErc20,
-						RelatedID:    txID,
-						TokenID:      orgInfo.TokenID,
-						TxID:         txHash,
-						FromAddress:  toAddress,
-						ToAddress:    tokenRow.ColdAddress,
-						BalanceReal:  balanceReal,
-						Gas:          erc20GasUseValue,
-						GasPrice:     gasPriceValue,
-						Nonce:        nonce,
-						Hex:          rawTxHex,
-						CreateTime:   now,
-						HandleStatus: app.SendStatusInit,
-						HandleMsg:    "",
-						HandleTime:   now,
+						Chain:                chainName(ctx),
+						RelatedType:          app.SendRelationTypeTxErc20,
+						RelatedID:            txID,
+						TokenID:              orgInfo.TokenID,
+						TxID:                 txHash,
+						FromAddress:          toAddress,
+						ToAddress:            tokenRow.ColdAddress,
+						BalanceReal:          balanceReal,
+						Gas:                  erc20GasUseValue,
+						GasPrice:             fee.GasPrice,
+						MaxFeePerGas:         fee.MaxFeePerGas,
+						MaxPriorityFeePerGas: fee.MaxPriorityFeePerGas,
+						Nonce:                nonce,
+						Hex:                  rawTxHex,
+						CreateTime:           now,
+						HandleStatus:         app.SendStatusInit,
+						HandleMsg:            "",
+						HandleTime:           now,
 					})
 				} else {
 					sendRows = append(sendRows, &model.DBTSend{
+						Chain:        chainName(ctx),
 						RelatedType:  app.SendRelationTypeTxErc20,
 						RelatedID:    txID,
 						TokenID:      orgInfo.TokenID,
@@ -2038,18 +2751,23 @@ func CheckErc20TxOrg() {
 			}
 			// 插入发送队列
 			_, err = model.SQLCreateManyTSend(
-				context.Background(),
+				ctx,
 				dbTx,
 				sendRows,
 				true,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
+			err = createOrgBalanceDiff(ctx, dbTx, tokenRow, toAddress, txHash, orgDiffMap[k])
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			// 更新整理状态
 			_, err = app.SQLUpdateTTxErc20OrgStatusByIDs(
-				context.Background(),
+				ctx,
 				dbTx,
 				orgInfo.TxIDs,
 				model.DBTTxErc20{
@@ -2059,57 +2777,59 @@ func CheckErc20TxOrg() {
 				},
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
+			app.JobAddCount(ctx, int64(len(orgInfo.TxIDs)))
 		}
 		// 生成eth转账
 		if len(needEthFeeMap) > 0 {
 			// 获取热钱包地址
 			feeAddressValue, err := app.SQLGetTAppConfigStrValueByK(
-				context.Background(),
+				ctx,
 				dbTx,
-				"fee_wallet_address_erc20",
+				chainKey(ctx, "fee_wallet_address_erc20"),
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			_, err = StrToAddressBytes(feeAddressValue)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			// 获取私钥
 			privateKey, err := GetPkOfAddress(
-				context.Background(),
+				ctx,
 				dbTx,
 				feeAddressValue,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			feeAddressBalance, err := ethclient.RPCBalanceAt(
-				context.Background(),
+				ctx,
 				feeAddressValue,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("RPCBalanceAt err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "RPCBalanceAt err: [%T] %s", err, err.Error())
 				return
 			}
 			pendingBalanceReal, err := app.SQLGetTSendPendingBalanceReal(
-				context.Background(),
+				ctx,
 				dbTx,
 				feeAddressValue,
+				chainName(ctx),
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			pendingBalance, err := EthStrToWeiBigInit(pendingBalanceReal)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 			feeAddressBalance.Sub(feeAddressBalance, pendingBalance)
@@ -2118,41 +2838,40 @@ func CheckErc20TxOrg() {
 				feeAddressBalance.Sub(feeAddressBalance, ethFee)
 				feeAddressBalance.Sub(feeAddressBalance, erc20Fee)
 				if feeAddressBalance.Cmp(new(big.Int)) < 0 {
-					mcommon.Log.Errorf("eth fee balance limit")
+					app.JobErrorf(ctx, "eth fee balance limit")
 					return
 				}
 				// nonce
 				nonce, err := GetNonce(
+					ctx,
 					dbTx,
 					feeAddressValue,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				// 创建交易
 				var data []byte
-				tx := types.NewTransaction(
-					uint64(nonce),
+				signedTx, rawTxHex, err := SignTx(
+					chainID,
+					nonce,
 					common.HexToAddress(orgInfo.ToAddress),
 					erc20Fee,
-					uint64(ethGasUse),
-					big.NewInt(gasPriceValue),
+					ethGasUse,
+					fee,
 					data,
+					privateKey,
 				)
-				signedTx, err := types.SignTx(tx, types.NewEIP155Signer(big.NewInt(chainID)), privateKey)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
-				ts := types.Transactions{signedTx}
-				rawTxBytes := ts.GetRlp(0)
-				rawTxHex := hex.EncodeToString(rawTxBytes)
 				txHash := strings.ToLower(signedTx.Hash().Hex())
 				now := time.Now().Unix()
 				balanceReal, err := WeiBigIntToEthStr(erc20Fee)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				// 待插入数据
@@ -2160,24 +2879,28 @@ func CheckErc20TxOrg() {
 				for rowIndex, txID := range orgInfo.TxIDs {
 					if rowIndex == 0 {
 						sendRows = append(sendRows, &model.DBTSend{
-							RelatedType:  app.SendRelationTypeTxErc20Fee,
-							RelatedID:    txID,
-							TokenID:      0,
-							TxID:         txHash,
-							FromAddress:  feeAddressValue,
-							ToAddress:    orgInfo.ToAddress,
-							BalanceReal:  balanceReal,
-							Gas:          ethGasUse,
-							GasPrice:     gasPriceValue,
-							Nonce:        nonce,
-							Hex:          rawTxHex,
-							CreateTime:   now,
-							HandleStatus: app.SendStatusInit,
-							HandleMsg:    "",
-							HandleTime:   now,
+							Chain:                chainName(ctx),
+							RelatedType:          app.SendRelationTypeTxErc20Fee,
+							RelatedID:            txID,
+							TokenID:              0,
+							TxID:                 txHash,
+							FromAddress:          feeAddressValue,
+							ToAddress:            orgInfo.ToAddress,
+							BalanceReal:          balanceReal,
+							Gas:                  ethGasUse,
+							GasPrice:             fee.GasPrice,
+							MaxFeePerGas:         fee.MaxFeePerGas,
+							MaxPriorityFeePerGas: fee.MaxPriorityFeePerGas,
+							Nonce:                nonce,
+							Hex:                  rawTxHex,
+							CreateTime:           now,
+							HandleStatus:         app.SendStatusInit,
+							HandleMsg:            "",
+							HandleTime:           now,
 						})
 					} else {
 						sendRows = append(sendRows, &model.DBTSend{
+							Chain:        chainName(ctx),
 							RelatedType:  app.SendRelationTypeTxErc20Fee,
 							RelatedID:    txID,
 							TokenID:      0,
@@ -2198,18 +2921,18 @@ func CheckErc20TxOrg() {
 				}
 				// 插入发送数据
 				_, err = model.SQLCreateManyTSend(
-					context.Background(),
+					ctx,
 					dbTx,
 					sendRows,
 					true,
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 				// 更新整理状态
 				_, err = app.SQLUpdateTTxErc20OrgStatusByIDs(
-					context.Background(),
+					ctx,
 					dbTx,
 					orgInfo.TxIDs,
 					model.DBTTxErc20{
@@ -2219,7 +2942,7 @@ func CheckErc20TxOrg() {
 					},
 				)
 				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 					return
 				}
 			}
@@ -2227,7 +2950,7 @@ func CheckErc20TxOrg() {
 
 		err = dbTx.Commit()
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		isComment = true
@@ -2235,16 +2958,16 @@ func CheckErc20TxOrg() {
 }
 
 // CheckErc20Withdraw erc20提币
-func CheckErc20Withdraw() {
-	lockKey := "Erc20CheckWithdraw"
-	app.LockWrap(lockKey, func() {
+func CheckErc20Withdraw(ctx context.Context) {
+	lockKey := chainKey(ctx, "Erc20CheckWithdraw")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		var tokenSymbols []string
 		tokenMap := make(map[string]*model.DBTAppConfigToken)
-		addressKeyMap := make(map[string]*ecdsa.PrivateKey)
-		addressEthBalanceMap := make(map[string]*big.Int)
+		hotWalletMap := make(map[string]*StHotWallet)
+		tokenHotWalletMap := make(map[string][]*StHotWallet)
 		addressTokenBalanceMap := make(map[string]*big.Int)
 		tokenRows, err := app.SQLSelectTAppConfigTokenColAll(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTAppConfigTokenID,
@@ -2253,9 +2976,10 @@ func CheckErc20Withdraw() {
 				model.DBColTAppConfigTokenTokenSymbol,
 				model.DBColTAppConfigTokenHotAddress,
 			},
+			chainName(ctx),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		for _, tokenRow := range tokenRows {
@@ -2265,7 +2989,7 @@ func CheckErc20Withdraw() {
 			}
 		}
 		withdrawRows, err := app.SQLSelectTWithdrawColByStatus(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			[]string{
 				model.DBColTWithdrawID,
@@ -2275,141 +2999,112 @@ func CheckErc20Withdraw() {
 			tokenSymbols,
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		if len(withdrawRows) == 0 {
 			return
 		}
 		for _, tokenRow := range tokenRows {
-			// 获取私钥
-			_, err = StrToAddressBytes(tokenRow.HotAddress)
+			// 热钱包地址，多个以逗号分隔
+			hotAddresses, err := ParseHotAddresses(tokenRow.HotAddress)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
-			hotAddress := tokenRow.HotAddress
-			_, ok := addressKeyMap[hotAddress]
-			if !ok {
-				// 获取私钥
-				keyRow, err := app.SQLGetTAddressKeyColByAddress(
-					context.Background(),
-					xenv.DbCon,
-					[]string{
-						model.DBColTAddressKeyPwd,
-					},
-					hotAddress,
-				)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-					return
-				}
-				if keyRow == nil {
-					mcommon.Log.Errorf("no key of: %s", hotAddress)
-					return
-				}
-				key, err := mcommon.AesDecrypt(keyRow.Pwd, xenv.Cfg.AESKey)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-					return
-				}
-				if len(key) == 0 {
-					mcommon.Log.Errorf("error key of: %s", hotAddress)
-					return
-				}
-				if strings.HasPrefix(key, "0x") {
-					key = key[2:]
-				}
-				privateKey, err := crypto.HexToECDSA(key)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-					return
-				}
-				addressKeyMap[hotAddress] = privateKey
-			}
-			_, ok = addressEthBalanceMap[hotAddress]
-			if !ok {
-				hotAddressBalance, err := ethclient.RPCBalanceAt(
-					context.Background(),
-					hotAddress,
-				)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-					return
-				}
-				pendingBalanceReal, err := app.SQLGetTSendPendingBalanceReal(
-					context.Background(),
-					xenv.DbCon,
-					hotAddress,
-				)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-					return
-				}
-				pendingBalance, err := EthStrToWeiBigInit(pendingBalanceReal)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-					return
+			for _, hotAddress := range hotAddresses {
+				// 获取私钥和eth可用余额，同一地址在多个token间共用
+				hotWallet, ok := hotWalletMap[hotAddress]
+				if !ok {
+					hotWallet, err = getHotWallet(ctx, hotAddress)
+					if err != nil {
+						app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+						return
+					}
+					hotWalletMap[hotAddress] = hotWallet
 				}
-				hotAddressBalance.Sub(hotAddressBalance, pendingBalance)
-				addressEthBalanceMap[hotAddress] = hotAddressBalance
-			}
-			tokenBalanceKey := fmt.Sprintf("%s-%s", tokenRow.HotAddress, tokenRow.TokenSymbol)
-			_, ok = addressTokenBalanceMap[tokenBalanceKey]
-			if !ok {
-				tokenBalance, err := ethclient.RPCTokenBalance(
-					context.Background(),
-					tokenRow.TokenAddress,
-					tokenRow.HotAddress,
-				)
-				if err != nil {
-					mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
-					return
+				tokenHotWalletMap[tokenRow.TokenSymbol] = append(tokenHotWalletMap[tokenRow.TokenSymbol], hotWallet)
+				tokenBalanceKey := fmt.Sprintf("%s-%s", hotAddress, tokenRow.TokenSymbol)
+				_, ok = addressTokenBalanceMap[tokenBalanceKey]
+				if !ok {
+					tokenBalance, err := ethclient.RPCTokenBalance(
+						ctx,
+						tokenRow.TokenAddress,
+						hotAddress,
+					)
+					if err != nil {
+						app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+						return
+					}
+					addressTokenBalanceMap[tokenBalanceKey] = tokenBalance
 				}
-				addressTokenBalanceMap[tokenBalanceKey] = tokenBalance
 			}
 		}
 		// 获取gap price
 		gasPriceValue, err := app.SQLGetTAppStatusIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
-			"to_user_gas_price_eth",
+			chainKey(ctx, "to_user_gas_price_eth"),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		fee, err := GetTxFee(ctx, gasPriceValue)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
-		gasPrice := gasPriceValue
 		erc20GasUseValue, err := app.SQLGetTAppConfigIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
-			"erc20_gas_use",
+			chainKey(ctx, "erc20_gas_use"),
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		gasLimit := erc20GasUseValue
-		// eth fee
-		feeValue := big.NewInt(gasLimit * gasPrice)
-		chainID, err := ethclient.RPCNetworkID(context.Background())
+		chainID, err := ethclient.RPCNetworkID(ctx)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
+		// 配置了批量转账合约时按币种合并发送，未能批量的提币逐笔发送
+		var remainRows []*model.DBTWithdraw
+		for _, tokenRow := range tokenRows {
+			var symbolRows []*model.DBTWithdraw
+			for _, withdrawRow := range withdrawRows {
+				if withdrawRow.Symbol == tokenRow.TokenSymbol {
+					symbolRows = append(symbolRows, withdrawRow)
+				}
+			}
+			if len(symbolRows) == 0 {
+				continue
+			}
+			symbolRows, err = batchWithdraw(ctx, chainID, symbolRows, tokenHotWalletMap[tokenRow.TokenSymbol], tokenRow, addressTokenBalanceMap, gasLimit, fee)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
+			remainRows = append(remainRows, symbolRows...)
+		}
+		withdrawRows = remainRows
 		for _, withdrawRow := range withdrawRows {
-			err = handleErc20Withdraw(withdrawRow.ID, chainID, &tokenMap, &addressKeyMap, &addressEthBalanceMap, &addressTokenBalanceMap, gasLimit, gasPrice, feeValue)
+			err = handleErc20Withdraw(ctx, withdrawRow.ID, chainID, &tokenMap, &tokenHotWalletMap, &addressTokenBalanceMap, gasLimit, fee)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				continue
 			}
+			app.JobAddCount(ctx, 1)
 		}
 	})
 }
 
-func handleErc20Withdraw(withdrawID int64, chainID int64, tokenMap *map[string]*model.DBTAppConfigToken, addressKeyMap *map[string]*ecdsa.PrivateKey, addressEthBalanceMap *map[string]*big.Int, addressTokenBalanceMap *map[string]*big.Int, gasLimit, gasPrice int64, feeValue *big.Int) error {
+// handleErc20Withdraw 处理erc20提币，按估算的gas发送，估算失败时使用 defaultGas
+func handleErc20Withdraw(ctx context.Context, withdrawID int64, chainID int64, tokenMap *map[string]*model.DBTAppConfigToken, tokenHotWalletMap *map[string][]*StHotWallet, addressTokenBalanceMap *map[string]*big.Int, defaultGas int64, fee *StTxFee) error {
 	isComment := false
-	dbTx, err := xenv.DbCon.BeginTxx(context.Background(), nil)
+	dbTx, err := xenv.DbCon.BeginTxx(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -2420,13 +3115,19 @@ func handleErc20Withdraw(withdrawID int64, chainID int64, tokenMap *map[string]*
 	}()
 	// 处理业务
 	withdrawRow, err := app.SQLGetTWithdrawColForUpdate(
-		context.Background(),
+		ctx,
 		dbTx,
 		[]string{
 			model.DBColTWithdrawID,
 			model.DBColTWithdrawBalanceReal,
 			model.DBColTWithdrawToAddress,
+			model.DBColTWithdrawProductID,
+			model.DBColTWithdrawOutSerial,
 			model.DBColTWithdrawSymbol,
+			model.DBColTWithdrawTokenID,
+			model.DBColTWithdrawFee,
+			model.DBColTWithdrawBlockNumber,
+			model.DBColTWithdrawBlockHash,
 		},
 		withdrawID,
 		app.WithdrawStatusInit,
@@ -2439,45 +3140,32 @@ func handleErc20Withdraw(withdrawID int64, chainID int64, tokenMap *map[string]*
 	}
 	tokenRow, ok := (*tokenMap)[withdrawRow.Symbol]
 	if !ok {
-		mcommon.Log.Errorf("no tokenMap: %s", withdrawRow.Symbol)
-		return nil
-	}
-	hotAddress := tokenRow.HotAddress
-	key, ok := (*addressKeyMap)[hotAddress]
-	if !ok {
-		mcommon.Log.Errorf("no addressKeyMap: %s", hotAddress)
-		return nil
-	}
-	(*addressEthBalanceMap)[hotAddress] = (*addressEthBalanceMap)[hotAddress].Sub(
-		(*addressEthBalanceMap)[hotAddress],
-		feeValue,
-	)
-	if (*addressEthBalanceMap)[hotAddress].Cmp(new(big.Int)) < 0 {
-		mcommon.Log.Errorf("%s eth limit", hotAddress)
+		app.JobErrorf(ctx, "no tokenMap: %s", withdrawRow.Symbol)
 		return nil
 	}
-	tokenBalanceKey := fmt.Sprintf("%s-%s", tokenRow.HotAddress, tokenRow.TokenSymbol)
 	tokenBalance, err := TokenEthStrToWeiBigInit(withdrawRow.BalanceReal, tokenRow.TokenDecimals)
 	if err != nil {
 		return err
 	}
-	(*addressTokenBalanceMap)[tokenBalanceKey] = (*addressTokenBalanceMap)[tokenBalanceKey].Sub(
-		(*addressTokenBalanceMap)[tokenBalanceKey],
-		tokenBalance,
-	)
-	if (*addressTokenBalanceMap)[tokenBalanceKey].Cmp(new(big.Int)) < 0 {
-		mcommon.Log.Errorf("%s token limit", tokenBalanceKey)
+	// 选择eth手续费和token余额足够且排队交易最少的热钱包
+	feeValue := big.NewInt(defaultGas * fee.GasPrice)
+	hotWallet := selectHotWallet((*tokenHotWalletMap)[tokenRow.TokenSymbol], func(hotWallet *StHotWallet) bool {
+		if hotWallet.Balance.Cmp(feeValue) < 0 {
+			return false
+		}
+		tokenBalanceKey := fmt.Sprintf("%s-%s", hotWallet.Address, tokenRow.TokenSymbol)
+		return (*addressTokenBalanceMap)[tokenBalanceKey].Cmp(tokenBalance) >= 0
+	})
+	if hotWallet == nil {
+		app.JobErrorf(ctx, "%s hot balance limit", tokenRow.TokenSymbol)
 		return nil
 	}
-	// 获取nonce值
-	nonce, err := GetNonce(dbTx, hotAddress)
-	if err != nil {
-		return err
-	}
+	hotAddress := hotWallet.Address
+	key := hotWallet.PrivateKey
 	// 生成交易
 	contractAbi, err := abi.JSON(strings.NewReader(ethclient.EthABI))
 	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 		return err
 	}
 	input, err := contractAbi.Pack(
@@ -2486,28 +3174,58 @@ func handleErc20Withdraw(withdrawID int64, chainID int64, tokenMap *map[string]*
 		tokenBalance,
 	)
 	if err != nil {
-		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+		return err
+	}
+	// 估算gas
+	gasLimit, gasEstimate, err := estimateGasLimit(ctx, hotAddress, tokenRow.TokenAddress, big.NewInt(0), input, defaultGas)
+	if errors.Is(err, ErrGasEstimateOverMax) {
+		app.JobErrorf(ctx, "withdraw %d failed: %s", withdrawRow.ID, err.Error())
+		err = withdrawGasOverMax(ctx, dbTx, withdrawRow, err.Error())
+		if err != nil {
+			return err
+		}
+		err = dbTx.Commit()
+		if err != nil {
+			return err
+		}
+		isComment = true
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	feeValue = big.NewInt(gasLimit * fee.GasPrice)
+	if hotWallet.Balance.Cmp(feeValue) < 0 {
+		app.JobErrorf(ctx, "%s hot balance limit", tokenRow.TokenSymbol)
+		return nil
+	}
+	tokenBalanceKey := fmt.Sprintf("%s-%s", hotAddress, tokenRow.TokenSymbol)
+	hotWallet.Balance.Sub(hotWallet.Balance, feeValue)
+	hotWallet.PendingCount++
+	(*addressTokenBalanceMap)[tokenBalanceKey].Sub((*addressTokenBalanceMap)[tokenBalanceKey], tokenBalance)
+	// 获取nonce值
+	nonce, err := GetNonce(ctx, dbTx, hotAddress)
+	if err != nil {
 		return err
 	}
-	rpcTx := types.NewTransaction(
-		uint64(nonce),
+	signedTx, rawTxHex, err := SignTx(
+		chainID,
+		nonce,
 		common.HexToAddress(tokenRow.TokenAddress),
 		big.NewInt(0),
-		uint64(gasLimit),
-		big.NewInt(gasPrice),
+		gasLimit,
+		fee,
 		input,
+		key,
 	)
-	signedTx, err := types.SignTx(rpcTx, types.NewEIP155Signer(big.NewInt(chainID)), key)
 	if err != nil {
 		return err
 	}
-	ts := types.Transactions{signedTx}
-	rawTxBytes := ts.GetRlp(0)
-	rawTxHex := hex.EncodeToString(rawTxBytes)
 	txHash := strings.ToLower(signedTx.Hash().Hex())
 	now := time.Now().Unix()
 	_, err = app.SQLUpdateTWithdrawGenTx(
-		context.Background(),
+		ctx,
 		dbTx,
 		&model.DBTWithdraw{
 			ID:           withdrawID,
@@ -2521,22 +3239,26 @@ func handleErc20Withdraw(withdrawID int64, chainID int64, tokenMap *map[string]*
 		return err
 	}
 	_, err = model.SQLCreateTSend(
-		context.Background(),
+		ctx,
 		dbTx,
 		&model.DBTSend{
-			RelatedType:  app.SendRelationTypeWithdraw,
-			RelatedID:    withdrawID,
-			TxID:         txHash,
-			FromAddress:  hotAddress,
-			ToAddress:    withdrawRow.ToAddress,
-			BalanceReal:  withdrawRow.BalanceReal,
-			Gas:          gasLimit,
-			GasPrice:     gasPrice,
-			Nonce:        nonce,
-			Hex:          rawTxHex,
-			HandleStatus: app.SendStatusInit,
-			HandleMsg:    "",
-			HandleTime:   now,
+			Chain:                chainName(ctx),
+			RelatedType:          app.SendRelationTypeWithdraw,
+			RelatedID:            withdrawID,
+			TxID:                 txHash,
+			FromAddress:          hotAddress,
+			ToAddress:            withdrawRow.ToAddress,
+			BalanceReal:          withdrawRow.BalanceReal,
+			Gas:                  gasLimit,
+			GasEstimate:          gasEstimate,
+			GasPrice:             fee.GasPrice,
+			MaxFeePerGas:         fee.MaxFeePerGas,
+			MaxPriorityFeePerGas: fee.MaxPriorityFeePerGas,
+			Nonce:                nonce,
+			Hex:                  rawTxHex,
+			HandleStatus:         app.SendStatusInit,
+			HandleMsg:            "",
+			HandleTime:           now,
 		},
 		false,
 	)
@@ -2553,18 +3275,18 @@ func handleErc20Withdraw(withdrawID int64, chainID int64, tokenMap *map[string]*
 }
 
 // CheckGasPrice 检测gas price
-func CheckGasPrice() {
-	lockKey := "EthCheckGasPrice"
-	app.LockWrap(lockKey, func() {
+func CheckGasPrice(ctx context.Context) {
+	lockKey := chainKey(ctx, "EthCheckGasPrice")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
 		// 获取最高单价
 		maxValue, err := app.SQLGetTAppStatusIntValueByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
-			"max_gas_price_eth",
+			chainKey(ctx, "max_gas_price_eth"),
 		)
 		if err != nil {
 			if !strings.Contains(err.Error(), "no app status int of") {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 		}
@@ -2572,54 +3294,26 @@ func CheckGasPrice() {
 			maxValue = 80000000000
 			// 创建
 			_, err := model.SQLCreateTAppStatusInt(
-				context.Background(),
+				ctx,
 				xenv.DbCon,
 				&model.DBTAppStatusInt{
-					K: "max_gas_price_eth",
+					K: chainKey(ctx, "max_gas_price_eth"),
 					V: maxValue,
 				},
 				true,
 			)
 			if err != nil {
-				mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 				return
 			}
 		}
-		type StRespGasPrice struct {
-			Fast        int64   `json:"fast"`
-			Fastest     int64   `json:"fastest"`
-			SafeLow     int64   `json:"safeLow"`
-			Average     int64   `json:"average"`
-			BlockTime   float64 `json:"block_time"`
-			BlockNum    int64   `json:"blockNum"`
-			Speed       float64 `json:"speed"`
-			SafeLowWait float64 `json:"safeLowWait"`
-			AvgWait     float64 `json:"avgWait"`
-			FastWait    float64 `json:"fastWait"`
-			FastestWait float64 `json:"fastestWait"`
-		}
-		gresp, body, errs := gorequest.New().
-			Proxy(xenv.Cfg.Proxy).
-			Get("https://ethgasstation.info/api/ethgasAPI.json").
-			Timeout(time.Second * 120).
-			End()
-		if errs != nil {
-			mcommon.Log.Errorf("err: [%T] %s", errs[0], errs[0].Error())
-			return
-		}
-		if gresp.StatusCode != http.StatusOK {
-			// 状态错误
-			mcommon.Log.Errorf("req status error: %d", gresp.StatusCode)
-			return
-		}
-		var resp StRespGasPrice
-		err = json.Unmarshal([]byte(body), &resp)
+		gasPrice, err := GetGasPrice(ctx)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
-		toUserGasPrice := resp.Fast * int64(math.Pow10(8))
-		toColdGasPrice := resp.Average * int64(math.Pow10(8))
+		toUserGasPrice := gasPrice.ToUser
+		toColdGasPrice := gasPrice.ToCold
 		if toUserGasPrice > maxValue {
 			toUserGasPrice = maxValue
 		}
@@ -2627,27 +3321,27 @@ func CheckGasPrice() {
 			toColdGasPrice = maxValue
 		}
 		_, err = app.SQLUpdateTAppStatusIntByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			&model.DBTAppStatusInt{
-				K: "to_user_gas_price_eth",
+				K: chainKey(ctx, "to_user_gas_price_eth"),
 				V: toUserGasPrice,
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 		_, err = app.SQLUpdateTAppStatusIntByK(
-			context.Background(),
+			ctx,
 			xenv.DbCon,
 			&model.DBTAppStatusInt{
-				K: "to_cold_gas_price_eth",
+				K: chainKey(ctx, "to_cold_gas_price_eth"),
 				V: toColdGasPrice,
 			},
 		)
 		if err != nil {
-			mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
 			return
 		}
 	})
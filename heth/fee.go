@@ -0,0 +1,138 @@
+package heth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/xenv"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// StTxFee 交易手续费参数
+type StTxFee struct {
+	// GasPrice 传统交易的 gasPrice，动态手续费交易为 maxFeePerGas，用于计算最大手续费
+	GasPrice int64
+	// MaxFeePerGas 动态手续费交易的 maxFeePerGas，传统交易为0
+	MaxFeePerGas int64
+	// MaxPriorityFeePerGas 动态手续费交易的 maxPriorityFeePerGas，传统交易为0
+	MaxPriorityFeePerGas int64
+}
+
+// getTxType 获取交易类型配置，0 传统交易 2 动态手续费交易
+func getTxType(ctx context.Context) (int64, error) {
+	return getConfigInt(ctx, "eth_tx_type", types.LegacyTxType)
+}
+
+// GetTxFee 获取交易手续费参数，gasPrice 为传统交易使用的单价，
+// 动态手续费模式下 maxFeePerGas = 2 * baseFee + maxPriorityFeePerGas，
+// 链未启用 London 时仍使用传统交易
+func GetTxFee(ctx context.Context, gasPrice int64) (*StTxFee, error) {
+	legacyFee := &StTxFee{
+		GasPrice: gasPrice,
+	}
+	txType, err := getTxType(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if txType != types.DynamicFeeTxType {
+		return legacyFee, nil
+	}
+	rpcHeader, err := ethclient.RPCHeaderLatest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rpcHeader.BaseFee == nil {
+		return legacyFee, nil
+	}
+	tipCap, err := ethclient.RPCSuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	feeCap := new(big.Int).Mul(rpcHeader.BaseFee, big.NewInt(2))
+	feeCap.Add(feeCap, tipCap)
+	// 不超过最高单价
+	maxValue, err := app.SQLGetTAppStatusIntValueByK(
+		ctx,
+		xenv.DbCon,
+		chainKey(ctx, "max_gas_price_eth"),
+	)
+	if err != nil {
+		if !strings.Contains(err.Error(), "no app status int of") {
+			return nil, err
+		}
+	}
+	if maxValue > 0 && feeCap.Cmp(big.NewInt(maxValue)) > 0 {
+		feeCap.SetInt64(maxValue)
+	}
+	if tipCap.Cmp(feeCap) > 0 {
+		tipCap.Set(feeCap)
+	}
+	return &StTxFee{
+		GasPrice:             feeCap.Int64(),
+		MaxFeePerGas:         feeCap.Int64(),
+		MaxPriorityFeePerGas: tipCap.Int64(),
+	}, nil
+}
+
+// SignTx 生成并签名交易，返回签名后的交易和 raw hex
+func SignTx(chainID int64, nonce int64, to common.Address, value *big.Int, gasLimit int64, fee *StTxFee, data []byte, privateKey *ecdsa.PrivateKey) (*types.Transaction, string, error) {
+	var tx *types.Transaction
+	var signer types.Signer
+	if fee.MaxFeePerGas > 0 {
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:   big.NewInt(chainID),
+			Nonce:     uint64(nonce),
+			GasTipCap: big.NewInt(fee.MaxPriorityFeePerGas),
+			GasFeeCap: big.NewInt(fee.MaxFeePerGas),
+			Gas:       uint64(gasLimit),
+			To:        &to,
+			Value:     value,
+			Data:      data,
+		})
+		signer = types.NewLondonSigner(big.NewInt(chainID))
+	} else {
+		tx = types.NewTransaction(
+			uint64(nonce),
+			to,
+			value,
+			uint64(gasLimit),
+			big.NewInt(fee.GasPrice),
+			data,
+		)
+		signer = types.NewEIP155Signer(big.NewInt(chainID))
+	}
+	signedTx, err := types.SignTx(tx, signer, privateKey)
+	if err != nil {
+		return nil, "", err
+	}
+	rawTxBytes, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, "", err
+	}
+	return signedTx, hex.EncodeToString(rawTxBytes), nil
+}
+
+// GetEffectiveGasPrice 获取交易实际的gas单价，动态手续费交易为 baseFee + 实际小费
+func GetEffectiveGasPrice(ctx context.Context, rpcTx *types.Transaction, rpcReceipt *types.Receipt) (*big.Int, error) {
+	if rpcTx.Type() != types.DynamicFeeTxType {
+		return rpcTx.GasPrice(), nil
+	}
+	rpcHeader, err := ethclient.RPCHeaderByNum(ctx, rpcReceipt.BlockNumber.Int64())
+	if err != nil {
+		return nil, err
+	}
+	if rpcHeader.BaseFee == nil {
+		return rpcTx.GasFeeCap(), nil
+	}
+	tip, err := rpcTx.EffectiveGasTip(rpcHeader.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+	return tip.Add(tip, rpcHeader.BaseFee), nil
+}
@@ -0,0 +1,315 @@
+package heth
+
+import (
+	"context"
+	"crypto/rand"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/moremorefun/mcommon"
+)
+
+// forwarder 整理默认配置
+const (
+	// ForwarderFlushMaxDefault 单笔整理交易最多包含的forwarder数
+	ForwarderFlushMaxDefault = 50
+	// ForwarderFlushGasDefault 每个forwarder预留的gas，包含部署和转账
+	ForwarderFlushGasDefault = 100000
+)
+
+// getForwarderFactory 获取forwarder工厂合约地址，为空时使用私钥生成冲币地址
+func getForwarderFactory(ctx context.Context) (string, error) {
+	return getConfigStr(ctx, "forwarder_factory_eth")
+}
+
+// createForwarderAddresses 通过工厂合约生成forwarder冲币地址
+func createForwarderAddresses(ctx context.Context, factoryAddress string, num int64) (int64, error) {
+	_, err := StrToAddressBytes(factoryAddress)
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now().Unix()
+	var keyRows []*model.DBTAddressKey
+	var forwarderRows []*model.DBTEthForwarder
+	for i := int64(0); i < num; i++ {
+		var salt common.Hash
+		_, err := rand.Read(salt[:])
+		if err != nil {
+			return 0, err
+		}
+		address, err := ethclient.RPCForwarderAddress(ctx, factoryAddress, salt)
+		if err != nil {
+			return 0, err
+		}
+		// forwarder地址没有私钥
+		keyRows = append(keyRows, &model.DBTAddressKey{
+			Symbol:  chainSymbol(ctx),
+			Address: address,
+			Pwd:     "",
+			UseTag:  0,
+		})
+		forwarderRows = append(forwarderRows, &model.DBTEthForwarder{
+			Address:        address,
+			Salt:           salt.Hex(),
+			FactoryAddress: strings.ToLower(factoryAddress),
+			CreateTime:     now,
+		})
+	}
+	err = mcommon.DbTransaction(ctx, xenv.DbCon, func(tx mcommon.DbExeAble) error {
+		_, err := model.SQLCreateManyTEthForwarder(
+			ctx,
+			tx,
+			forwarderRows,
+			true,
+		)
+		if err != nil {
+			return err
+		}
+		_, err = model.SQLCreateManyTAddressKey(
+			ctx,
+			tx,
+			keyRows,
+			true,
+		)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(keyRows)), nil
+}
+
+// getForwarderMap 获取地址中的forwarder地址
+func getForwarderMap(ctx context.Context, tx mcommon.DbExeAble, addresses []string) (map[string]*model.DBTEthForwarder, error) {
+	return app.SQLGetEthForwarderMap(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTEthForwarderAddress,
+			model.DBColTEthForwarderSalt,
+			model.DBColTEthForwarderFactoryAddress,
+		},
+		addresses,
+	)
+}
+
+// stForwarderOrg 待整理的forwarder地址
+type stForwarderOrg struct {
+	Address string
+	// RowIDs t_tx.id 或 t_tx_erc20.id
+	RowIDs []int64
+	// BalanceDiff 以链上余额为准的token的入账差额
+	BalanceDiff *stOrgBalanceDiff
+}
+
+// orgForwarders 通过工厂合约一次整理多个forwarder地址，手续费由 forwarder_owner_eth 支付，
+// tokenRow 为空时整理eth，需要在整理的事务中调用
+func orgForwarders(ctx context.Context, dbTx mcommon.DbExeAble, chainID int64, fee *StTxFee, forwarderMap map[string]*model.DBTEthForwarder, tokenRow *model.DBTAppConfigToken, toAddress string, orgs []*stForwarderOrg) error {
+	if len(orgs) == 0 {
+		return nil
+	}
+	err := checkColdAddress(toAddress)
+	if err != nil {
+		return err
+	}
+	ownerAddress, err := getConfigStr(ctx, "forwarder_owner_eth")
+	if err != nil {
+		return err
+	}
+	if ownerAddress == "" {
+		app.JobErrorf(ctx, "no app config str of: forwarder_owner_eth")
+		return nil
+	}
+	ownerWallet, err := getHotWallet(ctx, strings.ToLower(ownerAddress))
+	if err != nil {
+		return err
+	}
+	flushMax, err := getConfigInt(ctx, "forwarder_flush_max", ForwarderFlushMaxDefault)
+	if err != nil {
+		return err
+	}
+	if flushMax < 1 {
+		flushMax = 1
+	}
+	flushGas, err := getConfigInt(ctx, "forwarder_flush_gas", ForwarderFlushGasDefault)
+	if err != nil {
+		return err
+	}
+	contractAbi, err := abi.JSON(strings.NewReader(ethclient.ForwarderFactoryABI))
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	// 同一工厂合约的forwarder才能合并整理
+	factoryOrgsMap := make(map[string][]*stForwarderOrg)
+	var factoryAddresses []string
+	for _, org := range orgs {
+		factoryAddress := forwarderMap[org.Address].FactoryAddress
+		if !mcommon.IsStringInSlice(factoryAddresses, factoryAddress) {
+			factoryAddresses = append(factoryAddresses, factoryAddress)
+		}
+		factoryOrgsMap[factoryAddress] = append(factoryOrgsMap[factoryAddress], org)
+	}
+	for _, factoryAddress := range factoryAddresses {
+		factoryOrgs := factoryOrgsMap[factoryAddress]
+		for start := 0; start < len(factoryOrgs); start += int(flushMax) {
+			end := start + int(flushMax)
+			if end > len(factoryOrgs) {
+				end = len(factoryOrgs)
+			}
+			var salts []common.Hash
+			var rowIDs []int64
+			chunkOrgs := factoryOrgs[start:end]
+			for _, org := range chunkOrgs {
+				salts = append(salts, common.HexToHash(forwarderMap[org.Address].Salt))
+				rowIDs = append(rowIDs, org.RowIDs...)
+			}
+			// 多预留一项的gas用于合约调用本身
+			gasLimit := flushGas * int64(len(salts)+1)
+			feeValue := new(big.Int).Mul(big.NewInt(gasLimit), big.NewInt(fee.GasPrice))
+			if ownerWallet.Balance.Cmp(feeValue) < 0 {
+				app.JobErrorf(ctx, "forwarder owner %s eth limit", ownerWallet.Address)
+				return nil
+			}
+			var input []byte
+			relatedType := int64(app.SendRelationTypeTx)
+			tokenID := int64(0)
+			if tokenRow == nil {
+				input, err = contractAbi.Pack("flushEther", common.HexToAddress(toAddress), salts)
+			} else {
+				relatedType = app.SendRelationTypeTxErc20
+				tokenID = tokenRow.ID
+				input, err = contractAbi.Pack("flushTokens", common.HexToAddress(tokenRow.TokenAddress), common.HexToAddress(toAddress), salts)
+			}
+			if err != nil {
+				return err
+			}
+			// 模拟执行，失败时本次不整理
+			_, err = ethclient.RPCCallContract(ctx, ownerWallet.Address, factoryAddress, nil, input)
+			if err != nil {
+				mcommon.Log.Warnf("forwarder flush call err: [%T] %s", err, err.Error())
+				continue
+			}
+			nonce, err := GetNonce(ctx, dbTx, ownerWallet.Address)
+			if err != nil {
+				return err
+			}
+			signedTx, rawTxHex, err := SignTx(
+				chainID,
+				nonce,
+				common.HexToAddress(factoryAddress),
+				big.NewInt(0),
+				gasLimit,
+				fee,
+				input,
+				ownerWallet.PrivateKey,
+			)
+			if err != nil {
+				return err
+			}
+			txHash := strings.ToLower(signedTx.Hash().Hex())
+			// 整理的数额不从owner转出，不占用owner的余额
+			var sendRows []*model.DBTSend
+			for rowIndex, rowID := range rowIDs {
+				if rowIndex == 0 {
+					sendRows = append(sendRows, &model.DBTSend{
+						Chain:                chainName(ctx),
+						RelatedType:          relatedType,
+						RelatedID:            rowID,
+						TokenID:              tokenID,
+						TxID:                 txHash,
+						FromAddress:          ownerWallet.Address,
+						ToAddress:            toAddress,
+						BalanceReal:          "0",
+						Gas:                  gasLimit,
+						GasPrice:             fee.GasPrice,
+						MaxFeePerGas:         fee.MaxFeePerGas,
+						MaxPriorityFeePerGas: fee.MaxPriorityFeePerGas,
+						Nonce:                nonce,
+						Hex:                  rawTxHex,
+						CreateTime:           now,
+						HandleStatus:         app.SendStatusInit,
+						HandleMsg:            "",
+						HandleTime:           now,
+					})
+				} else {
+					// 占位数据
+					sendRows = append(sendRows, &model.DBTSend{
+						Chain:        chainName(ctx),
+						RelatedType:  relatedType,
+						RelatedID:    rowID,
+						TokenID:      tokenID,
+						TxID:         txHash,
+						FromAddress:  ownerWallet.Address,
+						ToAddress:    toAddress,
+						BalanceReal:  "0",
+						Gas:          0,
+						GasPrice:     0,
+						Nonce:        -1,
+						Hex:          "",
+						CreateTime:   now,
+						HandleStatus: app.SendStatusInit,
+						HandleMsg:    "",
+						HandleTime:   now,
+					})
+				}
+			}
+			_, err = model.SQLCreateManyTSend(
+				ctx,
+				dbTx,
+				sendRows,
+				true,
+			)
+			if err != nil {
+				return err
+			}
+			if tokenRow != nil {
+				for _, org := range chunkOrgs {
+					err = createOrgBalanceDiff(ctx, dbTx, tokenRow, org.Address, txHash, org.BalanceDiff)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			if tokenRow == nil {
+				_, err = app.SQLUpdateTTxOrgStatusByIDs(
+					ctx,
+					dbTx,
+					rowIDs,
+					model.DBTTx{
+						OrgStatus: app.TxOrgStatusHex,
+						OrgMsg:    "forwarder hex",
+						OrgTime:   now,
+					},
+				)
+			} else {
+				_, err = app.SQLUpdateTTxErc20OrgStatusByIDs(
+					ctx,
+					dbTx,
+					rowIDs,
+					model.DBTTxErc20{
+						OrgStatus: app.TxOrgStatusHex,
+						OrgMsg:    "forwarder hex",
+						OrgTime:   now,
+					},
+				)
+			}
+			if err != nil {
+				return err
+			}
+			ownerWallet.Balance.Sub(ownerWallet.Balance, feeValue)
+			app.JobAddCount(ctx, int64(len(rowIDs)))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package heth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/model"
+	"math/big"
+
+	"github.com/moremorefun/mcommon"
+)
+
+// 提币gas估算默认配置
+const (
+	// GasEstimatePercentDefault 估算值的安全系数，百分比
+	GasEstimatePercentDefault = 120
+	// GasEstimateMaxDefault 估算后的gas limit上限
+	GasEstimateMaxDefault = 500000
+	// gasTransfer 普通eth转账的固定gas
+	gasTransfer = 21000
+)
+
+// ErrGasEstimateOverMax 估算值超过 gas_estimate_max，交易无法以上限内的gas执行
+var ErrGasEstimateOverMax = errors.New("gas estimate over max")
+
+// estimateGasLimit 估算交易的gas limit，返回 gas limit 和估算值
+// 估算值乘以安全系数且不超过上限，估算失败时使用 defaultGas，估算值为 0，
+// 估算值超过上限时返回 ErrGasEstimateOverMax
+func estimateGasLimit(ctx context.Context, from string, to string, value *big.Int, data []byte, defaultGas int64) (int64, int64, error) {
+	percentValue, err := getConfigInt(ctx, "gas_estimate_percent", GasEstimatePercentDefault)
+	if err != nil {
+		return 0, 0, err
+	}
+	if percentValue < 100 {
+		percentValue = 100
+	}
+	maxValue, err := getConfigInt(ctx, "gas_estimate_max", GasEstimateMaxDefault)
+	if err != nil {
+		return 0, 0, err
+	}
+	estimateValue, err := ethclient.RPCEstimateGas(ctx, from, to, value, data)
+	if err != nil {
+		mcommon.Log.Warnf("estimate gas %s -> %s err: [%T] %s, use %d", from, to, err, err.Error(), defaultGas)
+		return defaultGas, 0, nil
+	}
+	if estimateValue > maxValue {
+		return 0, 0, fmt.Errorf("%w: %s -> %s %d > %d", ErrGasEstimateOverMax, from, to, estimateValue, maxValue)
+	}
+	// 普通转账gas固定，不需要安全系数
+	if estimateValue == gasTransfer {
+		return estimateValue, estimateValue, nil
+	}
+	gasLimit := estimateValue * percentValue / 100
+	if gasLimit > maxValue {
+		gasLimit = maxValue
+	}
+	return gasLimit, estimateValue, nil
+}
+
+// withdrawGasOverMax 估算值超过上限的提币重试也无法发送，标记为失败并通知商户
+func withdrawGasOverMax(ctx context.Context, dbTx mcommon.DbExeAble, withdrawRow *model.DBTWithdraw, reason string) error {
+	productMap, err := app.SQLGetProductMap(
+		ctx,
+		dbTx,
+		[]string{
+			model.DBColTProductID,
+			model.DBColTProductAppName,
+			model.DBColTProductCbURL,
+			model.DBColTProductAppSk,
+			model.DBColTProductNotifyVersion,
+		},
+		[]int64{withdrawRow.ProductID},
+	)
+	if err != nil {
+		return err
+	}
+	productRow, ok := productMap[withdrawRow.ProductID]
+	if !ok {
+		app.JobErrorf(ctx, "no productMap: %d", withdrawRow.ProductID)
+	}
+	return app.WithdrawFailed(
+		ctx,
+		dbTx,
+		withdrawRow,
+		productRow,
+		"",
+		reason,
+	)
+}
@@ -0,0 +1,51 @@
+package heth
+
+import (
+	"database/sql/driver"
+	"go-dc-wallet/app"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// reasonArg 失败原因包含估算值和上限
+type reasonArg struct{}
+
+func (reasonArg) Match(v driver.Value) bool {
+	reason, ok := v.(string)
+	return ok && strings.HasPrefix(reason, ErrGasEstimateOverMax.Error()) && strings.HasSuffix(reason, "21000 > 20000")
+}
+
+func TestWithdrawGasEstimateOverMax(t *testing.T) {
+	env := newBatchTestEnv(t, "gasovermax")
+	toAddress := env.newRecipient()
+	hotBalance := env.hot.Balance.String()
+	env.mock.ExpectBegin()
+	env.mock.ExpectQuery("FROM t_withdraw WHERE id=\\? AND handle_status=\\? FOR UPDATE").
+		WithArgs(int64(5), app.WithdrawStatusInit).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "out_serial", "balance_real", "to_address", "symbol"}).AddRow(5, 1, "s5", "1", toAddress, CoinSymbol))
+	env.mock.ExpectQuery("FROM t_app_config_int").
+		WillReturnRows(sqlmock.NewRows([]string{"v"}))
+	env.mock.ExpectQuery("FROM t_app_config_int").
+		WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(20000))
+	env.mock.ExpectQuery("FROM t_product WHERE id IN").
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "app_name", "cb_url", "app_sk", "notify_version"}).AddRow(1, "app", "http://127.0.0.1/notify", "sk", 0))
+	// 提币失败且不重试
+	env.mock.ExpectExec("UPDATE t_withdraw SET handle_status").
+		WithArgs(app.WithdrawStatusFailed, reasonArg{}, sqlmock.AnyArg(), int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// 通知商户
+	env.mock.ExpectExec("INTO t_product_notify").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	env.mock.ExpectCommit()
+	err := handleWithdraw(env.ctx, 5, testChainID, []*StHotWallet{env.hot}, gasTransfer, env.fee)
+	if err != nil {
+		t.Fatalf("withdraw err: %s", err.Error())
+	}
+	env.checkMock()
+	if env.hot.Balance.String() != hotBalance || env.hot.PendingCount != 0 {
+		t.Fatalf("hot balance: %s, pending: %d", env.hot.Balance.String(), env.hot.PendingCount)
+	}
+}
@@ -0,0 +1,312 @@
+package heth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/xenv"
+	"math"
+	"math/big"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/moremorefun/mcommon"
+	"github.com/parnurzeal/gorequest"
+	"github.com/shopspring/decimal"
+)
+
+// StGasPrice gas单价，单位 wei
+type StGasPrice struct {
+	// ToUser 提币使用的单价
+	ToUser int64
+	// ToCold 零钱整理使用的单价
+	ToCold int64
+}
+
+// GasPriceSource gas单价来源
+type GasPriceSource func(ctx context.Context) (*StGasPrice, error)
+
+// gasPriceSources 可选的gas单价来源
+var gasPriceSources = map[string]GasPriceSource{
+	"local":         localGasPrice,
+	"ethgasstation": ethGasStationGasPrice,
+	"etherscan":     etherscanGasPrice,
+}
+
+// RegisterGasPriceSource 注册gas单价来源
+func RegisterGasPriceSource(name string, source GasPriceSource) {
+	gasPriceSources[name] = source
+}
+
+// gas单价预言机默认配置
+const (
+	GasOracleBlocksDefault         = 20
+	GasOracleUserPercentileDefault = 60
+	GasOracleColdPercentileDefault = 30
+)
+
+// percentileOf 计算分位数，values 需要已排序
+func percentileOf(values []*big.Int, percentile int64) *big.Int {
+	if len(values) == 0 {
+		return new(big.Int)
+	}
+	i := int(percentile) * (len(values) - 1) / 100
+	if i < 0 {
+		i = 0
+	}
+	if i >= len(values) {
+		i = len(values) - 1
+	}
+	return new(big.Int).Set(values[i])
+}
+
+// sortBigInts 从小到大排序
+func sortBigInts(values []*big.Int) {
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Cmp(values[j]) < 0
+	})
+}
+
+// medianInt64 计算中位数
+func medianInt64(values []int64) int64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]int64{}, values...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// localGasPrice 根据最近区块计算gas单价，
+// 优先使用 eth_feeHistory 的小费分位数加下一区块 baseFee，
+// 节点不支持时根据区块中交易的实际单价计算分位数
+func localGasPrice(ctx context.Context) (*StGasPrice, error) {
+	blockCount, err := getConfigInt(ctx, "gas_oracle_blocks", GasOracleBlocksDefault)
+	if err != nil {
+		return nil, err
+	}
+	userPercentile, err := getConfigInt(ctx, "gas_oracle_user_percentile", GasOracleUserPercentileDefault)
+	if err != nil {
+		return nil, err
+	}
+	coldPercentile, err := getConfigInt(ctx, "gas_oracle_cold_percentile", GasOracleColdPercentileDefault)
+	if err != nil {
+		return nil, err
+	}
+	// 分位数需要从小到大
+	userIndex, coldIndex := 1, 0
+	percentiles := []float64{float64(coldPercentile), float64(userPercentile)}
+	if coldPercentile > userPercentile {
+		userIndex, coldIndex = 0, 1
+		percentiles = []float64{float64(userPercentile), float64(coldPercentile)}
+	}
+	feeHistory, err := ethclient.RPCFeeHistory(
+		ctx,
+		blockCount,
+		percentiles,
+	)
+	if err == nil && len(feeHistory.BaseFee) > 0 {
+		var userTips []*big.Int
+		var coldTips []*big.Int
+		for i, rewards := range feeHistory.Reward {
+			if len(rewards) != 2 || i >= len(feeHistory.GasUsedRatio) || feeHistory.GasUsedRatio[i] == 0 {
+				// 空区块没有小费数据
+				continue
+			}
+			userTips = append(userTips, rewards[userIndex].ToInt())
+			coldTips = append(coldTips, rewards[coldIndex].ToInt())
+		}
+		if len(userTips) > 0 {
+			sortBigInts(userTips)
+			sortBigInts(coldTips)
+			nextBaseFee := feeHistory.BaseFee[len(feeHistory.BaseFee)-1].ToInt()
+			return &StGasPrice{
+				ToUser: new(big.Int).Add(nextBaseFee, percentileOf(userTips, 50)).Int64(),
+				ToCold: new(big.Int).Add(nextBaseFee, percentileOf(coldTips, 50)).Int64(),
+			}, nil
+		}
+	}
+	// 根据区块中交易的实际单价计算
+	rpcBlockNum, err := ethclient.RPCBlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	concurrency, err := app.GetBlockFetchConcurrency(ctx)
+	if err != nil {
+		return nil, err
+	}
+	start := rpcBlockNum - blockCount + 1
+	if start < 0 {
+		start = 0
+	}
+	fetcher := app.NewBlockFetcher(
+		ctx,
+		start,
+		rpcBlockNum+1,
+		concurrency,
+		func(ctx context.Context, num int64) (interface{}, error) {
+			return ethclient.RPCBlockByNum(ctx, num)
+		},
+	)
+	defer fetcher.Close()
+	var prices []*big.Int
+	for i := start; i <= rpcBlockNum; i++ {
+		fetchBlock, err := fetcher.Get(i)
+		if err != nil {
+			return nil, err
+		}
+		rpcBlock := fetchBlock.(*types.Block)
+		baseFee := rpcBlock.BaseFee()
+		for _, rpcTx := range rpcBlock.Transactions() {
+			if baseFee == nil {
+				prices = append(prices, rpcTx.GasPrice())
+				continue
+			}
+			tip, err := rpcTx.EffectiveGasTip(baseFee)
+			if err != nil {
+				continue
+			}
+			prices = append(prices, tip.Add(tip, baseFee))
+		}
+	}
+	if len(prices) == 0 {
+		return nil, fmt.Errorf("no tx in blocks: %d-%d", start, rpcBlockNum)
+	}
+	sortBigInts(prices)
+	return &StGasPrice{
+		ToUser: percentileOf(prices, userPercentile).Int64(),
+		ToCold: percentileOf(prices, coldPercentile).Int64(),
+	}, nil
+}
+
+// ethGasStationGasPrice ethgasstation.info 的gas单价
+func ethGasStationGasPrice(ctx context.Context) (*StGasPrice, error) {
+	type StRespGasPrice struct {
+		Fast    int64 `json:"fast"`
+		Average int64 `json:"average"`
+	}
+	gresp, body, errs := gorequest.New().
+		Proxy(xenv.Cfg.Proxy).
+		Get("https://ethgasstation.info/api/ethgasAPI.json").
+		Timeout(time.Second * 120).
+		End()
+	if errs != nil {
+		return nil, errs[0]
+	}
+	if gresp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("req status error: %d", gresp.StatusCode)
+	}
+	var resp StRespGasPrice
+	err := json.Unmarshal([]byte(body), &resp)
+	if err != nil {
+		return nil, err
+	}
+	// 返回值单位为 0.1 gwei
+	return &StGasPrice{
+		ToUser: resp.Fast * int64(math.Pow10(8)),
+		ToCold: resp.Average * int64(math.Pow10(8)),
+	}, nil
+}
+
+// etherscanGasPrice etherscan 的gas单价，需要配置 gas_price_etherscan_key
+func etherscanGasPrice(ctx context.Context) (*StGasPrice, error) {
+	apiKey, err := getConfigStr(ctx, "gas_price_etherscan_key")
+	if err != nil {
+		return nil, err
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("no app config str of: gas_price_etherscan_key")
+	}
+	type StRespGasPrice struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+		Result  struct {
+			ProposeGasPrice string `json:"ProposeGasPrice"`
+			FastGasPrice    string `json:"FastGasPrice"`
+		} `json:"result"`
+	}
+	gresp, body, errs := gorequest.New().
+		Proxy(xenv.Cfg.Proxy).
+		Get("https://api.etherscan.io/api").
+		Query(fmt.Sprintf("module=gastracker&action=gasoracle&apikey=%s", apiKey)).
+		Timeout(time.Second * 120).
+		End()
+	if errs != nil {
+		return nil, errs[0]
+	}
+	if gresp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("req status error: %d", gresp.StatusCode)
+	}
+	var resp StRespGasPrice
+	err = json.Unmarshal([]byte(body), &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status != "1" {
+		return nil, fmt.Errorf("etherscan error: %s", resp.Message)
+	}
+	// 返回值单位为 gwei
+	fast, err := decimal.NewFromString(resp.Result.FastGasPrice)
+	if err != nil {
+		return nil, err
+	}
+	propose, err := decimal.NewFromString(resp.Result.ProposeGasPrice)
+	if err != nil {
+		return nil, err
+	}
+	return &StGasPrice{
+		ToUser: fast.Shift(9).IntPart(),
+		ToCold: propose.Shift(9).IntPart(),
+	}, nil
+}
+
+// GetGasPrice 从配置的来源获取gas单价，多个来源取中位数，
+// 来源通过 t_app_config_str gas_price_sources 配置，多个以逗号分隔，默认 local
+func GetGasPrice(ctx context.Context) (*StGasPrice, error) {
+	sourcesValue, err := getConfigStr(ctx, "gas_price_sources")
+	if err != nil {
+		return nil, err
+	}
+	if sourcesValue == "" {
+		sourcesValue = "local"
+	}
+	var toUserPrices []int64
+	var toColdPrices []int64
+	for _, name := range strings.Split(sourcesValue, ",") {
+		name = strings.TrimSpace(name)
+		source, ok := gasPriceSources[name]
+		if !ok {
+			mcommon.Log.Warnf("unknown gas price source: %s", name)
+			continue
+		}
+		gasPrice, err := source(ctx)
+		if err != nil {
+			mcommon.Log.Warnf("gas price source %s err: [%T] %s", name, err, err.Error())
+			continue
+		}
+		if gasPrice.ToUser <= 0 || gasPrice.ToCold <= 0 {
+			mcommon.Log.Warnf("gas price source %s error value: %d %d", name, gasPrice.ToUser, gasPrice.ToCold)
+			continue
+		}
+		toUserPrices = append(toUserPrices, gasPrice.ToUser)
+		toColdPrices = append(toColdPrices, gasPrice.ToCold)
+	}
+	if len(toUserPrices) == 0 {
+		return nil, fmt.Errorf("no gas price source available: %s", sourcesValue)
+	}
+	return &StGasPrice{
+		ToUser: medianInt64(toUserPrices),
+		ToCold: medianInt64(toColdPrices),
+	}, nil
+}
@@ -0,0 +1,73 @@
+package heth
+
+import (
+	"context"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/xenv"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/moremorefun/mcommon"
+)
+
+// NewHeadActiveSeconds 超过该时间未收到新区块时视为订阅失效，恢复轮询
+const NewHeadActiveSeconds = 60 * 2
+
+// NewHeadRetrySeconds 订阅断开后重连的间隔
+const NewHeadRetrySeconds = 10
+
+// lastHeadTime 最后收到新区块的时间
+var lastHeadTime int64
+
+// IsNewHeadActive 新区块订阅是否正常，正常时不需要轮询检测区块
+func IsNewHeadActive() bool {
+	if xenv.Cfg.EthWsRPC == "" {
+		return false
+	}
+	return time.Now().Unix()-atomic.LoadInt64(&lastHeadTime) < NewHeadActiveSeconds
+}
+
+// WatchNewHead 订阅新区块，每收到新区块时调用 onHead，直到 ctx 取消
+// 连接断开后定时重连，断开期间由定时任务轮询检测区块
+func WatchNewHead(ctx context.Context, onHead func()) {
+	for ctx.Err() == nil {
+		err := watchNewHeadOnce(ctx, onHead)
+		atomic.StoreInt64(&lastHeadTime, 0)
+		if err != nil {
+			mcommon.Log.Warnf("eth new head subscription err: [%T] %s", err, err.Error())
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second * NewHeadRetrySeconds):
+		}
+	}
+}
+
+// watchNewHeadOnce 建立一次订阅，订阅出错或 ctx 取消时返回
+func watchNewHeadOnce(ctx context.Context, onHead func()) error {
+	headCh := make(chan *types.Header, 16)
+	wsClient, sub, err := ethclient.RPCSubscribeNewHead(ctx, xenv.Cfg.EthWsRPC, headCh)
+	if err != nil {
+		return err
+	}
+	defer wsClient.Close()
+	defer sub.Unsubscribe()
+	mcommon.Log.Infof("eth new head subscribed")
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-sub.Err():
+			return err
+		case head := <-headCh:
+			// 合并积压的区块头，只处理一次
+			for len(headCh) > 0 {
+				head = <-headCh
+			}
+			atomic.StoreInt64(&lastHeadTime, time.Now().Unix())
+			mcommon.Log.Debugf("eth new head: %d", head.Number.Int64())
+			onHead()
+		}
+	}
+}
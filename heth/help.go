@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"go-dc-wallet/app"
-	"go-dc-wallet/ethclient"
 	"go-dc-wallet/model"
 	"go-dc-wallet/xenv"
 	"math/big"
@@ -35,29 +34,32 @@ func init() {
 	ethToWeiDecimal = decimal.NewFromInt(EthToWei)
 }
 
-// GetNonce 获取nonce值
-func GetNonce(tx mcommon.DbExeAble, address string) (int64, error) {
-	// 通过rpc获取
-	rpcNonce, err := ethclient.RPCNonceAt(
-		context.Background(),
-		address,
-	)
-	if nil != err {
-		return 0, err
-	}
-	// 获取db nonce
-	dbNonce, err := app.SQLGetTSendMaxNonce(
-		context.Background(),
-		tx,
-		address,
-	)
-	if nil != err {
-		return 0, err
-	}
-	if dbNonce > rpcNonce {
-		rpcNonce = dbNonce
+// sendRejectedErrors 交易本身无效、重试也无法发送的错误，
+// 余额不足等暂时性的错误继续重试
+var sendRejectedErrors = []string{
+	"invalid sender",
+	"oversized data",
+	"intrinsic gas too low",
+}
+
+// getConfigInt 获取当前链的数值配置，不存在时返回默认值
+func getConfigInt(ctx context.Context, k string, defaultValue int64) (int64, error) {
+	return app.GetConfigInt(ctx, xenv.DbCon, chainKey(ctx, k), defaultValue)
+}
+
+// getConfigStr 获取当前链的字符串配置，不存在时返回空字符串
+func getConfigStr(ctx context.Context, k string) (string, error) {
+	return app.GetConfigStr(ctx, xenv.DbCon, chainKey(ctx, k))
+}
+
+// IsSendRejected 判断交易是否被节点拒绝
+func IsSendRejected(err error) bool {
+	for _, msg := range sendRejectedErrors {
+		if strings.Contains(err.Error(), msg) {
+			return true
+		}
 	}
-	return rpcNonce, nil
+	return false
 }
 
 // IsValidAddress validate hex address
@@ -86,6 +88,21 @@ func StrToAddressBytes(str string) (common.Address, error) {
 	return common.HexToAddress(str), nil
 }
 
+// checkColdAddress 校验整理的目标地址，为空或零地址时返回 ErrColdAddressEmpty
+func checkColdAddress(address string) error {
+	if address == "" {
+		return ErrColdAddressEmpty
+	}
+	addressBytes, err := StrToAddressBytes(address)
+	if err != nil {
+		return err
+	}
+	if addressBytes == (common.Address{}) {
+		return fmt.Errorf("%w: %s", ErrColdAddressEmpty, address)
+	}
+	return nil
+}
+
 // EthStrToWeiBigInit 转换金额 eth to wei
 func EthStrToWeiBigInit(balanceRealStr string) (*big.Int, error) {
 	balanceReal, err := decimal.NewFromString(balanceRealStr)
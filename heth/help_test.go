@@ -0,0 +1,37 @@
+package heth
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckColdAddress(t *testing.T) {
+	for _, address := range []string{"", "0x0000000000000000000000000000000000000000"} {
+		err := checkColdAddress(address)
+		if !errors.Is(err, ErrColdAddressEmpty) {
+			t.Fatalf("cold address %q err: %v", address, err)
+		}
+	}
+	err := checkColdAddress("0x123")
+	if err == nil || errors.Is(err, ErrColdAddressEmpty) {
+		t.Fatalf("invalid cold address err: %v", err)
+	}
+	err = checkColdAddress("0xdac17f958d2ee523a2206206994597c13d831ec7")
+	if err != nil {
+		t.Fatalf("cold address err: %s", err.Error())
+	}
+}
+
+func TestIsSendRejected(t *testing.T) {
+	for _, msg := range []string{"invalid sender", "oversized data", "intrinsic gas too low"} {
+		if !IsSendRejected(errors.New(msg)) {
+			t.Fatalf("%s should be rejected", msg)
+		}
+	}
+	// 暂时性的错误重试
+	for _, msg := range []string{"insufficient funds for gas * price + value", "nonce too low", "replacement transaction underpriced"} {
+		if IsSendRejected(errors.New(msg)) {
+			t.Fatalf("%s should retry", msg)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package heth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/xenv"
+	"math/big"
+	"strings"
+)
+
+// StHotWallet 热钱包
+type StHotWallet struct {
+	Address    string
+	PrivateKey *ecdsa.PrivateKey
+	// Balance 可用eth余额，已扣除未完成发送的数额
+	Balance *big.Int
+	// PendingCount 未完成的交易数，即排队中的nonce数
+	PendingCount int64
+}
+
+// ParseHotAddresses 解析热钱包地址，多个以逗号分隔
+func ParseHotAddresses(value string) ([]string, error) {
+	var addresses []string
+	for _, address := range strings.Split(value, ",") {
+		address = strings.ToLower(strings.TrimSpace(address))
+		if address == "" {
+			continue
+		}
+		_, err := StrToAddressBytes(address)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
+	}
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("no hot address of: %s", value)
+	}
+	return addresses, nil
+}
+
+// getHotWallet 获取热钱包的私钥、可用余额和排队交易数
+func getHotWallet(ctx context.Context, address string) (*StHotWallet, error) {
+	privateKey, err := GetPkOfAddress(
+		ctx,
+		xenv.DbCon,
+		address,
+	)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := ethclient.RPCBalanceAt(
+		ctx,
+		address,
+	)
+	if err != nil {
+		return nil, err
+	}
+	pendingBalanceRealStr, err := app.SQLGetTSendPendingBalanceReal(
+		ctx,
+		xenv.DbCon,
+		address,
+		chainName(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+	pendingBalance, err := EthStrToWeiBigInit(pendingBalanceRealStr)
+	if err != nil {
+		return nil, err
+	}
+	balance.Sub(balance, pendingBalance)
+	pendingCount, err := app.SQLGetTSendPendingCount(
+		ctx,
+		xenv.DbCon,
+		address,
+		chainName(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &StHotWallet{
+		Address:      address,
+		PrivateKey:   privateKey,
+		Balance:      balance,
+		PendingCount: pendingCount,
+	}, nil
+}
+
+// selectHotWallet 在满足条件的热钱包中选择排队交易最少的，
+// 排队交易数相同时选择可用余额多的
+func selectHotWallet(hotWallets []*StHotWallet, isAvailable func(*StHotWallet) bool) *StHotWallet {
+	var selected *StHotWallet
+	for _, hotWallet := range hotWallets {
+		if !isAvailable(hotWallet) {
+			continue
+		}
+		if selected == nil ||
+			hotWallet.PendingCount < selected.PendingCount ||
+			(hotWallet.PendingCount == selected.PendingCount && hotWallet.Balance.Cmp(selected.Balance) > 0) {
+			selected = hotWallet
+		}
+	}
+	return selected
+}
@@ -0,0 +1,1217 @@
+package heth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/moremorefun/mcommon"
+)
+
+// nft 标准
+const (
+	NftStandard721  = 721
+	NftStandard1155 = 1155
+)
+
+// NftGasUseDefault nft转账默认 gas，可通过 t_app_config_int.nft_gas_use 配置
+const NftGasUseDefault = 150000
+
+// stNftTransfer nft转账事件，erc1155 批量转账按 token id 拆分
+type stNftTransfer struct {
+	Log     types.Log
+	NftID   int64
+	From    string
+	To      string
+	TokenID *big.Int
+	Amount  *big.Int
+}
+
+// getNftSeekNum 获取nft检测进度，没有记录时从eth检测进度开始
+func getNftSeekNum(ctx context.Context, ethSeekValue int64) (int64, error) {
+	row, err := model.SQLGetTAppStatusIntColKV(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTAppStatusIntV,
+		},
+		[]string{
+			model.DBColShortTAppStatusIntK,
+		},
+		[]interface{}{
+			chainKey(ctx, "nft_seek_num"),
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	if row != nil {
+		return row.V, nil
+	}
+	_, err = model.SQLCreateTAppStatusInt(
+		ctx,
+		xenv.DbCon,
+		&model.DBTAppStatusInt{
+			K: chainKey(ctx, "nft_seek_num"),
+			V: ethSeekValue,
+		},
+		true,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return ethSeekValue, nil
+}
+
+// getNftTransfers 获取区块中配置的nft合约的转账事件
+func getNftTransfers(ctx context.Context, blockNumber int64, nftRows []*model.DBTAppConfigNft) ([]*stNftTransfer, error) {
+	erc721Abi, err := abi.JSON(strings.NewReader(ethclient.Erc721ABI))
+	if err != nil {
+		return nil, err
+	}
+	erc1155Abi, err := abi.JSON(strings.NewReader(ethclient.Erc1155ABI))
+	if err != nil {
+		return nil, err
+	}
+	nftMap := make(map[string]*model.DBTAppConfigNft)
+	var erc721Addresses []string
+	var erc1155Addresses []string
+	for _, nftRow := range nftRows {
+		nftMap[strings.ToLower(nftRow.TokenAddress)] = nftRow
+		switch nftRow.TokenStandard {
+		case NftStandard721:
+			erc721Addresses = append(erc721Addresses, nftRow.TokenAddress)
+		case NftStandard1155:
+			erc1155Addresses = append(erc1155Addresses, nftRow.TokenAddress)
+		default:
+			return nil, fmt.Errorf("unknown nft standard: %s %d", nftRow.TokenSymbol, nftRow.TokenStandard)
+		}
+	}
+	var transfers []*stNftTransfer
+	if len(erc721Addresses) > 0 {
+		logs, err := ethclient.RPCFilterLogs(
+			ctx,
+			blockNumber,
+			blockNumber,
+			erc721Addresses,
+			erc721Abi.Events["Transfer"],
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, log := range logs {
+			// erc20 的 Transfer 事件只有3个topic
+			if log.Removed || len(log.Topics) != 4 {
+				continue
+			}
+			nftRow, ok := nftMap[strings.ToLower(log.Address.Hex())]
+			if !ok {
+				continue
+			}
+			transfers = append(transfers, &stNftTransfer{
+				Log:     log,
+				NftID:   nftRow.ID,
+				From:    AddressBytesToStr(common.HexToAddress(log.Topics[1].Hex())),
+				To:      AddressBytesToStr(common.HexToAddress(log.Topics[2].Hex())),
+				TokenID: log.Topics[3].Big(),
+				Amount:  big.NewInt(1),
+			})
+		}
+	}
+	if len(erc1155Addresses) > 0 {
+		for _, eventName := range []string{"TransferSingle", "TransferBatch"} {
+			logs, err := ethclient.RPCFilterLogs(
+				ctx,
+				blockNumber,
+				blockNumber,
+				erc1155Addresses,
+				erc1155Abi.Events[eventName],
+			)
+			if err != nil {
+				return nil, err
+			}
+			for _, log := range logs {
+				if log.Removed || len(log.Topics) != 4 {
+					continue
+				}
+				nftRow, ok := nftMap[strings.ToLower(log.Address.Hex())]
+				if !ok {
+					continue
+				}
+				values, err := erc1155Abi.Unpack(eventName, log.Data)
+				if err != nil {
+					return nil, err
+				}
+				var ids []*big.Int
+				var amounts []*big.Int
+				if eventName == "TransferSingle" {
+					ids = []*big.Int{values[0].(*big.Int)}
+					amounts = []*big.Int{values[1].(*big.Int)}
+				} else {
+					ids = values[0].([]*big.Int)
+					amounts = values[1].([]*big.Int)
+				}
+				if len(ids) != len(amounts) {
+					return nil, fmt.Errorf("error %s data: %s", eventName, log.TxHash.Hex())
+				}
+				// 同一事件中重复的 token id 合并数量
+				idTransferMap := make(map[string]*stNftTransfer)
+				for idIndex, id := range ids {
+					transfer, ok := idTransferMap[id.String()]
+					if ok {
+						transfer.Amount.Add(transfer.Amount, amounts[idIndex])
+						continue
+					}
+					transfer = &stNftTransfer{
+						Log:     log,
+						NftID:   nftRow.ID,
+						From:    AddressBytesToStr(common.HexToAddress(log.Topics[2].Hex())),
+						To:      AddressBytesToStr(common.HexToAddress(log.Topics[3].Hex())),
+						TokenID: id,
+						Amount:  new(big.Int).Set(amounts[idIndex]),
+					}
+					idTransferMap[id.String()] = transfer
+					transfers = append(transfers, transfer)
+				}
+			}
+		}
+	}
+	return transfers, nil
+}
+
+// packNftTransfer 生成nft转账的input
+func packNftTransfer(nftRow *model.DBTAppConfigNft, from string, to string, tokenID *big.Int, amount *big.Int) ([]byte, error) {
+	switch nftRow.TokenStandard {
+	case NftStandard721:
+		contractAbi, err := abi.JSON(strings.NewReader(ethclient.Erc721ABI))
+		if err != nil {
+			return nil, err
+		}
+		return contractAbi.Pack(
+			"safeTransferFrom",
+			common.HexToAddress(from),
+			common.HexToAddress(to),
+			tokenID,
+		)
+	case NftStandard1155:
+		contractAbi, err := abi.JSON(strings.NewReader(ethclient.Erc1155ABI))
+		if err != nil {
+			return nil, err
+		}
+		return contractAbi.Pack(
+			"safeTransferFrom",
+			common.HexToAddress(from),
+			common.HexToAddress(to),
+			tokenID,
+			amount,
+			[]byte{},
+		)
+	}
+	return nil, fmt.Errorf("unknown nft standard: %s %d", nftRow.TokenSymbol, nftRow.TokenStandard)
+}
+
+// getNftBalance 获取地址持有的nft数量，erc721 持有为1否则为0
+func getNftBalance(ctx context.Context, nftRow *model.DBTAppConfigNft, address string, tokenID *big.Int) (*big.Int, error) {
+	switch nftRow.TokenStandard {
+	case NftStandard721:
+		owner, err := ethclient.RPCNftOwnerOf(ctx, nftRow.TokenAddress, tokenID)
+		if err != nil {
+			return nil, err
+		}
+		if owner == strings.ToLower(address) {
+			return big.NewInt(1), nil
+		}
+		return new(big.Int), nil
+	case NftStandard1155:
+		return ethclient.RPCNft1155Balance(ctx, nftRow.TokenAddress, address, tokenID)
+	}
+	return nil, fmt.Errorf("unknown nft standard: %s %d", nftRow.TokenSymbol, nftRow.TokenStandard)
+}
+
+// CheckNftBlockSeek nft检测到账
+func CheckNftBlockSeek(ctx context.Context) {
+	lockKey := chainKey(ctx, "NftCheckBlockSeek")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
+		// 获取配置 延迟确认数
+		confirmValue, err := app.SQLGetTAppConfigIntValueByK(
+			ctx,
+			xenv.DbCon,
+			chainKey(ctx, "block_confirm_num"),
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		// 只检测已记录区块hash的区块，以便发现回滚
+		ethSeekValue, err := app.SQLGetTAppStatusIntValueByK(
+			ctx,
+			xenv.DbCon,
+			chainKey(ctx, "eth_seek_num"),
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		// 获取状态 当前处理完成的最新的block number
+		seekValue, err := getNftSeekNum(ctx, ethSeekValue)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		// rpc 获取当前最新区块数
+		rpcBlockNum, err := ethclient.RPCBlockNumber(ctx)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		startI := seekValue + 1
+		endI := rpcBlockNum - confirmValue + 1
+		if endI > ethSeekValue+1 {
+			endI = ethSeekValue + 1
+		}
+		if startI >= endI {
+			return
+		}
+		// 获取所有nft
+		nftRows, err := app.SQLSelectTAppConfigNftColAll(
+			ctx,
+			xenv.DbCon,
+			[]string{
+				model.DBColTAppConfigNftID,
+				model.DBColTAppConfigNftTokenAddress,
+				model.DBColTAppConfigNftTokenStandard,
+				model.DBColTAppConfigNftTokenSymbol,
+			},
+			chainName(ctx),
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		// 遍历获取需要查询的block信息
+		for i := startI; i < endI; i++ {
+			savedHash, err := getSavedBlockHash(ctx, xenv.DbCon, i)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
+			var txNftRows []*model.DBTTxNft
+			if len(nftRows) > 0 {
+				transfers, err := getNftTransfers(ctx, i, nftRows)
+				if err != nil {
+					mcommon.Log.Warnf("err: [%T] %s", err, err.Error())
+					return
+				}
+				// 接收地址列表
+				var toAddresses []string
+				for _, transfer := range transfers {
+					if savedHash != "" && transfer.Log.BlockHash.Hex() != savedHash {
+						// 区块已变化，等待eth区块检测处理回滚
+						mcommon.Log.Warnf("nft block hash changed: %d %s %s", i, savedHash, transfer.Log.BlockHash.Hex())
+						return
+					}
+					if !mcommon.IsStringInSlice(toAddresses, transfer.To) {
+						toAddresses = append(toAddresses, transfer.To)
+					}
+				}
+				// 从db中查询这些地址是否是冲币地址中的地址
+				addressMap, err := app.SQLGetAddressKeyMap(
+					ctx,
+					xenv.DbCon,
+					[]string{
+						model.DBColTAddressKeyAddress,
+						model.DBColTAddressKeyUseTag,
+					},
+					toAddresses,
+				)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					return
+				}
+				now := time.Now().Unix()
+				for _, transfer := range transfers {
+					addressRow, ok := addressMap[transfer.To]
+					if !ok || addressRow.UseTag < 0 {
+						continue
+					}
+					if transfer.Amount.Sign() <= 0 {
+						continue
+					}
+					txNftRows = append(txNftRows, &model.DBTTxNft{
+						Chain:        chainName(ctx),
+						NftID:        transfer.NftID,
+						ProductID:    addressRow.UseTag,
+						BlockNumber:  int64(transfer.Log.BlockNumber),
+						BlockHash:    transfer.Log.BlockHash.Hex(),
+						TxID:         transfer.Log.TxHash.Hex(),
+						LogIndex:     int64(transfer.Log.Index),
+						FromAddress:  transfer.From,
+						ToAddress:    transfer.To,
+						TokenID:      transfer.TokenID.String(),
+						Amount:       transfer.Amount.String(),
+						CreateTime:   now,
+						HandleStatus: app.TxStatusInit,
+						HandleMsg:    "",
+						HandleTime:   now,
+						OrgStatus:    app.TxOrgStatusInit,
+						OrgMsg:       "",
+						OrgTime:      now,
+					})
+				}
+			}
+			// 写入充币并更新检查到的最新区块数
+			err = saveSeekBlock(ctx, "nft_seek_num", i, savedHash, func(tx mcommon.DbExeAble) error {
+				_, err := model.SQLCreateManyTTxNft(
+					ctx,
+					tx,
+					txNftRows,
+					true,
+				)
+				return err
+			})
+			if errors.Is(err, errBlockHashChanged) {
+				// 区块已回滚，等待下次从回退后的进度检测
+				mcommon.Log.Warnf("nft %s", err.Error())
+				return
+			}
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
+			app.JobAddCount(ctx, int64(len(txNftRows)))
+		}
+	})
+}
+
+// CheckNftTxNotify 创建nft冲币通知
+func CheckNftTxNotify(ctx context.Context) {
+	lockKey := chainKey(ctx, "NftCheckTxNotify")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
+		txRows, err := app.SQLSelectTTxNftColByStatus(
+			ctx,
+			xenv.DbCon,
+			[]string{
+				model.DBColTTxNftID,
+				model.DBColTTxNftNftID,
+				model.DBColTTxNftProductID,
+				model.DBColTTxNftBlockNumber,
+				model.DBColTTxNftBlockHash,
+				model.DBColTTxNftTxID,
+				model.DBColTTxNftFromAddress,
+				model.DBColTTxNftToAddress,
+				model.DBColTTxNftTokenID,
+				model.DBColTTxNftAmount,
+			},
+			app.TxStatusInit,
+			chainName(ctx),
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(txRows) == 0 {
+			return
+		}
+		// 当前高度 用于计算确认数
+		rpcBlockNum, err := ethclient.RPCBlockNumber(ctx)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		var productIDs []int64
+		var nftIDs []int64
+		for _, txRow := range txRows {
+			if !mcommon.IsIntInSlice(productIDs, txRow.ProductID) {
+				productIDs = append(productIDs, txRow.ProductID)
+			}
+			if !mcommon.IsIntInSlice(nftIDs, txRow.NftID) {
+				nftIDs = append(nftIDs, txRow.NftID)
+			}
+		}
+		productMap, err := app.SQLGetProductMap(
+			ctx,
+			xenv.DbCon,
+			[]string{
+				model.DBColTProductID,
+				model.DBColTProductAppName,
+				model.DBColTProductCbURL,
+				model.DBColTProductAppSk,
+				model.DBColTProductNotifyVersion,
+			},
+			productIDs,
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		nftMap, err := app.SQLGetAppConfigNftMap(
+			ctx,
+			xenv.DbCon,
+			[]string{
+				model.DBColTAppConfigNftID,
+				model.DBColTAppConfigNftTokenAddress,
+				model.DBColTAppConfigNftTokenSymbol,
+			},
+			nftIDs,
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+
+		var notifyTxIDs []int64
+		var notifyRows []*model.DBTProductNotify
+		now := time.Now().Unix()
+		for _, txRow := range txRows {
+			productRow, ok := productMap[txRow.ProductID]
+			if !ok {
+				mcommon.Log.Warnf("productMap no: %d", txRow.ProductID)
+				notifyTxIDs = append(notifyTxIDs, txRow.ID)
+				continue
+			}
+			nftRow, ok := nftMap[txRow.NftID]
+			if !ok {
+				app.JobErrorf(ctx, "nftMap no: %d", txRow.NftID)
+				continue
+			}
+			notifyRow, err := app.GetNotifyRow(
+				productRow,
+				&app.StNotifyData{
+					ProductID:     txRow.ProductID,
+					ItemType:      app.SendRelationTypeTxNft,
+					ItemID:        txRow.ID,
+					NotifyType:    app.NotifyTypeTx,
+					Symbol:        nftRow.TokenSymbol,
+					TxHash:        txRow.TxID,
+					Address:       txRow.ToAddress,
+					FromAddress:   txRow.FromAddress,
+					Balance:       txRow.Amount,
+					TokenAddress:  nftRow.TokenAddress,
+					TokenID:       txRow.TokenID,
+					BlockNumber:   txRow.BlockNumber,
+					BlockHash:     txRow.BlockHash,
+					Confirmations: rpcBlockNum - txRow.BlockNumber + 1,
+				},
+				now,
+			)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
+			notifyRows = append(notifyRows, notifyRow)
+			notifyTxIDs = append(notifyTxIDs, txRow.ID)
+		}
+		_, err = model.SQLCreateManyTProductNotify(
+			ctx,
+			xenv.DbCon,
+			notifyRows,
+			true,
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		_, err = app.SQLUpdateTTxNftStatusByIDs(
+			ctx,
+			xenv.DbCon,
+			notifyTxIDs,
+			model.DBTTxNft{
+				HandleStatus: app.TxStatusNotify,
+				HandleMsg:    "notify",
+				HandleTime:   now,
+			},
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		app.JobAddCount(ctx, int64(len(notifyTxIDs)))
+	})
+}
+
+// CheckNftTxOrg nft零钱整理
+func CheckNftTxOrg(ctx context.Context) {
+	lockKey := chainKey(ctx, "NftCheckTxOrg")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
+		// 计算转账nft所需的手续费
+		nftGasUseValue, err := getConfigInt(ctx, "nft_gas_use", NftGasUseDefault)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		gasPriceValue, err := app.SQLGetTAppStatusIntValueByK(
+			ctx,
+			xenv.DbCon,
+			chainKey(ctx, "to_cold_gas_price_eth"),
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		fee, err := GetTxFee(ctx, gasPriceValue)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		nftFee := big.NewInt(nftGasUseValue * fee.GasPrice)
+		ethGasUse := int64(21000)
+		ethFee := big.NewInt(ethGasUse * fee.GasPrice)
+		// chainID
+		chainID, err := ethclient.RPCNetworkID(ctx)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		err = mcommon.DbTransaction(ctx, xenv.DbCon, func(dbTx mcommon.DbExeAble) error {
+			// 查询需要处理的交易
+			txRows, err := app.SQLSelectTTxNftColByOrgForUpdate(
+				ctx,
+				dbTx,
+				[]string{
+					model.DBColTTxNftID,
+					model.DBColTTxNftNftID,
+					model.DBColTTxNftToAddress,
+					model.DBColTTxNftTokenID,
+					model.DBColTTxNftAmount,
+				},
+				[]int64{app.TxOrgStatusInit, app.TxOrgStatusFeeConfirm},
+				chainName(ctx),
+			)
+			if err != nil {
+				return err
+			}
+			if len(txRows) <= 0 {
+				return nil
+			}
+			// 整理信息，同一地址同一nft的同一 token id 合并整理
+			type StOrgInfo struct {
+				TxIDs     []int64
+				ToAddress string
+				NftID     int64
+				TokenID   *big.Int
+				Amount    *big.Int
+			}
+			var nftIDs []int64
+			var toAddresses []string
+			for _, txRow := range txRows {
+				if !mcommon.IsIntInSlice(nftIDs, txRow.NftID) {
+					nftIDs = append(nftIDs, txRow.NftID)
+				}
+				if !mcommon.IsStringInSlice(toAddresses, txRow.ToAddress) {
+					toAddresses = append(toAddresses, txRow.ToAddress)
+				}
+			}
+			nftMap, err := app.SQLGetAppConfigNftMap(
+				ctx,
+				dbTx,
+				[]string{
+					model.DBColTAppConfigNftID,
+					model.DBColTAppConfigNftTokenAddress,
+					model.DBColTAppConfigNftTokenStandard,
+					model.DBColTAppConfigNftTokenSymbol,
+					model.DBColTAppConfigNftColdAddress,
+				},
+				nftIDs,
+			)
+			if err != nil {
+				return err
+			}
+			// forwarder地址没有私钥，无法转出nft
+			forwarderMap, err := getForwarderMap(ctx, dbTx, toAddresses)
+			if err != nil {
+				return err
+			}
+			var orgKeys []string
+			orgMap := make(map[string]*StOrgInfo)
+			for _, txRow := range txRows {
+				if _, ok := forwarderMap[txRow.ToAddress]; ok {
+					mcommon.Log.Warnf("nft to forwarder address: %d %s", txRow.ID, txRow.ToAddress)
+					continue
+				}
+				nftRow, ok := nftMap[txRow.NftID]
+				if !ok {
+					app.JobErrorf(ctx, "no nftMap: %d", txRow.NftID)
+					continue
+				}
+				// 冷钱包地址未配置的nft不整理
+				err = checkColdAddress(nftRow.ColdAddress)
+				if err != nil {
+					app.JobErrorf(ctx, "nft %s organize cold address err: [%T] %s", nftRow.TokenSymbol, err, err.Error())
+					continue
+				}
+				orgKey := fmt.Sprintf("%s-%d-%s", txRow.ToAddress, txRow.NftID, txRow.TokenID)
+				orgInfo, ok := orgMap[orgKey]
+				if !ok {
+					tokenID, ok := new(big.Int).SetString(txRow.TokenID, 10)
+					if !ok {
+						app.JobErrorf(ctx, "error token id: %d %s", txRow.ID, txRow.TokenID)
+						continue
+					}
+					orgInfo = &StOrgInfo{
+						ToAddress: txRow.ToAddress,
+						NftID:     txRow.NftID,
+						TokenID:   tokenID,
+						Amount:    new(big.Int),
+					}
+					orgMap[orgKey] = orgInfo
+					orgKeys = append(orgKeys, orgKey)
+				}
+				orgInfo.TxIDs = append(orgInfo.TxIDs, txRow.ID)
+				amount, ok := new(big.Int).SetString(txRow.Amount, 10)
+				if !ok {
+					app.JobErrorf(ctx, "error amount: %d %s", txRow.ID, txRow.Amount)
+					continue
+				}
+				orgInfo.Amount.Add(orgInfo.Amount, amount)
+			}
+			// 以链上持有数量为准，已转走的不再整理
+			now := time.Now().Unix()
+			var keyAddresses []string
+			var validOrgKeys []string
+			for _, orgKey := range orgKeys {
+				orgInfo := orgMap[orgKey]
+				nftRow := nftMap[orgInfo.NftID]
+				realAmount, err := getNftBalance(ctx, nftRow, orgInfo.ToAddress, orgInfo.TokenID)
+				if err != nil {
+					app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+					continue
+				}
+				if realAmount.Sign() <= 0 {
+					_, err = app.SQLUpdateTTxNftOrgStatusByIDs(
+						ctx,
+						dbTx,
+						orgInfo.TxIDs,
+						model.DBTTxNft{
+							OrgStatus: app.TxOrgStatusConfirm,
+							OrgMsg:    "no balance",
+							OrgTime:   now,
+						},
+					)
+					if err != nil {
+						return err
+					}
+					continue
+				}
+				if nftRow.TokenStandard == NftStandard721 || orgInfo.Amount.Cmp(realAmount) > 0 {
+					orgInfo.Amount = realAmount
+				}
+				validOrgKeys = append(validOrgKeys, orgKey)
+				if !mcommon.IsStringInSlice(keyAddresses, orgInfo.ToAddress) {
+					keyAddresses = append(keyAddresses, orgInfo.ToAddress)
+				}
+			}
+			// 整理地址key
+			addressPKMap, err := GetPKMapOfAddresses(
+				ctx,
+				dbTx,
+				keyAddresses,
+			)
+			if err != nil {
+				return err
+			}
+			// 地址eth余额
+			addressEthBalanceMap := make(map[string]*big.Int)
+			// 需要手续费的整理信息
+			var needEthFeeOrgs []*StOrgInfo
+			for _, orgKey := range validOrgKeys {
+				orgInfo := orgMap[orgKey]
+				toAddress := orgInfo.ToAddress
+				nftRow := nftMap[orgInfo.NftID]
+				// 计算eth费用
+				_, ok := addressEthBalanceMap[toAddress]
+				if !ok {
+					balance, err := ethclient.RPCBalanceAt(
+						ctx,
+						toAddress,
+					)
+					if err != nil {
+						app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+						continue
+					}
+					addressEthBalanceMap[toAddress] = balance
+				}
+				addressEthBalanceMap[toAddress].Sub(addressEthBalanceMap[toAddress], nftFee)
+				if addressEthBalanceMap[toAddress].Sign() < 0 {
+					// eth手续费不足
+					needEthFeeOrgs = append(needEthFeeOrgs, orgInfo)
+					continue
+				}
+				privateKey, ok := addressPKMap[toAddress]
+				if !ok {
+					app.JobErrorf(ctx, "addressMap no: %s", toAddress)
+					continue
+				}
+				// 获取nonce值
+				nonce, err := GetNonce(ctx, dbTx, toAddress)
+				if err != nil {
+					return err
+				}
+				// 生成交易
+				input, err := packNftTransfer(nftRow, toAddress, nftRow.ColdAddress, orgInfo.TokenID, orgInfo.Amount)
+				if err != nil {
+					return err
+				}
+				signedTx, rawTxHex, err := SignTx(
+					chainID,
+					nonce,
+					common.HexToAddress(nftRow.TokenAddress),
+					big.NewInt(0),
+					nftGasUseValue,
+					fee,
+					input,
+					privateKey,
+				)
+				if err != nil {
+					return err
+				}
+				txHash := strings.ToLower(signedTx.Hash().Hex())
+				// 待插入数据
+				var sendRows []*model.DBTSend
+				for rowIndex, txID := range orgInfo.TxIDs {
+					if rowIndex == 0 {
+						sendRows = append(sendRows, &model.DBTSend{
+							Chain:                chainName(ctx),
+							RelatedType:          app.SendRelationTypeTxNft,
+							RelatedID:            txID,
+							TokenID:              0,
+							TxID:                 txHash,
+							FromAddress:          toAddress,
+							ToAddress:            nftRow.ColdAddress,
+							BalanceReal:          "0",
+							Gas:                  nftGasUseValue,
+							GasPrice:             fee.GasPrice,
+							MaxFeePerGas:         fee.MaxFeePerGas,
+							MaxPriorityFeePerGas: fee.MaxPriorityFeePerGas,
+							Nonce:                nonce,
+							Hex:                  rawTxHex,
+							CreateTime:           now,
+							HandleStatus:         app.SendStatusInit,
+							HandleMsg:            "",
+							HandleTime:           now,
+						})
+					} else {
+						sendRows = append(sendRows, &model.DBTSend{
+							Chain:        chainName(ctx),
+							RelatedType:  app.SendRelationTypeTxNft,
+							RelatedID:    txID,
+							TokenID:      0,
+							TxID:         txHash,
+							FromAddress:  toAddress,
+							ToAddress:    nftRow.ColdAddress,
+							BalanceReal:  "",
+							Gas:          0,
+							GasPrice:     0,
+							Nonce:        -1,
+							Hex:          "",
+							CreateTime:   now,
+							HandleStatus: app.SendStatusInit,
+							HandleMsg:    "",
+							HandleTime:   now,
+						})
+					}
+				}
+				// 插入发送队列
+				_, err = model.SQLCreateManyTSend(
+					ctx,
+					dbTx,
+					sendRows,
+					true,
+				)
+				if err != nil {
+					return err
+				}
+				// 更新整理状态
+				_, err = app.SQLUpdateTTxNftOrgStatusByIDs(
+					ctx,
+					dbTx,
+					orgInfo.TxIDs,
+					model.DBTTxNft{
+						OrgStatus: app.TxOrgStatusHex,
+						OrgMsg:    "hex",
+						OrgTime:   now,
+					},
+				)
+				if err != nil {
+					return err
+				}
+				app.JobAddCount(ctx, int64(len(orgInfo.TxIDs)))
+			}
+			if len(needEthFeeOrgs) == 0 {
+				return nil
+			}
+			// 生成eth手续费转账，和erc20共用手续费钱包
+			feeAddressValue, err := app.SQLGetTAppConfigStrValueByK(
+				ctx,
+				dbTx,
+				chainKey(ctx, "fee_wallet_address_erc20"),
+			)
+			if err != nil {
+				return err
+			}
+			feeWallet, err := getHotWallet(ctx, feeAddressValue)
+			if err != nil {
+				return err
+			}
+			for _, orgInfo := range needEthFeeOrgs {
+				feeWallet.Balance.Sub(feeWallet.Balance, ethFee)
+				feeWallet.Balance.Sub(feeWallet.Balance, nftFee)
+				if feeWallet.Balance.Sign() < 0 {
+					app.JobErrorf(ctx, "eth fee balance limit")
+					return nil
+				}
+				// nonce
+				nonce, err := GetNonce(
+					ctx,
+					dbTx,
+					feeAddressValue,
+				)
+				if err != nil {
+					return err
+				}
+				// 创建交易
+				var data []byte
+				signedTx, rawTxHex, err := SignTx(
+					chainID,
+					nonce,
+					common.HexToAddress(orgInfo.ToAddress),
+					nftFee,
+					ethGasUse,
+					fee,
+					data,
+					feeWallet.PrivateKey,
+				)
+				if err != nil {
+					return err
+				}
+				txHash := strings.ToLower(signedTx.Hash().Hex())
+				balanceReal, err := WeiBigIntToEthStr(nftFee)
+				if err != nil {
+					return err
+				}
+				// 待插入数据
+				var sendRows []*model.DBTSend
+				for rowIndex, txID := range orgInfo.TxIDs {
+					if rowIndex == 0 {
+						sendRows = append(sendRows, &model.DBTSend{
+							Chain:                chainName(ctx),
+							RelatedType:          app.SendRelationTypeTxNftFee,
+							RelatedID:            txID,
+							TokenID:              0,
+							TxID:                 txHash,
+							FromAddress:          feeAddressValue,
+							ToAddress:            orgInfo.ToAddress,
+							BalanceReal:          balanceReal,
+							Gas:                  ethGasUse,
+							GasPrice:             fee.GasPrice,
+							MaxFeePerGas:         fee.MaxFeePerGas,
+							MaxPriorityFeePerGas: fee.MaxPriorityFeePerGas,
+							Nonce:                nonce,
+							Hex:                  rawTxHex,
+							CreateTime:           now,
+							HandleStatus:         app.SendStatusInit,
+							HandleMsg:            "",
+							HandleTime:           now,
+						})
+					} else {
+						sendRows = append(sendRows, &model.DBTSend{
+							Chain:        chainName(ctx),
+							RelatedType:  app.SendRelationTypeTxNftFee,
+							RelatedID:    txID,
+							TokenID:      0,
+							TxID:         txHash,
+							FromAddress:  feeAddressValue,
+							ToAddress:    orgInfo.ToAddress,
+							BalanceReal:  "",
+							Gas:          0,
+							GasPrice:     0,
+							Nonce:        -1,
+							Hex:          "",
+							CreateTime:   now,
+							HandleStatus: app.SendStatusInit,
+							HandleMsg:    "",
+							HandleTime:   now,
+						})
+					}
+				}
+				// 插入发送数据
+				_, err = model.SQLCreateManyTSend(
+					ctx,
+					dbTx,
+					sendRows,
+					true,
+				)
+				if err != nil {
+					return err
+				}
+				// 更新整理状态
+				_, err = app.SQLUpdateTTxNftOrgStatusByIDs(
+					ctx,
+					dbTx,
+					orgInfo.TxIDs,
+					model.DBTTxNft{
+						OrgStatus: app.TxOrgStatusFeeHex,
+						OrgMsg:    "fee hex",
+						OrgTime:   now,
+					},
+				)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+	})
+}
+
+// CheckNftWithdraw nft提币
+func CheckNftWithdraw(ctx context.Context) {
+	lockKey := chainKey(ctx, "NftCheckWithdraw")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
+		var nftSymbols []string
+		nftMap := make(map[string]*model.DBTAppConfigNft)
+		nftRows, err := app.SQLSelectTAppConfigNftColAll(
+			ctx,
+			xenv.DbCon,
+			[]string{
+				model.DBColTAppConfigNftID,
+				model.DBColTAppConfigNftTokenAddress,
+				model.DBColTAppConfigNftTokenStandard,
+				model.DBColTAppConfigNftTokenSymbol,
+				model.DBColTAppConfigNftHotAddress,
+			},
+			chainName(ctx),
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		for _, nftRow := range nftRows {
+			nftMap[nftRow.TokenSymbol] = nftRow
+			nftSymbols = append(nftSymbols, nftRow.TokenSymbol)
+		}
+		if len(nftSymbols) == 0 {
+			return
+		}
+		withdrawRows, err := app.SQLSelectTWithdrawColByStatus(
+			ctx,
+			xenv.DbCon,
+			[]string{
+				model.DBColTWithdrawID,
+			},
+			app.WithdrawStatusInit,
+			nftSymbols,
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(withdrawRows) == 0 {
+			return
+		}
+		// 热钱包，同一地址在多个nft间共用
+		hotWalletMap := make(map[string]*StHotWallet)
+		nftHotWalletMap := make(map[string][]*StHotWallet)
+		for _, nftRow := range nftRows {
+			hotAddresses, err := ParseHotAddresses(nftRow.HotAddress)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
+			for _, hotAddress := range hotAddresses {
+				hotWallet, ok := hotWalletMap[hotAddress]
+				if !ok {
+					hotWallet, err = getHotWallet(ctx, hotAddress)
+					if err != nil {
+						app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+						return
+					}
+					hotWalletMap[hotAddress] = hotWallet
+				}
+				nftHotWalletMap[nftRow.TokenSymbol] = append(nftHotWalletMap[nftRow.TokenSymbol], hotWallet)
+			}
+		}
+		// 获取gap price
+		gasPriceValue, err := app.SQLGetTAppStatusIntValueByK(
+			ctx,
+			xenv.DbCon,
+			chainKey(ctx, "to_user_gas_price_eth"),
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		fee, err := GetTxFee(ctx, gasPriceValue)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		gasLimit, err := getConfigInt(ctx, "nft_gas_use", NftGasUseDefault)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		feeValue := big.NewInt(gasLimit * fee.GasPrice)
+		chainID, err := ethclient.RPCNetworkID(ctx)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		// 热钱包持有的nft数量 map[地址-nft-token id] => 数量
+		addressNftBalanceMap := make(map[string]*big.Int)
+		for _, withdrawRow := range withdrawRows {
+			err = handleNftWithdraw(ctx, withdrawRow.ID, chainID, nftMap, nftHotWalletMap, addressNftBalanceMap, gasLimit, fee, feeValue)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				continue
+			}
+			app.JobAddCount(ctx, 1)
+		}
+	})
+}
+
+func handleNftWithdraw(ctx context.Context, withdrawID int64, chainID int64, nftMap map[string]*model.DBTAppConfigNft, nftHotWalletMap map[string][]*StHotWallet, addressNftBalanceMap map[string]*big.Int, gasLimit int64, fee *StTxFee, feeValue *big.Int) error {
+	return mcommon.DbTransaction(ctx, xenv.DbCon, func(dbTx mcommon.DbExeAble) error {
+		withdrawRow, err := app.SQLGetTWithdrawColForUpdate(
+			ctx,
+			dbTx,
+			[]string{
+				model.DBColTWithdrawID,
+				model.DBColTWithdrawBalanceReal,
+				model.DBColTWithdrawToAddress,
+				model.DBColTWithdrawSymbol,
+				model.DBColTWithdrawTokenID,
+			},
+			withdrawID,
+			app.WithdrawStatusInit,
+		)
+		if err != nil {
+			return err
+		}
+		if withdrawRow == nil {
+			return nil
+		}
+		nftRow, ok := nftMap[withdrawRow.Symbol]
+		if !ok {
+			return fmt.Errorf("no nftMap: %s", withdrawRow.Symbol)
+		}
+		tokenID, ok := new(big.Int).SetString(withdrawRow.TokenID, 10)
+		if !ok {
+			return fmt.Errorf("error withdraw token id: %d %s", withdrawRow.ID, withdrawRow.TokenID)
+		}
+		amount, ok := new(big.Int).SetString(withdrawRow.BalanceReal, 10)
+		if !ok {
+			return fmt.Errorf("error withdraw amount: %d %s", withdrawRow.ID, withdrawRow.BalanceReal)
+		}
+		// 选择eth手续费足够、持有该nft且排队交易最少的热钱包
+		var balanceErr error
+		hotWallet := selectHotWallet(nftHotWalletMap[nftRow.TokenSymbol], func(hotWallet *StHotWallet) bool {
+			if hotWallet.Balance.Cmp(feeValue) < 0 {
+				return false
+			}
+			balanceKey := fmt.Sprintf("%s-%d-%s", hotWallet.Address, nftRow.ID, withdrawRow.TokenID)
+			balance, ok := addressNftBalanceMap[balanceKey]
+			if !ok {
+				var err error
+				balance, err = getNftBalance(ctx, nftRow, hotWallet.Address, tokenID)
+				if err != nil {
+					balanceErr = err
+					return false
+				}
+				addressNftBalanceMap[balanceKey] = balance
+			}
+			return balance.Cmp(amount) >= 0
+		})
+		if balanceErr != nil {
+			return balanceErr
+		}
+		if hotWallet == nil {
+			app.JobErrorf(ctx, "%s %s hot balance limit", nftRow.TokenSymbol, withdrawRow.TokenID)
+			return nil
+		}
+		hotAddress := hotWallet.Address
+		balanceKey := fmt.Sprintf("%s-%d-%s", hotAddress, nftRow.ID, withdrawRow.TokenID)
+		hotWallet.Balance.Sub(hotWallet.Balance, feeValue)
+		hotWallet.PendingCount++
+		addressNftBalanceMap[balanceKey].Sub(addressNftBalanceMap[balanceKey], amount)
+		// 获取nonce值
+		nonce, err := GetNonce(ctx, dbTx, hotAddress)
+		if err != nil {
+			return err
+		}
+		// 生成交易
+		input, err := packNftTransfer(nftRow, hotAddress, withdrawRow.ToAddress, tokenID, amount)
+		if err != nil {
+			return err
+		}
+		signedTx, rawTxHex, err := SignTx(
+			chainID,
+			nonce,
+			common.HexToAddress(nftRow.TokenAddress),
+			big.NewInt(0),
+			gasLimit,
+			fee,
+			input,
+			hotWallet.PrivateKey,
+		)
+		if err != nil {
+			return err
+		}
+		txHash := strings.ToLower(signedTx.Hash().Hex())
+		now := time.Now().Unix()
+		_, err = app.SQLUpdateTWithdrawGenTx(
+			ctx,
+			dbTx,
+			&model.DBTWithdraw{
+				ID:           withdrawID,
+				TxHash:       txHash,
+				HandleStatus: app.WithdrawStatusHex,
+				HandleMsg:    "hex",
+				HandleTime:   now,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		_, err = model.SQLCreateTSend(
+			ctx,
+			dbTx,
+			&model.DBTSend{
+				Chain:                chainName(ctx),
+				RelatedType:          app.SendRelationTypeWithdraw,
+				RelatedID:            withdrawID,
+				TxID:                 txHash,
+				FromAddress:          hotAddress,
+				ToAddress:            withdrawRow.ToAddress,
+				BalanceReal:          "0",
+				Gas:                  gasLimit,
+				GasPrice:             fee.GasPrice,
+				MaxFeePerGas:         fee.MaxFeePerGas,
+				MaxPriorityFeePerGas: fee.MaxPriorityFeePerGas,
+				Nonce:                nonce,
+				Hex:                  rawTxHex,
+				HandleStatus:         app.SendStatusInit,
+				HandleMsg:            "",
+				HandleTime:           now,
+			},
+			false,
+		)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+}
@@ -0,0 +1,353 @@
+package heth
+
+import (
+	"context"
+	"encoding/hex"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/moremorefun/mcommon"
+)
+
+// lockNonce 锁定地址的nonce分配，锁在事务提交或回滚时释放
+func lockNonce(ctx context.Context, tx mcommon.DbExeAble, address string) error {
+	nonceRow, err := app.SQLGetTEthNonceForUpdate(
+		ctx,
+		tx,
+		address,
+		chainName(ctx),
+	)
+	if err != nil {
+		return err
+	}
+	if nonceRow != nil {
+		return nil
+	}
+	_, err = model.SQLCreateTEthNonce(
+		ctx,
+		tx,
+		&model.DBTEthNonce{
+			Chain:      chainName(ctx),
+			Address:    address,
+			Nonce:      -1,
+			UpdateTime: time.Now().Unix(),
+		},
+		true,
+	)
+	if err != nil {
+		return err
+	}
+	_, err = app.SQLGetTEthNonceForUpdate(
+		ctx,
+		tx,
+		address,
+		chainName(ctx),
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetNonce 获取nonce值，需要在事务中调用，
+// 同一地址的nonce分配在事务结束前对其他进程加锁
+func GetNonce(ctx context.Context, tx mcommon.DbExeAble, address string) (int64, error) {
+	err := lockNonce(ctx, tx, address)
+	if err != nil {
+		return 0, err
+	}
+	// 通过rpc获取，包含交易池中的交易
+	rpcNonce, err := ethclient.RPCPendingNonceAt(
+		ctx,
+		address,
+	)
+	if nil != err {
+		return 0, err
+	}
+	// 获取db nonce
+	dbNonce, err := app.SQLGetTSendMaxNonce(
+		ctx,
+		tx,
+		address,
+		chainName(ctx),
+	)
+	if nil != err {
+		return 0, err
+	}
+	if dbNonce > rpcNonce {
+		rpcNonce = dbNonce
+	}
+	_, err = app.SQLUpdateTEthNonceByAddress(
+		ctx,
+		tx,
+		address,
+		rpcNonce,
+		time.Now().Unix(),
+		chainName(ctx),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return rpcNonce, nil
+}
+
+// CheckNonceGap 检测发送地址的nonce空缺，
+// 已发送但节点丢失的交易重新广播，没有交易的nonce使用0金额转给自己的交易填补
+func CheckNonceGap(ctx context.Context) {
+	lockKey := chainKey(ctx, "EthCheckNonceGap")
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
+		addresses, err := app.SQLSelectTSendPendingFromAddresses(
+			ctx,
+			xenv.DbCon,
+			chainName(ctx),
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		if len(addresses) == 0 {
+			return
+		}
+		chainID, err := ethclient.RPCNetworkID(ctx)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		for _, address := range addresses {
+			err = checkUsedNonce(ctx, address)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				continue
+			}
+			err = fillNonceGap(ctx, chainID, address)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				continue
+			}
+		}
+	})
+}
+
+// checkUsedNonce nonce已被链上其他交易使用时，将未打包的发送标记为失败
+func checkUsedNonce(ctx context.Context, address string) error {
+	sendRows, err := app.SQLSelectTSendColPendingByAddress(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTSendID,
+			model.DBColTSendTxID,
+			model.DBColTSendFromAddress,
+			model.DBColTSendNonce,
+			model.DBColTSendHex,
+			model.DBColTSendRelatedType,
+			model.DBColTSendRelatedID,
+		},
+		address,
+		chainName(ctx),
+	)
+	if err != nil {
+		return err
+	}
+	err = resolveReplacedTx(ctx, sendRows)
+	if err != nil {
+		return err
+	}
+	rpcNonce, err := ethclient.RPCNonceAt(
+		ctx,
+		address,
+	)
+	if err != nil {
+		return err
+	}
+	failMap := make(map[string]string)
+	for _, sendRow := range sendRows {
+		if sendRow.Hex == "" || sendRow.Nonce >= rpcNonce {
+			continue
+		}
+		isMined, err := isTxMined(ctx, sendRow.TxID)
+		if err != nil {
+			return err
+		}
+		if isMined {
+			continue
+		}
+		failMap[sendRow.TxID] = "nonce used by other tx"
+	}
+	if len(failMap) == 0 {
+		return nil
+	}
+	var failRows []*model.DBTSend
+	for _, sendRow := range sendRows {
+		if _, ok := failMap[sendRow.TxID]; ok {
+			failRows = append(failRows, sendRow)
+		}
+	}
+	mcommon.Log.Warnf("eth nonce used by other tx: %s %d", address, len(failMap))
+	return handleSendFailed(ctx, failRows, failMap)
+}
+
+// fillNonceGap 填补节点nonce与待发送交易之间的空缺
+func fillNonceGap(ctx context.Context, chainID int64, address string) error {
+	return mcommon.DbTransaction(ctx, xenv.DbCon, func(tx mcommon.DbExeAble) error {
+		err := lockNonce(ctx, tx, address)
+		if err != nil {
+			return err
+		}
+		sendRows, err := app.SQLSelectTSendColPendingByAddress(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTSendID,
+				model.DBColTSendTxID,
+				model.DBColTSendNonce,
+				model.DBColTSendHex,
+				model.DBColTSendHandleStatus,
+			},
+			address,
+			chainName(ctx),
+		)
+		if err != nil {
+			return err
+		}
+		// map[nonce] => 发送数据
+		nonceMap := make(map[int64]*model.DBTSend)
+		maxNonce := int64(-1)
+		for _, sendRow := range sendRows {
+			if sendRow.Hex == "" {
+				continue
+			}
+			nonceMap[sendRow.Nonce] = sendRow
+			if sendRow.Nonce > maxNonce {
+				maxNonce = sendRow.Nonce
+			}
+		}
+		pendingNonce, err := ethclient.RPCPendingNonceAt(
+			ctx,
+			address,
+		)
+		if err != nil {
+			return err
+		}
+		if maxNonce < pendingNonce {
+			// 没有空缺
+			return nil
+		}
+		var fillNonces []int64
+		for nonce := pendingNonce; nonce <= maxNonce; nonce++ {
+			sendRow, ok := nonceMap[nonce]
+			if !ok {
+				fillNonces = append(fillNonces, nonce)
+				continue
+			}
+			if nonce == pendingNonce && sendRow.HandleStatus == app.SendStatusSend {
+				// 节点丢失了已发送的交易，重新广播
+				err = resendRawTx(ctx, sendRow.Hex)
+				if err != nil {
+					mcommon.Log.Warnf("eth nonce resend %s err: [%T] %s", sendRow.TxID, err, err.Error())
+				}
+			}
+		}
+		if len(fillNonces) == 0 {
+			return nil
+		}
+		privateKey, err := GetPkOfAddress(
+			ctx,
+			tx,
+			address,
+		)
+		if err != nil {
+			return err
+		}
+		gasPriceValue, err := app.SQLGetTAppStatusIntValueByK(
+			ctx,
+			tx,
+			chainKey(ctx, "to_user_gas_price_eth"),
+		)
+		if err != nil {
+			return err
+		}
+		fee, err := GetTxFee(ctx, gasPriceValue)
+		if err != nil {
+			return err
+		}
+		toAddress, err := StrToAddressBytes(address)
+		if err != nil {
+			return err
+		}
+		gasLimit := int64(21000)
+		now := time.Now().Unix()
+		var fillRows []*model.DBTSend
+		for _, nonce := range fillNonces {
+			signedTx, rawTxHex, err := SignTx(
+				chainID,
+				nonce,
+				toAddress,
+				new(big.Int),
+				gasLimit,
+				fee,
+				nil,
+				privateKey,
+			)
+			if err != nil {
+				return err
+			}
+			fillRows = append(fillRows, &model.DBTSend{
+				Chain:                chainName(ctx),
+				RelatedType:          app.SendRelationTypeNonceFill,
+				RelatedID:            0,
+				TxID:                 strings.ToLower(signedTx.Hash().Hex()),
+				FromAddress:          address,
+				ToAddress:            address,
+				BalanceReal:          "0",
+				Gas:                  gasLimit,
+				GasPrice:             fee.GasPrice,
+				MaxFeePerGas:         fee.MaxFeePerGas,
+				MaxPriorityFeePerGas: fee.MaxPriorityFeePerGas,
+				Nonce:                nonce,
+				Hex:                  rawTxHex,
+				CreateTime:           now,
+				HandleStatus:         app.SendStatusInit,
+				HandleMsg:            "",
+				HandleTime:           now,
+			})
+		}
+		mcommon.Log.Warnf("eth nonce gap fill: %s %v", address, fillNonces)
+		_, err = model.SQLCreateManyTSend(
+			ctx,
+			tx,
+			fillRows,
+			false,
+		)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+// resendRawTx 重新广播交易
+func resendRawTx(ctx context.Context, rawTxHex string) error {
+	rawTxBytes, err := hex.DecodeString(rawTxHex)
+	if err != nil {
+		return err
+	}
+	rpcTx := new(types.Transaction)
+	err = rpcTx.UnmarshalBinary(rawTxBytes)
+	if err != nil {
+		return err
+	}
+	err = ethclient.RPCSendTransaction(
+		ctx,
+		rpcTx,
+	)
+	if err != nil && !strings.Contains(err.Error(), "known transaction") {
+		return err
+	}
+	return nil
+}
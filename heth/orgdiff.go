@@ -0,0 +1,79 @@
+package heth
+
+import (
+	"context"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/model"
+	"math/big"
+	"time"
+
+	"github.com/moremorefun/mcommon"
+)
+
+// stOrgBalanceDiff 整理时入账金额与链上实际余额
+type stOrgBalanceDiff struct {
+	CreditedBalance *big.Int
+	RealBalance     *big.Int
+}
+
+// getOrgRealBalance 获取以链上余额为准的token的实际余额，
+// 地址还有未完成的发送时返回nil，等待发送完成后再整理
+func getOrgRealBalance(ctx context.Context, tx mcommon.DbExeAble, tokenRow *model.DBTAppConfigToken, address string, isForwarder bool) (*big.Int, error) {
+	if !isForwarder {
+		pendingCount, err := app.SQLGetTSendPendingCount(
+			ctx,
+			tx,
+			address,
+			chainName(ctx),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if pendingCount > 0 {
+			return nil, nil
+		}
+	}
+	return ethclient.RPCTokenBalance(
+		ctx,
+		tokenRow.TokenAddress,
+		address,
+	)
+}
+
+// createOrgBalanceDiff 记录入账金额与链上实际余额的差额，相同时不记录
+func createOrgBalanceDiff(ctx context.Context, tx mcommon.DbExeAble, tokenRow *model.DBTAppConfigToken, address string, txHash string, diff *stOrgBalanceDiff) error {
+	if diff == nil || diff.CreditedBalance.Cmp(diff.RealBalance) == 0 {
+		return nil
+	}
+	creditedBalance, err := TokenWeiBigIntToEthStr(diff.CreditedBalance, tokenRow.TokenDecimals)
+	if err != nil {
+		return err
+	}
+	realBalance, err := TokenWeiBigIntToEthStr(diff.RealBalance, tokenRow.TokenDecimals)
+	if err != nil {
+		return err
+	}
+	diffBalance, err := TokenWeiBigIntToEthStr(new(big.Int).Sub(diff.RealBalance, diff.CreditedBalance), tokenRow.TokenDecimals)
+	if err != nil {
+		return err
+	}
+	_, err = model.SQLCreateTTxErc20OrgDiff(
+		ctx,
+		tx,
+		&model.DBTTxErc20OrgDiff{
+			TokenID:         tokenRow.ID,
+			Address:         address,
+			TxID:            txHash,
+			CreditedBalance: creditedBalance,
+			RealBalance:     realBalance,
+			DiffBalance:     diffBalance,
+			CreateTime:      time.Now().Unix(),
+		},
+		false,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
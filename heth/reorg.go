@@ -0,0 +1,457 @@
+package heth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/moremorefun/mcommon"
+)
+
+// BlockKeepNum 保留的区块hash记录数，超过该深度的回滚无法自动处理
+const BlockKeepNum = 1000
+
+// errBlockHashChanged 检测期间区块已被回滚处理
+var errBlockHashChanged = errors.New("saved block hash changed")
+
+// getSavedBlockHash 获取已记录的区块hash，没有记录时返回空字符串
+func getSavedBlockHash(ctx context.Context, tx mcommon.DbExeAble, blockNumber int64) (string, error) {
+	blockRow, err := model.SQLGetTEthBlockColKV(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTEthBlockBlockHash,
+		},
+		[]string{
+			model.DBColShortTEthBlockChain,
+			model.DBColShortTEthBlockBlockNumber,
+		},
+		[]interface{}{
+			chainName(ctx),
+			blockNumber,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	if blockRow == nil {
+		return "", nil
+	}
+	return blockRow.BlockHash, nil
+}
+
+// saveBlock 记录已处理的区块hash，并清理过旧的记录
+func saveBlock(ctx context.Context, tx mcommon.DbExeAble, block *types.Block) error {
+	blockNumber := block.Number().Int64()
+	_, err := model.SQLCreateTEthBlockDuplicate(
+		ctx,
+		tx,
+		&model.DBTEthBlock{
+			Chain:       chainName(ctx),
+			BlockNumber: blockNumber,
+			BlockHash:   block.Hash().Hex(),
+			ParentHash:  block.ParentHash().Hex(),
+			CreateTime:  time.Now().Unix(),
+		},
+		[]string{
+			model.DBColShortTEthBlockBlockHash,
+			model.DBColShortTEthBlockParentHash,
+			model.DBColShortTEthBlockCreateTime,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if blockNumber%100 == 0 {
+		_, err = app.SQLDeleteTEthBlockByBlockNumberLess(
+			ctx,
+			tx,
+			blockNumber-BlockKeepNum,
+			chainName(ctx),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveSeekBlock 在同一事务中写入区块的充币记录并更新检测进度 seekKey，
+// 写入前锁定并校验区块hash与检测时读取的 savedHash 一致，与 handleReorg 互斥，
+// 检测期间区块已被回滚时返回 errBlockHashChanged
+func saveSeekBlock(ctx context.Context, seekKey string, blockNumber int64, savedHash string, f func(tx mcommon.DbExeAble) error) error {
+	return mcommon.DbTransaction(ctx, xenv.DbCon, func(tx mcommon.DbExeAble) error {
+		lockedHash, err := app.SQLGetTEthBlockHashForUpdate(
+			ctx,
+			tx,
+			blockNumber,
+			chainName(ctx),
+		)
+		if err != nil {
+			return err
+		}
+		if lockedHash != savedHash {
+			return fmt.Errorf("%w: %d %s %s", errBlockHashChanged, blockNumber, savedHash, lockedHash)
+		}
+		err = f(tx)
+		if err != nil {
+			return err
+		}
+		_, err = app.SQLUpdateTAppStatusIntByKGreater(
+			ctx,
+			tx,
+			&model.DBTAppStatusInt{
+				K: chainKey(ctx, seekKey),
+				V: blockNumber,
+			},
+		)
+		return err
+	})
+}
+
+// handleReorg 已记录的区块 blockNumber 不在主链上，
+// 向前查找分叉点，作废分叉后的充币并发送回滚通知，检测进度回退到分叉点
+func handleReorg(ctx context.Context, blockNumber int64) error {
+	forkNum := blockNumber
+	for {
+		savedHash, err := getSavedBlockHash(ctx, xenv.DbCon, forkNum)
+		if err != nil {
+			return err
+		}
+		if savedHash == "" {
+			return fmt.Errorf("eth reorg deeper than saved blocks at: %d", forkNum)
+		}
+		rpcHeader, err := ethclient.RPCHeaderByNum(ctx, forkNum)
+		if err != nil {
+			return err
+		}
+		if rpcHeader.Hash().Hex() == savedHash {
+			break
+		}
+		forkNum--
+	}
+	mcommon.Log.Warnf("eth reorg at: %d, rewind to: %d", forkNum+1, forkNum)
+	return mcommon.DbTransaction(ctx, xenv.DbCon, func(tx mcommon.DbExeAble) error {
+		// 先锁定分叉后的区块记录，等待正在写入这些区块充币的检测完成
+		err := app.SQLLockTEthBlockByBlockNumberGreater(
+			ctx,
+			tx,
+			forkNum,
+			chainName(ctx),
+		)
+		if err != nil {
+			return err
+		}
+		txRows, err := app.SQLSelectTTxColByBlockNumberGreater(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTTxID,
+				model.DBColTTxProductID,
+				model.DBColTTxBlockNumber,
+				model.DBColTTxBlockHash,
+				model.DBColTTxTxID,
+				model.DBColTTxTraceAddress,
+				model.DBColTTxFromAddress,
+				model.DBColTTxToAddress,
+				model.DBColTTxBalanceReal,
+				model.DBColTTxHandleStatus,
+			},
+			forkNum,
+			chainName(ctx),
+		)
+		if err != nil {
+			return err
+		}
+		txErc20Rows, err := app.SQLSelectTTxErc20ColByBlockNumberGreater(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTTxErc20ID,
+				model.DBColTTxErc20TokenID,
+				model.DBColTTxErc20ProductID,
+				model.DBColTTxErc20BlockNumber,
+				model.DBColTTxErc20BlockHash,
+				model.DBColTTxErc20TxID,
+				model.DBColTTxErc20FromAddress,
+				model.DBColTTxErc20ToAddress,
+				model.DBColTTxErc20BalanceReal,
+				model.DBColTTxErc20HandleStatus,
+			},
+			forkNum,
+			chainName(ctx),
+		)
+		if err != nil {
+			return err
+		}
+		txNftRows, err := app.SQLSelectTTxNftColByBlockNumberGreater(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTTxNftID,
+				model.DBColTTxNftNftID,
+				model.DBColTTxNftProductID,
+				model.DBColTTxNftBlockNumber,
+				model.DBColTTxNftBlockHash,
+				model.DBColTTxNftTxID,
+				model.DBColTTxNftFromAddress,
+				model.DBColTTxNftToAddress,
+				model.DBColTTxNftTokenID,
+				model.DBColTTxNftAmount,
+				model.DBColTTxNftHandleStatus,
+			},
+			forkNum,
+			chainName(ctx),
+		)
+		if err != nil {
+			return err
+		}
+		var productIDs []int64
+		var tokenIDs []int64
+		var nftIDs []int64
+		for _, txRow := range txRows {
+			if !mcommon.IsIntInSlice(productIDs, txRow.ProductID) {
+				productIDs = append(productIDs, txRow.ProductID)
+			}
+		}
+		for _, txRow := range txErc20Rows {
+			if !mcommon.IsIntInSlice(productIDs, txRow.ProductID) {
+				productIDs = append(productIDs, txRow.ProductID)
+			}
+			if !mcommon.IsIntInSlice(tokenIDs, txRow.TokenID) {
+				tokenIDs = append(tokenIDs, txRow.TokenID)
+			}
+		}
+		for _, txRow := range txNftRows {
+			if !mcommon.IsIntInSlice(productIDs, txRow.ProductID) {
+				productIDs = append(productIDs, txRow.ProductID)
+			}
+			if !mcommon.IsIntInSlice(nftIDs, txRow.NftID) {
+				nftIDs = append(nftIDs, txRow.NftID)
+			}
+		}
+		productMap, err := app.SQLGetProductMap(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTProductID,
+				model.DBColTProductAppName,
+				model.DBColTProductCbURL,
+				model.DBColTProductAppSk,
+				model.DBColTProductNotifyVersion,
+			},
+			productIDs,
+		)
+		if err != nil {
+			return err
+		}
+		tokenMap, err := app.SQLGetAppConfigTokenMap(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTAppConfigTokenID,
+				model.DBColTAppConfigTokenTokenAddress,
+				model.DBColTAppConfigTokenTokenSymbol,
+			},
+			tokenIDs,
+		)
+		if err != nil {
+			return err
+		}
+		nftMap, err := app.SQLGetAppConfigNftMap(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTAppConfigNftID,
+				model.DBColTAppConfigNftTokenAddress,
+				model.DBColTAppConfigNftTokenSymbol,
+			},
+			nftIDs,
+		)
+		if err != nil {
+			return err
+		}
+		// 已通知的充币发送回滚通知
+		var notifyRows []*model.DBTProductNotify
+		var txIDs []int64
+		var txErc20IDs []int64
+		var txNftIDs []int64
+		now := time.Now().Unix()
+		for _, txRow := range txRows {
+			txIDs = append(txIDs, txRow.ID)
+			if txRow.HandleStatus != app.TxStatusNotify {
+				continue
+			}
+			productRow, ok := productMap[txRow.ProductID]
+			if !ok {
+				mcommon.Log.Warnf("no productMap: %d", txRow.ProductID)
+				continue
+			}
+			notifyRow, err := app.GetNotifyRow(
+				productRow,
+				&app.StNotifyData{
+					ProductID:   txRow.ProductID,
+					ItemType:    app.SendRelationTypeTx,
+					ItemID:      txRow.ID,
+					NotifyType:  app.NotifyTypeTxReorg,
+					Symbol:      chainSymbol(ctx),
+					TxHash:      GetTxNotifyHash(txRow),
+					Address:     txRow.ToAddress,
+					FromAddress: txRow.FromAddress,
+					Balance:     txRow.BalanceReal,
+					BlockNumber: txRow.BlockNumber,
+					BlockHash:   txRow.BlockHash,
+					Reason:      app.NotifyReasonReorg,
+				},
+				now,
+			)
+			if err != nil {
+				return err
+			}
+			notifyRows = append(notifyRows, notifyRow)
+		}
+		for _, txRow := range txErc20Rows {
+			txErc20IDs = append(txErc20IDs, txRow.ID)
+			if txRow.HandleStatus != app.TxStatusNotify {
+				continue
+			}
+			productRow, ok := productMap[txRow.ProductID]
+			if !ok {
+				mcommon.Log.Warnf("no productMap: %d", txRow.ProductID)
+				continue
+			}
+			tokenRow, ok := tokenMap[txRow.TokenID]
+			if !ok {
+				return fmt.Errorf("no tokenMap: %d", txRow.TokenID)
+			}
+			notifyRow, err := app.GetNotifyRow(
+				productRow,
+				&app.StNotifyData{
+					ProductID:    txRow.ProductID,
+					ItemType:     app.SendRelationTypeTx,
+					ItemID:       txRow.ID,
+					NotifyType:   app.NotifyTypeTxReorg,
+					Symbol:       tokenRow.TokenSymbol,
+					TxHash:       txRow.TxID,
+					Address:      txRow.ToAddress,
+					FromAddress:  txRow.FromAddress,
+					Balance:      txRow.BalanceReal,
+					TokenAddress: tokenRow.TokenAddress,
+					BlockNumber:  txRow.BlockNumber,
+					BlockHash:    txRow.BlockHash,
+					Reason:       app.NotifyReasonReorg,
+				},
+				now,
+			)
+			if err != nil {
+				return err
+			}
+			notifyRows = append(notifyRows, notifyRow)
+		}
+		for _, txRow := range txNftRows {
+			txNftIDs = append(txNftIDs, txRow.ID)
+			if txRow.HandleStatus != app.TxStatusNotify {
+				continue
+			}
+			productRow, ok := productMap[txRow.ProductID]
+			if !ok {
+				mcommon.Log.Warnf("no productMap: %d", txRow.ProductID)
+				continue
+			}
+			nftRow, ok := nftMap[txRow.NftID]
+			if !ok {
+				return fmt.Errorf("no nftMap: %d", txRow.NftID)
+			}
+			notifyRow, err := app.GetNotifyRow(
+				productRow,
+				&app.StNotifyData{
+					ProductID:    txRow.ProductID,
+					ItemType:     app.SendRelationTypeTxNft,
+					ItemID:       txRow.ID,
+					NotifyType:   app.NotifyTypeTxReorg,
+					Symbol:       nftRow.TokenSymbol,
+					TxHash:       txRow.TxID,
+					Address:      txRow.ToAddress,
+					FromAddress:  txRow.FromAddress,
+					Balance:      txRow.Amount,
+					TokenAddress: nftRow.TokenAddress,
+					TokenID:      txRow.TokenID,
+					BlockNumber:  txRow.BlockNumber,
+					BlockHash:    txRow.BlockHash,
+					Reason:       app.NotifyReasonReorg,
+				},
+				now,
+			)
+			if err != nil {
+				return err
+			}
+			notifyRows = append(notifyRows, notifyRow)
+		}
+		_, err = model.SQLCreateManyTProductNotify(
+			ctx,
+			tx,
+			notifyRows,
+			true,
+		)
+		if err != nil {
+			return err
+		}
+		_, err = app.SQLUpdateTTxReorgByIDs(
+			ctx,
+			tx,
+			txIDs,
+			now,
+		)
+		if err != nil {
+			return err
+		}
+		_, err = app.SQLUpdateTTxErc20ReorgByIDs(
+			ctx,
+			tx,
+			txErc20IDs,
+			now,
+		)
+		if err != nil {
+			return err
+		}
+		_, err = app.SQLUpdateTTxNftReorgByIDs(
+			ctx,
+			tx,
+			txNftIDs,
+			now,
+		)
+		if err != nil {
+			return err
+		}
+		_, err = app.SQLDeleteTEthBlockByBlockNumberGreater(
+			ctx,
+			tx,
+			forkNum,
+			chainName(ctx),
+		)
+		if err != nil {
+			return err
+		}
+		for _, k := range []string{"eth_seek_num", "erc20_seek_num", "nft_seek_num"} {
+			_, err = app.SQLUpdateTAppStatusIntByKLess(
+				ctx,
+				tx,
+				&model.DBTAppStatusInt{
+					K: chainKey(ctx, k),
+					V: forkNum,
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+		app.JobAddCount(ctx, int64(len(txIDs)+len(txErc20IDs)+len(txNftIDs)))
+		return nil
+	})
+}
@@ -0,0 +1,61 @@
+package heth
+
+import (
+	"context"
+	"errors"
+	"go-dc-wallet/xenv"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/moremorefun/mcommon"
+)
+
+func TestSaveSeekBlock(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock err: %s", err.Error())
+	}
+	oldDbCon := xenv.DbCon
+	xenv.DbCon = sqlx.NewDb(db, "mysql")
+	t.Cleanup(func() {
+		xenv.DbCon = oldDbCon
+		_ = db.Close()
+	})
+	ctx := context.Background()
+
+	// 检测期间区块已被回滚，不写入充币也不推进进度
+	mock.ExpectBegin()
+	mock.ExpectQuery("FROM t_eth_block WHERE chain=\\? AND block_number=\\? LIMIT 1 FOR UPDATE").
+		WithArgs("eth", int64(100)).
+		WillReturnRows(sqlmock.NewRows([]string{"block_hash"}))
+	mock.ExpectRollback()
+	isCalled := false
+	err = saveSeekBlock(ctx, "erc20_seek_num", 100, "0xaa", func(tx mcommon.DbExeAble) error {
+		isCalled = true
+		return nil
+	})
+	if !errors.Is(err, errBlockHashChanged) || isCalled {
+		t.Fatalf("orphan block err: %v, called: %v", err, isCalled)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("FROM t_eth_block WHERE chain=\\? AND block_number=\\? LIMIT 1 FOR UPDATE").
+		WithArgs("eth", int64(101)).
+		WillReturnRows(sqlmock.NewRows([]string{"block_hash"}).AddRow("0xbb"))
+	mock.ExpectExec("UPDATE t_app_status_int").
+		WithArgs(int64(101), "erc20_seek_num", int64(101)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	err = saveSeekBlock(ctx, "erc20_seek_num", 101, "0xbb", func(tx mcommon.DbExeAble) error {
+		isCalled = true
+		return nil
+	})
+	if err != nil || !isCalled {
+		t.Fatalf("save block err: %v, called: %v", err, isCalled)
+	}
+	err = mock.ExpectationsWereMet()
+	if err != nil {
+		t.Fatalf("sql expectations: %s", err.Error())
+	}
+}
@@ -0,0 +1,467 @@
+package heth
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/moremorefun/mcommon"
+)
+
+// 替换交易的单价涨幅
+const (
+	// ReplaceBumpPercent 替换交易时单价提高的百分比
+	ReplaceBumpPercent = 20
+	// ReplaceMinBumpPercent 节点接受替换交易要求的最低涨幅
+	ReplaceMinBumpPercent = 10
+	// ReplaceSecondsDefault 交易发送后未打包多久进行替换
+	ReplaceSecondsDefault = 600
+)
+
+// getReplaceSeconds 获取交易发送后未打包多久进行替换，小于等于0时不替换
+func getReplaceSeconds(ctx context.Context) (int64, error) {
+	return getConfigInt(ctx, "eth_tx_replace_seconds", ReplaceSecondsDefault)
+}
+
+// isTxMined 交易是否已打包，节点中没有该交易时返回 false
+func isTxMined(ctx context.Context, txHash string) (bool, error) {
+	rpcTx, err := ethclient.RPCTransactionByHash(ctx, txHash)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return rpcTx != nil, nil
+}
+
+// updateWithdrawTxHash 将发送数据对应提币的 tx hash 改为新的交易，
+// 同时修改已生成的提币通知并重新发送，使商户获得新的 tx hash
+func updateWithdrawTxHash(ctx context.Context, tx mcommon.DbExeAble, sendRows []*model.DBTSend, txID string, newTxID string) error {
+	var withdrawIDs []int64
+	for _, sendRow := range sendRows {
+		if sendRow.TxID != txID {
+			continue
+		}
+		switch sendRow.RelatedType {
+		case app.SendRelationTypeWithdraw:
+			_, err := app.SQLUpdateTWithdrawTxHashByID(
+				ctx,
+				tx,
+				sendRow.RelatedID,
+				newTxID,
+			)
+			if err != nil {
+				return err
+			}
+			withdrawIDs = append(withdrawIDs, sendRow.RelatedID)
+		case app.SendRelationTypeWithdrawBatch:
+			_, err := app.SQLUpdateTWithdrawTxHashBySendID(
+				ctx,
+				tx,
+				sendRow.ID,
+				newTxID,
+			)
+			if err != nil {
+				return err
+			}
+			linkRows, err := app.SQLSelectTSendWithdrawColBySendIDs(
+				ctx,
+				tx,
+				[]string{
+					model.DBColTSendWithdrawWithdrawID,
+				},
+				[]int64{sendRow.ID},
+			)
+			if err != nil {
+				return err
+			}
+			for _, linkRow := range linkRows {
+				withdrawIDs = append(withdrawIDs, linkRow.WithdrawID)
+			}
+		}
+	}
+	notifyRows, err := app.SQLSelectTProductNotifyColByItemIDs(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTProductNotifyID,
+			model.DBColTProductNotifyProductID,
+			model.DBColTProductNotifyMsg,
+		},
+		app.SendRelationTypeWithdraw,
+		withdrawIDs,
+	)
+	if err != nil {
+		return err
+	}
+	if len(notifyRows) == 0 {
+		return nil
+	}
+	var productIDs []int64
+	for _, notifyRow := range notifyRows {
+		if !mcommon.IsIntInSlice(productIDs, notifyRow.ProductID) {
+			productIDs = append(productIDs, notifyRow.ProductID)
+		}
+	}
+	productMap, err := app.SQLGetProductMap(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTProductID,
+			model.DBColTProductCbURL,
+			model.DBColTProductAppSk,
+		},
+		productIDs,
+	)
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	for _, notifyRow := range notifyRows {
+		productRow, ok := productMap[notifyRow.ProductID]
+		if !ok {
+			return fmt.Errorf("no product of notify: %d", notifyRow.ID)
+		}
+		msg, err := app.ReplaceNotifyTxHash(productRow, notifyRow.Msg, newTxID)
+		if err != nil {
+			return err
+		}
+		_, err = app.SQLUpdateTProductNotifyMsgByID(
+			ctx,
+			tx,
+			&model.DBTProductNotify{
+				ID:           notifyRow.ID,
+				Nonce:        mcommon.GetUUIDStr(),
+				URL:          productRow.CbURL,
+				Msg:          msg,
+				HandleStatus: app.NotifyStatusInit,
+				UpdateTime:   now,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveReplacedTx 当前交易未打包而被替换的交易已打包时，
+// 将发送数据和提币的 tx hash 改为已打包的交易
+func resolveReplacedTx(ctx context.Context, sendRows []*model.DBTSend) error {
+	var sendIDs []int64
+	sendMap := make(map[int64]*model.DBTSend)
+	for _, sendRow := range sendRows {
+		if sendRow.Hex != "" {
+			sendIDs = append(sendIDs, sendRow.ID)
+			sendMap[sendRow.ID] = sendRow
+		}
+	}
+	replaceRows, err := app.SQLSelectTSendReplaceColBySendIDs(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTSendReplaceSendID,
+			model.DBColTSendReplaceTxID,
+		},
+		sendIDs,
+	)
+	if err != nil {
+		return err
+	}
+	// map[当前tx hash] => []被替换的tx hash
+	replacedMap := make(map[string][]string)
+	var currentTxIDs []string
+	for _, replaceRow := range replaceRows {
+		sendRow, ok := sendMap[replaceRow.SendID]
+		if !ok {
+			continue
+		}
+		replacedMap[sendRow.TxID] = append(replacedMap[sendRow.TxID], replaceRow.TxID)
+		if !mcommon.IsStringInSlice(currentTxIDs, sendRow.TxID) {
+			currentTxIDs = append(currentTxIDs, sendRow.TxID)
+		}
+	}
+	for _, currentTxID := range currentTxIDs {
+		isMined, err := isTxMined(ctx, currentTxID)
+		if err != nil {
+			return err
+		}
+		if isMined {
+			continue
+		}
+		minedTxID := ""
+		for _, replacedTxID := range replacedMap[currentTxID] {
+			isMined, err := isTxMined(ctx, replacedTxID)
+			if err != nil {
+				return err
+			}
+			if isMined {
+				minedTxID = replacedTxID
+				break
+			}
+		}
+		if minedTxID == "" {
+			continue
+		}
+		mcommon.Log.Warnf("eth replaced tx mined: %s, current: %s", minedTxID, currentTxID)
+		err = mcommon.DbTransaction(ctx, xenv.DbCon, func(tx mcommon.DbExeAble) error {
+			_, err := app.SQLUpdateTSendTxIDByTxID(
+				ctx,
+				tx,
+				currentTxID,
+				minedTxID,
+			)
+			if err != nil {
+				return err
+			}
+			return updateWithdrawTxHash(ctx, tx, sendRows, currentTxID, minedTxID)
+		})
+		if err != nil {
+			return err
+		}
+		for _, sendRow := range sendRows {
+			if sendRow.TxID == currentTxID {
+				sendRow.TxID = minedTxID
+			}
+		}
+	}
+	return nil
+}
+
+// bumpPrice 按百分比提高单价
+func bumpPrice(price *big.Int, percent int64) *big.Int {
+	v := new(big.Int).Mul(price, big.NewInt(100+percent))
+	v.Add(v, big.NewInt(99))
+	return v.Div(v, big.NewInt(100))
+}
+
+// getReplaceFee 获取替换交易的手续费，单价至少提高 ReplaceBumpPercent，
+// 不超过 maxValue，无法满足节点最低涨幅时返回 nil
+func getReplaceFee(oldTx *types.Transaction, fee *StTxFee, maxValue int64) *StTxFee {
+	newFeeCap := bumpPrice(oldTx.GasFeeCap(), ReplaceBumpPercent)
+	if newFeeCap.Cmp(big.NewInt(fee.GasPrice)) < 0 {
+		newFeeCap.SetInt64(fee.GasPrice)
+	}
+	if maxValue > 0 && newFeeCap.Cmp(big.NewInt(maxValue)) > 0 {
+		newFeeCap.SetInt64(maxValue)
+	}
+	if newFeeCap.Cmp(bumpPrice(oldTx.GasFeeCap(), ReplaceMinBumpPercent)) < 0 {
+		return nil
+	}
+	if fee.MaxFeePerGas == 0 {
+		return &StTxFee{
+			GasPrice: newFeeCap.Int64(),
+		}
+	}
+	newTipCap := bumpPrice(oldTx.GasTipCap(), ReplaceBumpPercent)
+	if newTipCap.Cmp(big.NewInt(fee.MaxPriorityFeePerGas)) < 0 {
+		newTipCap.SetInt64(fee.MaxPriorityFeePerGas)
+	}
+	if newTipCap.Cmp(newFeeCap) > 0 {
+		newTipCap.Set(newFeeCap)
+	}
+	if newTipCap.Cmp(bumpPrice(oldTx.GasTipCap(), ReplaceMinBumpPercent)) < 0 {
+		return nil
+	}
+	return &StTxFee{
+		GasPrice:             newFeeCap.Int64(),
+		MaxFeePerGas:         newFeeCap.Int64(),
+		MaxPriorityFeePerGas: newTipCap.Int64(),
+	}
+}
+
+// replaceStuckTx 发送后长时间未打包的交易使用相同nonce提高单价重新发送
+func replaceStuckTx(ctx context.Context, sendRows []*model.DBTSend) error {
+	replaceSeconds, err := getReplaceSeconds(ctx)
+	if err != nil {
+		return err
+	}
+	if replaceSeconds <= 0 {
+		return nil
+	}
+	now := time.Now().Unix()
+	var stuckRows []*model.DBTSend
+	for _, sendRow := range sendRows {
+		if sendRow.Hex != "" && now-sendRow.HandleTime >= replaceSeconds {
+			stuckRows = append(stuckRows, sendRow)
+		}
+	}
+	if len(stuckRows) == 0 {
+		return nil
+	}
+	gasPriceValue, err := app.SQLGetTAppStatusIntValueByK(
+		ctx,
+		xenv.DbCon,
+		chainKey(ctx, "to_user_gas_price_eth"),
+	)
+	if err != nil {
+		return err
+	}
+	fee, err := GetTxFee(ctx, gasPriceValue)
+	if err != nil {
+		return err
+	}
+	maxValue, err := app.SQLGetTAppStatusIntValueByK(
+		ctx,
+		xenv.DbCon,
+		chainKey(ctx, "max_gas_price_eth"),
+	)
+	if err != nil {
+		if !strings.Contains(err.Error(), "no app status int of") {
+			return err
+		}
+	}
+	chainID, err := ethclient.RPCNetworkID(ctx)
+	if err != nil {
+		return err
+	}
+	for _, sendRow := range stuckRows {
+		isMined, err := isTxMined(ctx, sendRow.TxID)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			continue
+		}
+		if isMined {
+			continue
+		}
+		rawTxBytes, err := hex.DecodeString(sendRow.Hex)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			continue
+		}
+		oldTx := new(types.Transaction)
+		err = oldTx.UnmarshalBinary(rawTxBytes)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			continue
+		}
+		newFee := getReplaceFee(oldTx, fee, maxValue)
+		if newFee == nil {
+			mcommon.Log.Warnf("eth tx %s gas price reach max: %d", sendRow.TxID, maxValue)
+			continue
+		}
+		gasLimit := int64(oldTx.Gas())
+		value := oldTx.Value()
+		isOrgAll := sendRow.RelatedType == app.SendRelationTypeTx && value.Sign() > 0
+		if isOrgAll {
+			// 零钱整理转出全部余额，增加的手续费从转账金额中扣除
+			addFee := new(big.Int).Sub(big.NewInt(newFee.GasPrice), oldTx.GasFeeCap())
+			addFee.Mul(addFee, big.NewInt(gasLimit))
+			value = new(big.Int).Sub(value, addFee)
+			if value.Sign() <= 0 {
+				mcommon.Log.Warnf("eth tx %s balance not enough for replace", sendRow.TxID)
+				continue
+			}
+		} else {
+			balance, err := ethclient.RPCBalanceAt(ctx, sendRow.FromAddress)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				continue
+			}
+			needBalance := new(big.Int).Mul(big.NewInt(newFee.GasPrice), big.NewInt(gasLimit))
+			needBalance.Add(needBalance, value)
+			if balance.Cmp(needBalance) < 0 {
+				mcommon.Log.Warnf("eth tx %s balance not enough for replace", sendRow.TxID)
+				continue
+			}
+		}
+		balanceReal := sendRow.BalanceReal
+		if isOrgAll {
+			balanceReal, err = WeiBigIntToEthStr(value)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				continue
+			}
+		}
+		privateKey, err := GetPkOfAddress(ctx, xenv.DbCon, sendRow.FromAddress)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			continue
+		}
+		signedTx, rawTxHex, err := SignTx(
+			chainID,
+			sendRow.Nonce,
+			*oldTx.To(),
+			value,
+			gasLimit,
+			newFee,
+			oldTx.Data(),
+			privateKey,
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			continue
+		}
+		txHash := strings.ToLower(signedTx.Hash().Hex())
+		// 先记录替换再发送，发送失败时被替换的交易仍然可以被识别
+		err = mcommon.DbTransaction(ctx, xenv.DbCon, func(tx mcommon.DbExeAble) error {
+			_, err := model.SQLCreateTSendReplace(
+				ctx,
+				tx,
+				&model.DBTSendReplace{
+					SendID:               sendRow.ID,
+					TxID:                 sendRow.TxID,
+					ReplaceTxID:          txHash,
+					Nonce:                sendRow.Nonce,
+					GasPrice:             sendRow.GasPrice,
+					MaxPriorityFeePerGas: sendRow.MaxPriorityFeePerGas,
+					CreateTime:           now,
+				},
+				false,
+			)
+			if err != nil {
+				return err
+			}
+			_, err = app.SQLUpdateTSendTxIDByTxID(
+				ctx,
+				tx,
+				sendRow.TxID,
+				txHash,
+			)
+			if err != nil {
+				return err
+			}
+			_, err = app.SQLUpdateTSendReplaceByID(
+				ctx,
+				tx,
+				&model.DBTSend{
+					ID:                   sendRow.ID,
+					BalanceReal:          balanceReal,
+					GasPrice:             newFee.GasPrice,
+					MaxFeePerGas:         newFee.MaxFeePerGas,
+					MaxPriorityFeePerGas: newFee.MaxPriorityFeePerGas,
+					Hex:                  rawTxHex,
+					HandleMsg:            "replaced",
+					HandleTime:           now,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return updateWithdrawTxHash(ctx, tx, sendRows, sendRow.TxID, txHash)
+		})
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			continue
+		}
+		mcommon.Log.Warnf("eth tx replaced: %s => %s, nonce: %d", sendRow.TxID, txHash, sendRow.Nonce)
+		err = ethclient.RPCSendTransaction(ctx, signedTx)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			continue
+		}
+		app.JobAddCount(ctx, 1)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package heth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"strings"
+	"time"
+
+	"github.com/moremorefun/mcommon"
+	"github.com/shopspring/decimal"
+)
+
+// token 配置错误
+var (
+	ErrTokenNoCode = errors.New("token address has no contract code")
+	ErrTokenExist  = errors.New("token address or symbol exist")
+	// ErrColdAddressEmpty 冷钱包地址为空或为零地址
+	ErrColdAddressEmpty = errors.New("cold address empty or zero")
+)
+
+// GetTokenInfo 获取erc20 token的链上信息，地址没有合约代码时返回 ErrTokenNoCode
+func GetTokenInfo(ctx context.Context, tokenAddress string) (*ethclient.StTokenInfo, error) {
+	_, err := StrToAddressBytes(tokenAddress)
+	if err != nil {
+		return nil, err
+	}
+	code, err := ethclient.RPCCodeAt(ctx, tokenAddress)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrTokenNoCode, tokenAddress)
+	}
+	return ethclient.RPCTokenInfo(ctx, tokenAddress)
+}
+
+// StTokenAdd 添加erc20 token的配置
+type StTokenAdd struct {
+	TokenAddress string
+	// TokenSymbol 为空时使用 erc20_ 加链上符号，其他evm链再加链名称前缀
+	TokenSymbol   string
+	ColdAddress   string
+	HotAddress    string
+	OrgMinBalance string
+	// OrgRealBalance 1 整理时以链上余额为准
+	OrgRealBalance int64
+}
+
+// AddToken 根据链上的精度和符号添加erc20 token配置
+func AddToken(ctx context.Context, req *StTokenAdd) (*model.DBTAppConfigToken, *ethclient.StTokenInfo, error) {
+	tokenAddress := strings.ToLower(strings.TrimSpace(req.TokenAddress))
+	info, err := GetTokenInfo(ctx, tokenAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	tokenSymbol := strings.TrimSpace(req.TokenSymbol)
+	if tokenSymbol == "" {
+		tokenSymbol = chainKey(ctx, "erc20_"+strings.ToLower(strings.TrimSpace(info.Symbol)))
+	}
+	coldAddress := strings.ToLower(strings.TrimSpace(req.ColdAddress))
+	err = checkColdAddress(coldAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	hotAddress := ""
+	if strings.TrimSpace(req.HotAddress) != "" {
+		hotAddresses, err := ParseHotAddresses(req.HotAddress)
+		if err != nil {
+			return nil, nil, err
+		}
+		hotAddress = strings.Join(hotAddresses, ",")
+	}
+	orgMinBalance := "0"
+	if req.OrgMinBalance != "" {
+		orgMinBalanceValue, err := decimal.NewFromString(req.OrgMinBalance)
+		if err != nil {
+			return nil, nil, err
+		}
+		orgMinBalance = orgMinBalanceValue.String()
+	}
+	tokenRow := &model.DBTAppConfigToken{
+		Chain:          chainName(ctx),
+		TokenAddress:   tokenAddress,
+		TokenDecimals:  info.Decimals,
+		TokenSymbol:    tokenSymbol,
+		ColdAddress:    coldAddress,
+		HotAddress:     hotAddress,
+		OrgMinBalance:  orgMinBalance,
+		OrgRealBalance: req.OrgRealBalance,
+		CreateTime:     time.Now().Unix(),
+	}
+	err = mcommon.DbTransaction(ctx, xenv.DbCon, func(tx mcommon.DbExeAble) error {
+		// symbol 用于区分提币和余额，不能重复
+		existRow, err := model.SQLGetTAppConfigTokenColKV(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTAppConfigTokenID,
+			},
+			[]string{
+				model.DBColTAppConfigTokenTokenSymbol,
+			},
+			[]interface{}{
+				tokenSymbol,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if existRow != nil {
+			return fmt.Errorf("%w: %s", ErrTokenExist, tokenSymbol)
+		}
+		lastID, err := model.SQLCreateTAppConfigToken(
+			ctx,
+			tx,
+			tokenRow,
+			true,
+		)
+		if err != nil {
+			return err
+		}
+		if lastID <= 0 {
+			return fmt.Errorf("%w: %s", ErrTokenExist, tokenAddress)
+		}
+		tokenRow.ID = lastID
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return tokenRow, info, nil
+}
+
+// CheckTokens 校验已配置的erc20 token，地址错误、合约不存在或精度与链上不一致时返回错误，
+// 节点请求失败时只记录日志，不影响其他链和任务的启动
+func CheckTokens(ctx context.Context) error {
+	tokenRows, err := app.SQLSelectTAppConfigTokenColAll(
+		ctx,
+		xenv.DbCon,
+		[]string{
+			model.DBColTAppConfigTokenTokenAddress,
+			model.DBColTAppConfigTokenTokenDecimals,
+			model.DBColTAppConfigTokenTokenSymbol,
+		},
+		chainName(ctx),
+	)
+	if err != nil {
+		return err
+	}
+	var errMsgs []string
+	for _, tokenRow := range tokenRows {
+		_, err = StrToAddressBytes(tokenRow.TokenAddress)
+		if err != nil {
+			errMsgs = append(errMsgs, fmt.Sprintf("%s %s: %s", tokenRow.TokenSymbol, tokenRow.TokenAddress, err.Error()))
+			continue
+		}
+		info, err := GetTokenInfo(ctx, tokenRow.TokenAddress)
+		if errors.Is(err, ErrTokenNoCode) {
+			errMsgs = append(errMsgs, fmt.Sprintf("%s %s: %s", tokenRow.TokenSymbol, tokenRow.TokenAddress, err.Error()))
+			continue
+		}
+		if err != nil {
+			mcommon.Log.Warnf("check token %s %s skipped: [%T] %s", tokenRow.TokenSymbol, tokenRow.TokenAddress, err, err.Error())
+			continue
+		}
+		if info.Decimals != tokenRow.TokenDecimals {
+			errMsgs = append(errMsgs, fmt.Sprintf("%s %s: token_decimals %d, contract decimals %d", tokenRow.TokenSymbol, tokenRow.TokenAddress, tokenRow.TokenDecimals, info.Decimals))
+			continue
+		}
+		if !strings.Contains(strings.ToLower(tokenRow.TokenSymbol), strings.ToLower(info.Symbol)) {
+			mcommon.Log.Warnf("token symbol not match: %s %s contract symbol %s", tokenRow.TokenSymbol, tokenRow.TokenAddress, info.Symbol)
+		}
+	}
+	if len(errMsgs) > 0 {
+		return fmt.Errorf("token config error: %s", strings.Join(errMsgs, "; "))
+	}
+	return nil
+}
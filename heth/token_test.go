@@ -0,0 +1,64 @@
+package heth
+
+import (
+	"go-dc-wallet/xenv"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+)
+
+// mockTokenRows t_app_config_token 中配置的token
+func mockTokenRows(t *testing.T, tokenAddress string) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock err: %s", err.Error())
+	}
+	oldDbCon := xenv.DbCon
+	xenv.DbCon = sqlx.NewDb(db, "mysql")
+	t.Cleanup(func() {
+		xenv.DbCon = oldDbCon
+		_ = db.Close()
+	})
+	mock.ExpectQuery("FROM t_app_config_token").
+		WillReturnRows(sqlmock.NewRows([]string{"token_address", "token_decimals", "token_symbol"}).AddRow(tokenAddress, 6, "erc20_usdt"))
+}
+
+func TestCheckTokensNoCode(t *testing.T) {
+	var calls []string
+	ctx := newFakeTraceChain(t, "tokennocode", map[string]string{
+		"eth_getCode": `"0x"`,
+	}, &calls)
+	mockTokenRows(t, "0xdac17f958d2ee523a2206206994597c13d831ec7")
+	err := CheckTokens(ctx)
+	if err == nil {
+		t.Fatalf("token without code should fail")
+	}
+}
+
+func TestCheckTokensAddressWrong(t *testing.T) {
+	var calls []string
+	ctx := newFakeTraceChain(t, "tokenaddress", nil, &calls)
+	mockTokenRows(t, "0x123")
+	err := CheckTokens(ctx)
+	if err == nil {
+		t.Fatalf("wrong token address should fail")
+	}
+	if len(calls) != 0 {
+		t.Fatalf("unexpected rpc calls: %v", calls)
+	}
+}
+
+func TestCheckTokensRPCError(t *testing.T) {
+	var calls []string
+	// 节点不可用时跳过校验
+	ctx := newFakeTraceChain(t, "tokenrpcerr", nil, &calls)
+	mockTokenRows(t, "0xdac17f958d2ee523a2206206994597c13d831ec7")
+	err := CheckTokens(ctx)
+	if err != nil {
+		t.Fatalf("rpc error should not fail: %s", err.Error())
+	}
+	if len(calls) == 0 {
+		t.Fatalf("token code not requested")
+	}
+}
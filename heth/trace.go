@@ -0,0 +1,52 @@
+package heth
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/model"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// stSeekBlock 预取的区块和其中的内部转账
+type stSeekBlock struct {
+	block     *types.Block
+	transfers []*ethclient.StInternalTransfer
+}
+
+// getTraceMethod 获取内部转账检测使用的接口，为空时不检测
+func getTraceMethod(ctx context.Context) (string, error) {
+	return getConfigStr(ctx, "eth_trace_method")
+}
+
+// fetchSeekBlock 获取区块，traceMethod 不为空时同时获取内部转账
+func fetchSeekBlock(ctx context.Context, num int64, traceMethod string) (*stSeekBlock, error) {
+	rpcBlock, err := ethclient.RPCBlockByNum(ctx, num)
+	if err != nil {
+		return nil, err
+	}
+	seekBlock := &stSeekBlock{
+		block: rpcBlock,
+	}
+	if traceMethod == "" {
+		return seekBlock, nil
+	}
+	var txHashes []string
+	for _, rpcTx := range rpcBlock.Transactions() {
+		txHashes = append(txHashes, rpcTx.Hash().Hex())
+	}
+	seekBlock.transfers, err = ethclient.RPCTraceBlockTransfers(ctx, traceMethod, num, txHashes)
+	if err != nil {
+		return nil, err
+	}
+	return seekBlock, nil
+}
+
+// GetTxNotifyHash 获取充币通知中的交易标示，合约内部转账附加调用位置
+func GetTxNotifyHash(txRow *model.DBTTx) string {
+	if txRow.TraceAddress == "" {
+		return txRow.TxID
+	}
+	return fmt.Sprintf("%s_%s", txRow.TxID, txRow.TraceAddress)
+}
@@ -0,0 +1,140 @@
+package heth
+
+import (
+	"context"
+	"encoding/json"
+	"go-dc-wallet/ethclient"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// fakeTraceBlock 生成包含一笔交易的区块数据
+func fakeTraceBlock(t *testing.T) (string, *types.Transaction) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key err: %s", err.Error())
+	}
+	toAddress := common.HexToAddress("0xc0")
+	tx, err := types.SignTx(
+		types.NewTransaction(0, toAddress, big.NewInt(1), 100000, big.NewInt(1), nil),
+		types.HomesteadSigner{},
+		key,
+	)
+	if err != nil {
+		t.Fatalf("sign tx err: %s", err.Error())
+	}
+	header := &types.Header{
+		ParentHash: common.HexToHash("0x01"),
+		UncleHash:  types.EmptyUncleHash,
+		TxHash:     common.HexToHash("0x02"),
+		Difficulty: big.NewInt(1),
+		Number:     big.NewInt(100),
+		GasLimit:   8000000,
+		Time:       1600000000,
+	}
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		t.Fatalf("marshal header err: %s", err.Error())
+	}
+	txJSON, err := json.Marshal(tx)
+	if err != nil {
+		t.Fatalf("marshal tx err: %s", err.Error())
+	}
+	blockJSON := strings.TrimSuffix(string(headerJSON), "}") + `,"transactions":[` + string(txJSON) + `],"uncles":[]}`
+	return blockJSON, tx
+}
+
+// newFakeTraceChain 启动模拟节点并作为一条链初始化，返回该链的 ctx
+func newFakeTraceChain(t *testing.T, name string, results map[string]string, calls *[]string) context.Context {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		*calls = append(*calls, req.Method)
+		w.Header().Set("Content-Type", "application/json")
+		result, ok := results[req.Method]
+		if !ok {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"error":{"code":-32601,"message":"method not found"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":` + result + `}`))
+	}))
+	t.Cleanup(server.Close)
+	err := ethclient.InitChainClient(name, server.URL, 0)
+	if err != nil {
+		t.Fatalf("init chain client err: %s", err.Error())
+	}
+	return ethclient.WithChain(context.Background(), name)
+}
+
+func TestFetchSeekBlockDebugTrace(t *testing.T) {
+	blockJSON, tx := fakeTraceBlock(t)
+	var calls []string
+	ctx := newFakeTraceChain(
+		t,
+		"tracedebug",
+		map[string]string{
+			"eth_getBlockByNumber": blockJSON,
+			// 旧版本节点不返回 txHash，按区块中的交易顺序对应
+			ethclient.TraceMethodDebugTraceBlock: `[{"result":{"type":"CALL","from":"0xa0","to":"0xc0","value":"0x1","calls":[
+				{"type":"CALL","from":"0xc0","to":"0xd1","value":"0x0"},
+				{"type":"CALL","from":"0xc0","to":"0xd2","value":"0x2","error":"execution reverted"},
+				{"type":"CALL","from":"0xc0","to":"0xd3","value":"0x3"}
+			]}}]`,
+		},
+		&calls,
+	)
+	seekBlock, err := fetchSeekBlock(ctx, 100, ethclient.TraceMethodDebugTraceBlock)
+	if err != nil {
+		t.Fatalf("fetch seek block err: %s", err.Error())
+	}
+	if len(seekBlock.block.Transactions()) != 1 {
+		t.Fatalf("block tx count: %d", len(seekBlock.block.Transactions()))
+	}
+	if len(seekBlock.transfers) != 1 {
+		t.Fatalf("transfers count: %d", len(seekBlock.transfers))
+	}
+	transfer := seekBlock.transfers[0]
+	if transfer.TxHash != tx.Hash().Hex() || transfer.TraceAddress != "2" || transfer.To != "0xd3" || transfer.Value.Int64() != 3 {
+		t.Fatalf("transfer: %+v", transfer)
+	}
+}
+
+func TestFetchSeekBlockNoTrace(t *testing.T) {
+	blockJSON, _ := fakeTraceBlock(t)
+	var calls []string
+	ctx := newFakeTraceChain(
+		t,
+		"tracenone",
+		map[string]string{
+			"eth_getBlockByNumber": blockJSON,
+		},
+		&calls,
+	)
+	// 未配置 trace 接口时不请求
+	seekBlock, err := fetchSeekBlock(ctx, 100, "")
+	if err != nil {
+		t.Fatalf("fetch seek block err: %s", err.Error())
+	}
+	if len(seekBlock.transfers) != 0 {
+		t.Fatalf("transfers count: %d", len(seekBlock.transfers))
+	}
+	for _, call := range calls {
+		if call != "eth_getBlockByNumber" {
+			t.Fatalf("unexpected rpc call: %s", call)
+		}
+	}
+}
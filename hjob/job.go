@@ -0,0 +1,378 @@
+package hjob
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/heth"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/moremorefun/mcommon"
+	"github.com/robfig/cron/v3"
+)
+
+// 任务所属链
+const (
+	ChainCommon = "common"
+	ChainEth    = "eth"
+	ChainBtc    = "btc"
+	ChainEos    = "eos"
+)
+
+// StJob 定时任务
+type StJob struct {
+	Name  string
+	Chain string
+	Spec  string
+	Func  func(ctx context.Context)
+	// IsPushed 返回true时由推送触发运行，定时任务跳过
+	IsPushed func() bool
+	// EvmChain 其他evm链的任务所属的链名称，默认链为空
+	EvmChain string
+}
+
+// StJobConfig 任务运行配置
+type StJobConfig struct {
+	Spec           string
+	Enable         bool
+	TimeoutSeconds int64
+}
+
+var jobs []*StJob
+
+// Register 注册定时任务
+func Register(name string, chain string, spec string, f func(ctx context.Context)) {
+	if GetJob(name) != nil {
+		mcommon.Log.Fatalf("job already registered: %s", name)
+	}
+	jobs = append(jobs, &StJob{
+		Name:  name,
+		Chain: chain,
+		Spec:  spec,
+		Func:  f,
+	})
+}
+
+// GetJobs 获取所有任务
+func GetJobs() []*StJob {
+	return jobs
+}
+
+// GetJob 根据名称获取任务
+func GetJob(name string) *StJob {
+	for _, job := range jobs {
+		if job.Name == name {
+			return job
+		}
+	}
+	return nil
+}
+
+// ChainJobName 其他evm链的任务名，替换 eth_ 前缀或添加链名称前缀
+// 如 eth_block_seek => bsc_block_seek，erc20_tx_org => bsc_erc20_tx_org
+func ChainJobName(chain string, name string) string {
+	return chain + "_" + strings.TrimPrefix(name, "eth_")
+}
+
+// InitChainJobs 加载 t_app_config_chain 中开启的evm链，并为每条链注册一组 eth 任务，
+// 其他链的任务不使用新区块推送，定时轮询
+func InitChainJobs(ctx context.Context) error {
+	if !IsChainEnable(ChainEth) {
+		return nil
+	}
+	err := heth.InitChains(ctx)
+	if err != nil {
+		return err
+	}
+	var chainJobs []*StJob
+	for _, chain := range heth.GetChains() {
+		if chain.Name == ethclient.DefaultChain {
+			continue
+		}
+		for _, job := range jobs {
+			if job.Chain != ChainEth || job.EvmChain != "" {
+				continue
+			}
+			name := ChainJobName(chain.Name, job.Name)
+			if GetJob(name) != nil {
+				continue
+			}
+			chainName := chain.Name
+			f := job.Func
+			chainJobs = append(chainJobs, &StJob{
+				Name:  name,
+				Chain: ChainEth,
+				Spec:  job.Spec,
+				Func: func(ctx context.Context) {
+					f(ethclient.WithChain(ctx, chainName))
+				},
+				EvmChain: chainName,
+			})
+		}
+	}
+	jobs = append(jobs, chainJobs...)
+	return nil
+}
+
+// IsChainEnable 链是否开启
+func IsChainEnable(chain string) bool {
+	switch chain {
+	case ChainCommon:
+		return true
+	case ChainEth:
+		return xenv.Cfg.EthEnable
+	case ChainBtc:
+		return xenv.Cfg.BtcEnable
+	case ChainEos:
+		return xenv.Cfg.EosEnable
+	}
+	return false
+}
+
+// GetJobConfigMap 获取任务配置，t_app_job 中没有记录时使用默认值
+func GetJobConfigMap(ctx context.Context, tx mcommon.DbExeAble) (map[string]*StJobConfig, error) {
+	jobRows, err := model.SQLSelectTAppJobColKV(
+		ctx,
+		tx,
+		model.DBColTAppJobAll,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	jobRowMap := make(map[string]*model.DBTAppJob)
+	for _, jobRow := range jobRows {
+		jobRowMap[jobRow.Name] = jobRow
+	}
+	configMap := make(map[string]*StJobConfig)
+	for _, job := range jobs {
+		config := &StJobConfig{
+			Spec:   job.Spec,
+			Enable: true,
+		}
+		jobRow, ok := jobRowMap[job.Name]
+		if ok {
+			if jobRow.Spec != "" {
+				config.Spec = jobRow.Spec
+			}
+			config.Enable = jobRow.Enable == 1
+			config.TimeoutSeconds = jobRow.TimeoutSeconds
+		}
+		configMap[job.Name] = config
+	}
+	return configMap, nil
+}
+
+// RunJob 运行任务并记录运行结果，配置了超时时间时超时后取消 ctx
+func RunJob(ctx context.Context, job *StJob, config *StJobConfig) {
+	if config.TimeoutSeconds > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Second*time.Duration(config.TimeoutSeconds))
+		defer cancel()
+	}
+	ctx, run := app.WithJobRun(ctx)
+	startTime := time.Now()
+	job.Func(ctx)
+	endTime := time.Now()
+	if ctx.Err() == context.DeadlineExceeded {
+		mcommon.Log.Errorf("job %s timeout, running %s", job.Name, endTime.Sub(startTime))
+		run.AddErrMsg("timeout")
+	} else if ctx.Err() == context.Canceled {
+		run.AddErrMsg("canceled")
+	}
+	handleCount, errMsg, lockSkipped := run.Result()
+	var lockSkippedValue int64
+	if lockSkipped {
+		lockSkippedValue = 1
+	}
+	_, err := model.SQLCreateTAppJobRun(
+		context.Background(),
+		xenv.DbCon,
+		&model.DBTAppJobRun{
+			Name:        job.Name,
+			Instance:    app.InstanceID,
+			StartTime:   startTime.Unix(),
+			EndTime:     endTime.Unix(),
+			CostMs:      endTime.Sub(startTime).Milliseconds(),
+			HandleCount: handleCount,
+			LockSkipped: lockSkippedValue,
+			ErrMsg:      errMsg,
+		},
+		false,
+	)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+	}
+}
+
+// blockSeekDependJobs 只检测 eth_block_seek 已记录区块hash的区块的任务
+var blockSeekDependJobs = []string{"erc20_block_seek", "nft_block_seek"}
+
+// checkBlockSeekJobs erc20 和 nft 充币检测不超过 eth_block_seek 的进度，
+// 关闭 eth_block_seek 时不再检测新区块，拒绝开启这些任务而关闭 eth_block_seek 的配置
+func checkBlockSeekJobs(configMap map[string]*StJobConfig) error {
+	for _, job := range jobs {
+		if job.Chain != ChainEth {
+			continue
+		}
+		for _, name := range blockSeekDependJobs {
+			seekName := "eth_block_seek"
+			if job.EvmChain != "" {
+				name = ChainJobName(job.EvmChain, name)
+				seekName = ChainJobName(job.EvmChain, seekName)
+			}
+			if job.Name != name {
+				continue
+			}
+			config, ok := configMap[job.Name]
+			if !ok || !config.Enable {
+				continue
+			}
+			seekConfig, ok := configMap[seekName]
+			if ok && !seekConfig.Enable {
+				return fmt.Errorf("job %s depends on %s, which is disabled", job.Name, seekName)
+			}
+		}
+	}
+	return nil
+}
+
+// NewCron 根据任务配置创建定时器，chains 为空时添加所有开启的链的任务
+// 任务运行时使用 ctx，ctx 取消后任务应尽快退出
+func NewCron(ctx context.Context, tx mcommon.DbExeAble, chains []string) (*cron.Cron, error) {
+	configMap, err := GetJobConfigMap(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	if IsChainEnable(ChainEth) && (len(chains) == 0 || mcommon.IsStringInSlice(chains, ChainEth)) {
+		err = checkBlockSeekJobs(configMap)
+		if err != nil {
+			return nil, err
+		}
+	}
+	c := cron.New(
+		cron.WithSeconds(),
+		cron.WithChain(
+			cron.Recover(cron.DefaultLogger),
+		),
+	)
+	for _, job := range jobs {
+		if len(chains) > 0 && !mcommon.IsStringInSlice(chains, job.Chain) {
+			continue
+		}
+		if !IsChainEnable(job.Chain) {
+			continue
+		}
+		config := configMap[job.Name]
+		if !config.Enable {
+			mcommon.Log.Infof("job disabled: %s", job.Name)
+			continue
+		}
+		job := job
+		_, err = c.AddFunc(config.Spec, func() {
+			if job.IsPushed != nil && job.IsPushed() {
+				return
+			}
+			RunJob(ctx, job, config)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("job %s spec %s: %w", job.Name, config.Spec, err)
+		}
+	}
+	return c, nil
+}
+
+// checkChainConfig 启动时校验运行链的配置，
+// eth 检测 t_app_config_token 的精度是否与合约一致，避免金额换算错误
+func checkChainConfig(ctx context.Context, chains []string) error {
+	if len(chains) > 0 && !mcommon.IsStringInSlice(chains, ChainEth) {
+		return nil
+	}
+	if !IsChainEnable(ChainEth) {
+		return nil
+	}
+	for _, chain := range heth.GetChains() {
+		err := heth.CheckTokens(ethclient.WithChain(ctx, chain.Name))
+		if err != nil {
+			return fmt.Errorf("chain %s: %w", chain.Name, err)
+		}
+	}
+	return nil
+}
+
+// RunCron 运行定时任务直到收到 SIGINT 或 SIGTERM
+// 收到信号后不再启动新任务，等待运行中的任务在 shutdownSeconds 内完成，
+// 超时后取消任务的 ctx，最后释放仍被持有的任务锁
+func RunCron(chains []string, shutdownSeconds int64) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// common 中的报警任务同样需要检测其他evm链
+	if len(chains) == 0 || mcommon.IsStringInSlice(chains, ChainEth) || mcommon.IsStringInSlice(chains, ChainCommon) {
+		err := InitChainJobs(ctx)
+		if err != nil {
+			mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+		}
+	}
+	err := checkChainConfig(ctx, chains)
+	if err != nil {
+		mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+	}
+	c, err := NewCron(
+		ctx,
+		xenv.DbCon,
+		chains,
+	)
+	if err != nil {
+		mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+	}
+	c.Start()
+	watchCtx, watchCancel := context.WithCancel(ctx)
+	defer watchCancel()
+	watchWg, err := startWatchers(
+		watchCtx,
+		ctx,
+		chains,
+	)
+	if err != nil {
+		mcommon.Log.Fatalf("err: [%T] %s", err, err.Error())
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	sig := <-sigCh
+	mcommon.Log.Infof("receive signal %s, waiting running jobs", sig)
+
+	// 停止定时和推送触发，等待运行中的任务
+	stopCtx := c.Stop()
+	watchCancel()
+	doneCh := make(chan struct{})
+	go func() {
+		<-stopCtx.Done()
+		watchWg.Wait()
+		close(doneCh)
+	}()
+	select {
+	case <-doneCh:
+	case <-time.After(time.Second * time.Duration(shutdownSeconds)):
+		mcommon.Log.Warnf("running jobs not finished in %ds, cancel them", shutdownSeconds)
+		cancel()
+		select {
+		case <-doneCh:
+		case <-time.After(time.Second * 10):
+			mcommon.Log.Errorf("running jobs not finished after cancel")
+		}
+	}
+	app.ReleaseHeldLocks()
+	mcommon.Log.Infof("cron stopped")
+}
@@ -0,0 +1,29 @@
+package hjob
+
+import "testing"
+
+func TestCheckBlockSeekJobs(t *testing.T) {
+	configMap := make(map[string]*StJobConfig)
+	for _, job := range GetJobs() {
+		configMap[job.Name] = &StJobConfig{
+			Spec:   job.Spec,
+			Enable: true,
+		}
+	}
+	err := checkBlockSeekJobs(configMap)
+	if err != nil {
+		t.Fatalf("all jobs enabled err: %s", err.Error())
+	}
+	// 停用 eth 检测时 erc20 和 nft 检测无法推进
+	configMap["eth_block_seek"].Enable = false
+	err = checkBlockSeekJobs(configMap)
+	if err == nil {
+		t.Fatalf("erc20 seek without eth seek should fail")
+	}
+	configMap["erc20_block_seek"].Enable = false
+	configMap["nft_block_seek"].Enable = false
+	err = checkBlockSeekJobs(configMap)
+	if err != nil {
+		t.Fatalf("token seek disabled err: %s", err.Error())
+	}
+}
@@ -0,0 +1,109 @@
+package hjob
+
+import (
+	"go-dc-wallet/app"
+	"go-dc-wallet/halert"
+	"go-dc-wallet/hbtc"
+	"go-dc-wallet/heos"
+	"go-dc-wallet/heth"
+)
+
+func init() {
+	// --- common ---
+	// 检测 通知发送
+	Register("do_notify", ChainCommon, "@every 1m", app.CheckDoNotify)
+	// 检测 报警
+	Register("alert", ChainCommon, "@every 1m", halert.CheckAlert)
+	// 清理 任务运行记录
+	Register("job_run_clean", ChainCommon, "@every 1h", CheckJobRunClean)
+
+	// --- eth ---
+	// 检测 eth 生成地址
+	Register("eth_address_free", ChainEth, "@every 1m", heth.CheckAddressFree)
+	// 检测 eth 冲币
+	Register("eth_block_seek", ChainEth, "@every 5s", heth.CheckBlockSeek)
+	// 检测 eth 零钱整理
+	Register("eth_address_org", ChainEth, "@every 10m", heth.CheckAddressOrg)
+	// 检测 eth 提币
+	Register("eth_withdraw", ChainEth, "@every 3m", heth.CheckWithdraw)
+	// 检测 eth 发送交易
+	Register("eth_raw_tx_send", ChainEth, "@every 1m", heth.CheckRawTxSend)
+	// 检测 eth 交易上链
+	Register("eth_raw_tx_confirm", ChainEth, "@every 5s", heth.CheckRawTxConfirm)
+	// 检测 eth nonce 空缺
+	Register("eth_nonce_check", ChainEth, "@every 1m", heth.CheckNonceGap)
+	// 检测 eth 通知到账
+	Register("eth_tx_notify", ChainEth, "@every 5s", heth.CheckTxNotify)
+	// 检测 eth gas price
+	Register("eth_gas_price", ChainEth, "@every 2m", heth.CheckGasPrice)
+
+	// --- erc20 ---
+	// 检测 erc20 冲币
+	Register("erc20_block_seek", ChainEth, "@every 5s", heth.CheckErc20BlockSeek)
+	// 检测 erc20 通知到账
+	Register("erc20_tx_notify", ChainEth, "@every 5s", heth.CheckErc20TxNotify)
+	// 检测 erc20 零钱整理
+	Register("erc20_tx_org", ChainEth, "@every 10m", heth.CheckErc20TxOrg)
+	// 检测 erc20 提币
+	Register("erc20_withdraw", ChainEth, "@every 3m", heth.CheckErc20Withdraw)
+
+	// --- nft ---
+	// 检测 erc721 erc1155 冲币
+	Register("nft_block_seek", ChainEth, "@every 5s", heth.CheckNftBlockSeek)
+	// 检测 nft 通知到账
+	Register("nft_tx_notify", ChainEth, "@every 5s", heth.CheckNftTxNotify)
+	// 检测 nft 零钱整理
+	Register("nft_tx_org", ChainEth, "@every 10m", heth.CheckNftTxOrg)
+	// 检测 nft 提币
+	Register("nft_withdraw", ChainEth, "@every 3m", heth.CheckNftWithdraw)
+
+	// --- btc ---
+	// 检测 btc 生成地址
+	Register("btc_address_free", ChainBtc, "@every 1m", hbtc.CheckAddressFree)
+	// 检测 btc 冲币
+	Register("btc_block_seek", ChainBtc, "@every 5m", hbtc.CheckBlockSeek)
+	// 检测 btc hot and fee uxto
+	Register("btc_block_seek_hot_fee", ChainBtc, "@every 5m", hbtc.CheckBlockSeekHotAndFee)
+	// 检测 btc 零钱整理
+	Register("btc_tx_org", ChainBtc, "@every 10m", hbtc.CheckTxOrg)
+	// 检测 btc 提币
+	Register("btc_withdraw", ChainBtc, "@every 3m", hbtc.CheckWithdraw)
+	// 检测 btc 发送交易
+	Register("btc_raw_tx_send", ChainBtc, "@every 1m", hbtc.CheckRawTxSend)
+	// 检测 btc 交易上链
+	Register("btc_raw_tx_confirm", ChainBtc, "@every 5m", hbtc.CheckRawTxConfirm)
+	// 检测 btc 通知到账
+	Register("btc_tx_notify", ChainBtc, "@every 5s", hbtc.CheckTxNotify)
+	// 检测 btc 手续费
+	Register("btc_gas_price", ChainBtc, "@every 5m", hbtc.CheckGasPrice)
+
+	// --- omni ---
+	// 检测 omni 冲币
+	Register("omni_block_seek", ChainBtc, "@every 5m", hbtc.OmniCheckBlockSeek)
+	// 检测 omni 零钱整理
+	Register("omni_tx_org", ChainBtc, "@every 10m", hbtc.OmniCheckTxOrg)
+	// 检测 omni 提币
+	Register("omni_withdraw", ChainBtc, "@every 3m", hbtc.OmniCheckWithdraw)
+	// 检测 omni 通知到账
+	Register("omni_tx_notify", ChainBtc, "@every 5s", hbtc.OmniCheckTxNotify)
+
+	// --- eos ---
+	// 检测 eos 生成地址
+	Register("eos_address_free", ChainEos, "@every 1m", heos.CheckAddressFree)
+	// 检测 eos 冲币
+	Register("eos_block_seek", ChainEos, "@every 3s", heos.CheckBlockSeek)
+	// 检测 eos 提币
+	Register("eos_withdraw", ChainEos, "@every 3m", heos.CheckWithdraw)
+	// 检测 eos 发送交易
+	Register("eos_raw_tx_send", ChainEos, "@every 1s", heos.CheckRawTxSend)
+	// 检测 eos 交易上链
+	Register("eos_raw_tx_confirm", ChainEos, "@every 3s", heos.CheckRawTxConfirm)
+	// 检测 eos 通知到账
+	Register("eos_tx_notify", ChainEos, "@every 3s", heos.CheckTxNotify)
+
+	// --- push ---
+	// 新区块订阅正常时由推送触发检测 eth erc20 nft 冲币
+	GetJob("eth_block_seek").IsPushed = heth.IsNewHeadActive
+	GetJob("erc20_block_seek").IsPushed = heth.IsNewHeadActive
+	GetJob("nft_block_seek").IsPushed = heth.IsNewHeadActive
+}
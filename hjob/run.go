@@ -0,0 +1,55 @@
+package hjob
+
+import (
+	"context"
+	"go-dc-wallet/app"
+	"go-dc-wallet/model"
+	"go-dc-wallet/xenv"
+	"time"
+)
+
+// CheckJobRunClean 清理过期的任务运行记录
+func CheckJobRunClean(ctx context.Context) {
+	lockKey := "CheckJobRunClean"
+	app.LockWrap(ctx, lockKey, func(ctx context.Context) {
+		keepDays := int64(7)
+		configRow, err := model.SQLGetTAppConfigIntColKV(
+			ctx,
+			xenv.DbCon,
+			[]string{
+				model.DBColTAppConfigIntV,
+			},
+			[]string{
+				model.DBColShortTAppConfigIntK,
+			},
+			[]interface{}{
+				"job_run_keep_days",
+			},
+		)
+		if err != nil {
+			app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+			return
+		}
+		if configRow != nil && configRow.V > 0 {
+			keepDays = configRow.V
+		}
+		startTime := time.Now().Add(-time.Hour * 24 * time.Duration(keepDays)).Unix()
+		// 分批删除，避免长时间锁表
+		for ctx.Err() == nil {
+			count, err := app.SQLDeleteTAppJobRunByStartTime(
+				ctx,
+				xenv.DbCon,
+				startTime,
+				5000,
+			)
+			if err != nil {
+				app.JobErrorf(ctx, "err: [%T] %s", err, err.Error())
+				return
+			}
+			app.JobAddCount(ctx, count)
+			if count < 5000 {
+				return
+			}
+		}
+	})
+}
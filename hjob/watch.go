@@ -0,0 +1,49 @@
+package hjob
+
+import (
+	"context"
+	"go-dc-wallet/heth"
+	"go-dc-wallet/xenv"
+	"sync"
+
+	"github.com/moremorefun/mcommon"
+)
+
+// startWatchers 启动推送触发的任务，watchCtx 取消后停止接收推送，
+// 已触发的任务使用 ctx 运行
+func startWatchers(watchCtx context.Context, ctx context.Context, chains []string) (*sync.WaitGroup, error) {
+	var wg sync.WaitGroup
+	if xenv.Cfg.EthWsRPC == "" {
+		return &wg, nil
+	}
+	if len(chains) > 0 && !mcommon.IsStringInSlice(chains, ChainEth) {
+		return &wg, nil
+	}
+	if !IsChainEnable(ChainEth) {
+		return &wg, nil
+	}
+	configMap, err := GetJobConfigMap(ctx, xenv.DbCon)
+	if err != nil {
+		return nil, err
+	}
+	var pushJobs []*StJob
+	for _, job := range jobs {
+		if job.Chain != ChainEth || job.IsPushed == nil || !configMap[job.Name].Enable {
+			continue
+		}
+		pushJobs = append(pushJobs, job)
+	}
+	if len(pushJobs) == 0 {
+		return &wg, nil
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		heth.WatchNewHead(watchCtx, func() {
+			for _, job := range pushJobs {
+				RunJob(ctx, job, configMap[job.Name])
+			}
+		})
+	}()
+	return &wg, nil
+}
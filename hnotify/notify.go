@@ -0,0 +1,609 @@
+package hnotify
+
+import (
+	"context"
+	"fmt"
+	"go-dc-wallet/app"
+	"go-dc-wallet/eosclient"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/hbtc"
+	"go-dc-wallet/heos"
+	"go-dc-wallet/heth"
+	"go-dc-wallet/model"
+	"go-dc-wallet/omniclient"
+	"time"
+
+	"github.com/moremorefun/mcommon"
+)
+
+// 重发方式
+const (
+	// ReplayModeOriginal 使用原有数据和签名重新发送
+	ReplayModeOriginal = "original"
+	// ReplayModeResign 使用原有数据，以产品当前密钥重新签名
+	ReplayModeResign = "resign"
+	// ReplayModeRegenerate 根据交易和提币记录重新生成数据并签名
+	ReplayModeRegenerate = "regenerate"
+)
+
+// 币种所属链
+const (
+	chainEth   = "eth"
+	chainErc20 = "erc20"
+	chainNft   = "nft"
+	chainBtc   = "btc"
+	chainOmni  = "omni"
+	chainEos   = "eos"
+)
+
+// ListCols 查询通知时的字段
+var ListCols = []string{
+	model.DBColTProductNotifyID,
+	model.DBColTProductNotifyProductID,
+	model.DBColTProductNotifyItemType,
+	model.DBColTProductNotifyItemID,
+	model.DBColTProductNotifyNotifyType,
+	model.DBColTProductNotifyTokenSymbol,
+	model.DBColTProductNotifyURL,
+	model.DBColTProductNotifyMsg,
+	model.DBColTProductNotifyHandleStatus,
+	model.DBColTProductNotifyHandleMsg,
+	model.DBColTProductNotifyCreateTime,
+	model.DBColTProductNotifyUpdateTime,
+}
+
+// IsReplayMode 是否是支持的重发方式
+func IsReplayMode(mode string) bool {
+	return mcommon.IsStringInSlice(
+		[]string{
+			ReplayModeOriginal,
+			ReplayModeResign,
+			ReplayModeRegenerate,
+		},
+		mode,
+	)
+}
+
+// List 根据条件查询通知
+func List(ctx context.Context, tx mcommon.DbExeAble, filter *app.StNotifyFilter) ([]*model.DBTProductNotify, error) {
+	return app.SQLSelectTProductNotifyColByFilter(
+		ctx,
+		tx,
+		ListCols,
+		filter,
+	)
+}
+
+// Replay 将通知重新加入发送队列
+func Replay(ctx context.Context, tx mcommon.DbExeAble, ids []int64, mode string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	now := time.Now().Unix()
+	if mode == ReplayModeOriginal {
+		return app.SQLUpdateTProductNotifyInitByIDs(
+			ctx,
+			tx,
+			ids,
+			now,
+		)
+	}
+	if !IsReplayMode(mode) {
+		return 0, fmt.Errorf("unknown replay mode: %s", mode)
+	}
+	notifyRows, err := model.SQLSelectTProductNotifyCol(
+		ctx,
+		tx,
+		ListCols,
+		ids,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return 0, err
+	}
+	var productIDs []int64
+	for _, notifyRow := range notifyRows {
+		if !mcommon.IsIntInSlice(productIDs, notifyRow.ProductID) {
+			productIDs = append(productIDs, notifyRow.ProductID)
+		}
+	}
+	productMap, err := app.SQLGetProductMap(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTProductID,
+			model.DBColTProductAppName,
+			model.DBColTProductCbURL,
+			model.DBColTProductAppSk,
+			model.DBColTProductNotifyVersion,
+		},
+		productIDs,
+	)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	for _, notifyRow := range notifyRows {
+		productRow, ok := productMap[notifyRow.ProductID]
+		if !ok {
+			return count, fmt.Errorf("no product of notify: %d", notifyRow.ID)
+		}
+		var msg string
+		switch mode {
+		case ReplayModeResign:
+			msg, err = app.ResignNotifyMsg(productRow, notifyRow.Msg)
+			if err != nil {
+				return count, err
+			}
+		case ReplayModeRegenerate:
+			data, err := getNotifyData(ctx, tx, notifyRow)
+			if err != nil {
+				return count, err
+			}
+			msg, err = app.GetNotifyMsg(productRow, data)
+			if err != nil {
+				return count, err
+			}
+		}
+		_, err = app.SQLUpdateTProductNotifyMsgByID(
+			ctx,
+			tx,
+			&model.DBTProductNotify{
+				ID:           notifyRow.ID,
+				Nonce:        mcommon.GetUUIDStr(),
+				URL:          productRow.CbURL,
+				Msg:          msg,
+				HandleStatus: app.NotifyStatusInit,
+				UpdateTime:   now,
+			},
+		)
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// getSymbolChain 获取币种所属的链，evm 链的币种同时返回所属的evm链名称
+func getSymbolChain(ctx context.Context, tx mcommon.DbExeAble, symbol string) (string, string, error) {
+	switch symbol {
+	case heth.CoinSymbol:
+		return chainEth, ethclient.DefaultChain, nil
+	case hbtc.CoinSymbol:
+		return chainBtc, "", nil
+	case heos.CoinSymbol:
+		return chainEos, "", nil
+	}
+	for _, evmChain := range heth.GetChains() {
+		if evmChain.CoinSymbol == symbol {
+			return chainEth, evmChain.Name, nil
+		}
+	}
+	tokenRow, err := model.SQLGetTAppConfigTokenColKV(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTAppConfigTokenChain,
+		},
+		[]string{
+			model.DBColShortTAppConfigTokenTokenSymbol,
+		},
+		[]interface{}{
+			symbol,
+		},
+	)
+	if err != nil {
+		return "", "", err
+	}
+	if tokenRow != nil {
+		return chainErc20, tokenRow.Chain, nil
+	}
+	nftRow, err := model.SQLGetTAppConfigNftColKV(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTAppConfigNftChain,
+		},
+		[]string{
+			model.DBColShortTAppConfigNftTokenSymbol,
+		},
+		[]interface{}{
+			symbol,
+		},
+	)
+	if err != nil {
+		return "", "", err
+	}
+	if nftRow != nil {
+		return chainNft, nftRow.Chain, nil
+	}
+	tokenBtcRow, err := model.SQLGetTAppConfigTokenBtcColKV(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTAppConfigTokenBtcID,
+		},
+		[]string{
+			model.DBColShortTAppConfigTokenBtcTokenSymbol,
+		},
+		[]interface{}{
+			symbol,
+		},
+	)
+	if err != nil {
+		return "", "", err
+	}
+	if tokenBtcRow != nil {
+		return chainOmni, "", nil
+	}
+	return "", "", fmt.Errorf("unknown symbol: %s", symbol)
+}
+
+// getChainHeight 获取链当前高度
+func getChainHeight(ctx context.Context, chain string) (int64, error) {
+	switch chain {
+	case chainEth, chainErc20, chainNft:
+		return ethclient.RPCBlockNumber(ctx)
+	case chainBtc, chainOmni:
+		return omniclient.RPCGetBlockCount(ctx)
+	case chainEos:
+		rpcChainInfo, err := eosclient.RPCChainGetInfo(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return rpcChainInfo.HeadBlockNum, nil
+	}
+	return 0, fmt.Errorf("unknown chain: %s", chain)
+}
+
+// getNotifyData 根据交易和提币记录重新生成通知数据
+func getNotifyData(ctx context.Context, tx mcommon.DbExeAble, notifyRow *model.DBTProductNotify) (*app.StNotifyData, error) {
+	chain, evmChain, err := getSymbolChain(ctx, tx, notifyRow.TokenSymbol)
+	if err != nil {
+		return nil, err
+	}
+	if evmChain != "" {
+		ctx = ethclient.WithChain(ctx, evmChain)
+	}
+	data := &app.StNotifyData{
+		ProductID:  notifyRow.ProductID,
+		ItemType:   notifyRow.ItemType,
+		ItemID:     notifyRow.ItemID,
+		NotifyType: notifyRow.NotifyType,
+		Symbol:     notifyRow.TokenSymbol,
+	}
+	switch notifyRow.ItemType {
+	case app.SendRelationTypeTx, app.SendRelationTypeTxNft:
+		err = fillTxNotifyData(ctx, tx, chain, data)
+		if data.NotifyType == app.NotifyTypeTxReorg {
+			data.Reason = app.NotifyReasonReorg
+		}
+	case app.SendRelationTypeWithdraw:
+		err = fillWithdrawNotifyData(ctx, tx, chain, data)
+	default:
+		err = fmt.Errorf("unknown item type: %d", notifyRow.ItemType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if data.BlockNumber > 0 {
+		height, err := getChainHeight(ctx, chain)
+		if err != nil {
+			return nil, err
+		}
+		data.Confirmations = height - data.BlockNumber + 1
+	}
+	return data, nil
+}
+
+// fillTxNotifyData 填充充币通知数据
+func fillTxNotifyData(ctx context.Context, tx mcommon.DbExeAble, chain string, data *app.StNotifyData) error {
+	switch chain {
+	case chainEth:
+		txRow, err := model.SQLGetTTxCol(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTTxBlockNumber,
+				model.DBColTTxBlockHash,
+				model.DBColTTxTxID,
+				model.DBColTTxTraceAddress,
+				model.DBColTTxFromAddress,
+				model.DBColTTxToAddress,
+				model.DBColTTxBalanceReal,
+			},
+			data.ItemID,
+		)
+		if err != nil {
+			return err
+		}
+		if txRow == nil {
+			return fmt.Errorf("no t_tx: %d", data.ItemID)
+		}
+		data.TxHash = heth.GetTxNotifyHash(txRow)
+		data.Address = txRow.ToAddress
+		data.FromAddress = txRow.FromAddress
+		data.Balance = txRow.BalanceReal
+		data.BlockNumber = txRow.BlockNumber
+		data.BlockHash = txRow.BlockHash
+	case chainErc20:
+		txRow, err := model.SQLGetTTxErc20Col(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTTxErc20TokenID,
+				model.DBColTTxErc20BlockNumber,
+				model.DBColTTxErc20BlockHash,
+				model.DBColTTxErc20TxID,
+				model.DBColTTxErc20FromAddress,
+				model.DBColTTxErc20ToAddress,
+				model.DBColTTxErc20BalanceReal,
+			},
+			data.ItemID,
+		)
+		if err != nil {
+			return err
+		}
+		if txRow == nil {
+			return fmt.Errorf("no t_tx_erc20: %d", data.ItemID)
+		}
+		tokenRow, err := model.SQLGetTAppConfigTokenCol(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTAppConfigTokenTokenAddress,
+			},
+			txRow.TokenID,
+		)
+		if err != nil {
+			return err
+		}
+		if tokenRow == nil {
+			return fmt.Errorf("no t_app_config_token: %d", txRow.TokenID)
+		}
+		data.TxHash = txRow.TxID
+		data.Address = txRow.ToAddress
+		data.FromAddress = txRow.FromAddress
+		data.Balance = txRow.BalanceReal
+		data.TokenAddress = tokenRow.TokenAddress
+		data.BlockNumber = txRow.BlockNumber
+		data.BlockHash = txRow.BlockHash
+	case chainNft:
+		txRow, err := model.SQLGetTTxNftCol(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTTxNftNftID,
+				model.DBColTTxNftBlockNumber,
+				model.DBColTTxNftBlockHash,
+				model.DBColTTxNftTxID,
+				model.DBColTTxNftFromAddress,
+				model.DBColTTxNftToAddress,
+				model.DBColTTxNftTokenID,
+				model.DBColTTxNftAmount,
+			},
+			data.ItemID,
+		)
+		if err != nil {
+			return err
+		}
+		if txRow == nil {
+			return fmt.Errorf("no t_tx_nft: %d", data.ItemID)
+		}
+		nftRow, err := model.SQLGetTAppConfigNftCol(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTAppConfigNftTokenAddress,
+			},
+			txRow.NftID,
+		)
+		if err != nil {
+			return err
+		}
+		if nftRow == nil {
+			return fmt.Errorf("no t_app_config_nft: %d", txRow.NftID)
+		}
+		data.TxHash = txRow.TxID
+		data.Address = txRow.ToAddress
+		data.FromAddress = txRow.FromAddress
+		data.Balance = txRow.Amount
+		data.TokenAddress = nftRow.TokenAddress
+		data.TokenID = txRow.TokenID
+		data.BlockNumber = txRow.BlockNumber
+		data.BlockHash = txRow.BlockHash
+	case chainBtc:
+		txRow, err := model.SQLGetTTxBtcCol(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTTxBtcBlockNumber,
+				model.DBColTTxBtcBlockHash,
+				model.DBColTTxBtcTxID,
+				model.DBColTTxBtcVoutN,
+				model.DBColTTxBtcVoutAddress,
+				model.DBColTTxBtcVoutValue,
+			},
+			data.ItemID,
+		)
+		if err != nil {
+			return err
+		}
+		if txRow == nil {
+			return fmt.Errorf("no t_tx_btc: %d", data.ItemID)
+		}
+		data.TxHash = fmt.Sprintf("%s_%d", txRow.TxID, txRow.VoutN)
+		data.Address = txRow.VoutAddress
+		data.Balance = txRow.VoutValue
+		data.BlockNumber = txRow.BlockNumber
+		data.BlockHash = txRow.BlockHash
+	case chainOmni:
+		txRow, err := model.SQLGetTTxBtcTokenCol(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTTxBtcTokenTokenIndex,
+				model.DBColTTxBtcTokenBlockNumber,
+				model.DBColTTxBtcTokenBlockHash,
+				model.DBColTTxBtcTokenTxID,
+				model.DBColTTxBtcTokenFromAddress,
+				model.DBColTTxBtcTokenToAddress,
+				model.DBColTTxBtcTokenValue,
+			},
+			data.ItemID,
+		)
+		if err != nil {
+			return err
+		}
+		if txRow == nil {
+			return fmt.Errorf("no t_tx_btc_token: %d", data.ItemID)
+		}
+		data.TxHash = txRow.TxID
+		data.Address = txRow.ToAddress
+		data.FromAddress = txRow.FromAddress
+		data.Balance = txRow.Value
+		data.TokenIndex = txRow.TokenIndex
+		data.BlockNumber = txRow.BlockNumber
+		data.BlockHash = txRow.BlockHash
+	case chainEos:
+		txRow, err := model.SQLGetTTxEosCol(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTTxEosBlockNumber,
+				model.DBColTTxEosBlockHash,
+				model.DBColTTxEosTxHash,
+				model.DBColTTxEosLogIndex,
+				model.DBColTTxEosFromAddress,
+				model.DBColTTxEosToAddress,
+				model.DBColTTxEosMemo,
+				model.DBColTTxEosBalanceReal,
+			},
+			data.ItemID,
+		)
+		if err != nil {
+			return err
+		}
+		if txRow == nil {
+			return fmt.Errorf("no t_tx_eos: %d", data.ItemID)
+		}
+		data.TxHash = fmt.Sprintf("%s_%d", txRow.TxHash, txRow.LogIndex)
+		data.Address = txRow.ToAddress
+		data.FromAddress = txRow.FromAddress
+		data.Balance = txRow.BalanceReal
+		data.Memo = txRow.Memo
+		data.BlockNumber = txRow.BlockNumber
+		data.BlockHash = txRow.BlockHash
+	}
+	return nil
+}
+
+// fillWithdrawNotifyData 填充提币通知数据
+func fillWithdrawNotifyData(ctx context.Context, tx mcommon.DbExeAble, chain string, data *app.StNotifyData) error {
+	withdrawRow, err := model.SQLGetTWithdrawCol(
+		ctx,
+		tx,
+		[]string{
+			model.DBColTWithdrawOutSerial,
+			model.DBColTWithdrawToAddress,
+			model.DBColTWithdrawMemo,
+			model.DBColTWithdrawBalanceReal,
+			model.DBColTWithdrawTokenID,
+			model.DBColTWithdrawTxHash,
+			model.DBColTWithdrawFee,
+			model.DBColTWithdrawBlockNumber,
+			model.DBColTWithdrawBlockHash,
+			model.DBColTWithdrawHandleStatus,
+			model.DBColTWithdrawHandleMsg,
+		},
+		data.ItemID,
+	)
+	if err != nil {
+		return err
+	}
+	if withdrawRow == nil {
+		return fmt.Errorf("no t_withdraw: %d", data.ItemID)
+	}
+	data.TxHash = withdrawRow.TxHash
+	data.Address = withdrawRow.ToAddress
+	data.Balance = withdrawRow.BalanceReal
+	data.TokenID = withdrawRow.TokenID
+	data.OutSerial = withdrawRow.OutSerial
+	data.Memo = withdrawRow.Memo
+	data.Fee = withdrawRow.Fee
+	data.BlockNumber = withdrawRow.BlockNumber
+	data.BlockHash = withdrawRow.BlockHash
+	if data.NotifyType == app.NotifyTypeWithdrawFailed {
+		data.Reason = withdrawRow.HandleMsg
+	}
+	// 发送地址
+	switch chain {
+	case chainEth, chainErc20, chainNft:
+		sendRow, err := model.SQLGetTSendColKV(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTSendFromAddress,
+			},
+			[]string{
+				model.DBColShortTSendRelatedType,
+				model.DBColShortTSendRelatedID,
+			},
+			[]interface{}{
+				app.SendRelationTypeWithdraw,
+				data.ItemID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if sendRow != nil {
+			data.FromAddress = sendRow.FromAddress
+		}
+	case chainBtc, chainOmni:
+		sendRow, err := model.SQLGetTSendBtcColKV(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTSendBtcFromAddress,
+			},
+			[]string{
+				model.DBColShortTSendBtcRelatedType,
+				model.DBColShortTSendBtcRelatedID,
+			},
+			[]interface{}{
+				app.SendRelationTypeWithdraw,
+				data.ItemID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if sendRow != nil {
+			data.FromAddress = sendRow.FromAddress
+		}
+	case chainEos:
+		sendRow, err := model.SQLGetTSendEosColKV(
+			ctx,
+			tx,
+			[]string{
+				model.DBColTSendEosFromAddress,
+			},
+			[]string{
+				model.DBColShortTSendEosWithdrawID,
+			},
+			[]interface{}{
+				data.ItemID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if sendRow != nil {
+			data.FromAddress = sendRow.FromAddress
+		}
+	}
+	return nil
+}
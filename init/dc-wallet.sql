@@ -37,6 +37,40 @@ CREATE TABLE `t_address_key` (
 
 
 
+# Dump of table t_app_alert
+# ------------------------------------------------------------
+
+CREATE TABLE `t_app_alert` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `k` varchar(128) NOT NULL DEFAULT '' COMMENT '报警键值',
+  `check_value` bigint(20) NOT NULL DEFAULT '0' COMMENT '最后检测值',
+  `check_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '检测值变化时间',
+  `alert_msg` varchar(512) NOT NULL DEFAULT '' COMMENT '最后报警内容',
+  `alert_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '最后报警时间 0 未报警',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `k` (`k`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_app_config_chain
+# ------------------------------------------------------------
+
+CREATE TABLE `t_app_config_chain` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `name` varchar(32) NOT NULL DEFAULT '' COMMENT '链名称，作为配置、状态键和任务名的前缀',
+  `coin_symbol` varchar(128) NOT NULL DEFAULT '' COMMENT '原生币符号',
+  `chain_id` bigint(20) NOT NULL DEFAULT '0' COMMENT '链id，为0时从节点获取',
+  `rpc` varchar(512) NOT NULL DEFAULT '' COMMENT '节点rpc地址',
+  `enable` tinyint(4) NOT NULL DEFAULT '1' COMMENT '是否开启',
+  `create_time` bigint(20) unsigned NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `name` (`name`),
+  UNIQUE KEY `coin_symbol` (`coin_symbol`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
 # Dump of table t_app_config_int
 # ------------------------------------------------------------
 
@@ -50,6 +84,25 @@ CREATE TABLE `t_app_config_int` (
 
 
 
+# Dump of table t_app_config_nft
+# ------------------------------------------------------------
+
+CREATE TABLE `t_app_config_nft` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `chain` varchar(32) NOT NULL DEFAULT 'eth' COMMENT '所属evm链 t_app_config_chain.name',
+  `token_address` varchar(128) NOT NULL DEFAULT '',
+  `token_standard` int(11) unsigned NOT NULL COMMENT '721 或 1155',
+  `token_symbol` varchar(128) NOT NULL,
+  `cold_address` varchar(128) NOT NULL DEFAULT '',
+  `hot_address` varchar(1024) NOT NULL DEFAULT '' COMMENT '热钱包地址，多个以逗号分隔',
+  `create_time` bigint(20) unsigned NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `token_address` (`chain`,`token_address`),
+  UNIQUE KEY `token_symbol` (`token_symbol`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
 # Dump of table t_app_config_str
 # ------------------------------------------------------------
 
@@ -68,15 +121,17 @@ CREATE TABLE `t_app_config_str` (
 
 CREATE TABLE `t_app_config_token` (
   `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `chain` varchar(32) NOT NULL DEFAULT 'eth' COMMENT '所属evm链 t_app_config_chain.name',
   `token_address` varchar(128) NOT NULL DEFAULT '',
   `token_decimals` int(11) unsigned NOT NULL,
   `token_symbol` varchar(128) NOT NULL,
   `cold_address` varchar(128) NOT NULL DEFAULT '',
-  `hot_address` varchar(128) NOT NULL DEFAULT '',
+  `hot_address` varchar(1024) NOT NULL DEFAULT '' COMMENT '热钱包地址，多个以逗号分隔',
   `org_min_balance` varchar(128) NOT NULL DEFAULT '0',
+  `org_real_balance` tinyint(4) NOT NULL DEFAULT '0' COMMENT '整理时以链上余额为准，用于转账扣费和rebase的token',
   `create_time` bigint(20) unsigned NOT NULL,
   PRIMARY KEY (`id`),
-  UNIQUE KEY `token_address` (`token_address`)
+  UNIQUE KEY `token_address` (`chain`,`token_address`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 
@@ -99,6 +154,41 @@ CREATE TABLE `t_app_config_token_btc` (
 
 
 
+# Dump of table t_app_job
+# ------------------------------------------------------------
+
+CREATE TABLE `t_app_job` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT '任务名称',
+  `spec` varchar(64) NOT NULL DEFAULT '' COMMENT '运行间隔，为空时使用默认值',
+  `enable` tinyint(2) NOT NULL DEFAULT '1' COMMENT '是否启用',
+  `timeout_seconds` bigint(20) NOT NULL DEFAULT '0' COMMENT '超时秒数，0为不限制',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `name` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_app_job_run
+# ------------------------------------------------------------
+
+CREATE TABLE `t_app_job_run` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT '任务名称',
+  `instance` varchar(128) NOT NULL DEFAULT '' COMMENT '运行实例 主机名-进程号',
+  `start_time` bigint(20) unsigned NOT NULL COMMENT '开始时间',
+  `end_time` bigint(20) unsigned NOT NULL COMMENT '结束时间',
+  `cost_ms` bigint(20) NOT NULL DEFAULT '0' COMMENT '耗时毫秒',
+  `handle_count` bigint(20) NOT NULL DEFAULT '0' COMMENT '处理数量',
+  `lock_skipped` tinyint(2) NOT NULL DEFAULT '0' COMMENT '是否因未获取到锁跳过',
+  `err_msg` varchar(1024) NOT NULL DEFAULT '' COMMENT '错误信息',
+  PRIMARY KEY (`id`),
+  KEY `name` (`name`,`start_time`),
+  KEY `start_time` (`start_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
 # Dump of table t_app_lock
 # ------------------------------------------------------------
 
@@ -106,7 +196,10 @@ CREATE TABLE `t_app_lock` (
   `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
   `k` varchar(64) NOT NULL DEFAULT '' COMMENT '上锁键值',
   `v` tinyint(2) NOT NULL COMMENT '是否锁定',
+  `owner` varchar(128) NOT NULL DEFAULT '' COMMENT '持有者',
   `create_time` bigint(20) unsigned NOT NULL COMMENT '上锁时间',
+  `expire_time` bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '租约到期时间',
+  `update_time` bigint(20) unsigned NOT NULL DEFAULT '0' COMMENT '最后续约时间',
   PRIMARY KEY (`id`),
   UNIQUE KEY `k_2` (`k`),
   KEY `k` (`k`,`create_time`)
@@ -127,6 +220,68 @@ CREATE TABLE `t_app_status_int` (
 
 
 
+# Dump of table t_btc_block
+# ------------------------------------------------------------
+
+CREATE TABLE `t_btc_block` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `seek_key` varchar(64) NOT NULL COMMENT '记录该区块的检测任务进度键 t_app_status_int.k',
+  `block_number` bigint(20) NOT NULL COMMENT '区块高度',
+  `block_hash` varchar(128) NOT NULL DEFAULT '' COMMENT '区块hash',
+  `parent_hash` varchar(128) NOT NULL DEFAULT '' COMMENT '父区块hash',
+  `create_time` bigint(20) unsigned NOT NULL COMMENT '创建时间戳',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `seek_key_block_number` (`seek_key`,`block_number`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_eth_block
+# ------------------------------------------------------------
+
+CREATE TABLE `t_eth_block` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `chain` varchar(32) NOT NULL DEFAULT 'eth' COMMENT '所属evm链 t_app_config_chain.name',
+  `block_number` bigint(20) NOT NULL COMMENT '区块高度',
+  `block_hash` varchar(128) NOT NULL DEFAULT '' COMMENT '区块hash',
+  `parent_hash` varchar(128) NOT NULL DEFAULT '' COMMENT '父区块hash',
+  `create_time` bigint(20) unsigned NOT NULL COMMENT '创建时间戳',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `block_number` (`chain`,`block_number`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_eth_forwarder
+# ------------------------------------------------------------
+
+CREATE TABLE `t_eth_forwarder` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `address` varchar(64) NOT NULL COMMENT 'forwarder地址',
+  `salt` varchar(128) NOT NULL COMMENT 'create2 salt',
+  `factory_address` varchar(128) NOT NULL COMMENT '工厂合约地址',
+  `create_time` bigint(20) NOT NULL COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `address` (`address`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_eth_nonce
+# ------------------------------------------------------------
+
+CREATE TABLE `t_eth_nonce` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `chain` varchar(32) NOT NULL DEFAULT 'eth' COMMENT '所属evm链 t_app_config_chain.name',
+  `address` varchar(128) NOT NULL COMMENT '打币地址',
+  `nonce` bigint(20) NOT NULL DEFAULT '-1' COMMENT '最后分配的nonce',
+  `update_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `address` (`chain`,`address`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
 # Dump of table t_product
 # ------------------------------------------------------------
 
@@ -136,6 +291,7 @@ CREATE TABLE `t_product` (
   `app_sk` varchar(64) NOT NULL DEFAULT '' COMMENT '应用私钥',
   `cb_url` varchar(512) NOT NULL COMMENT '回调地址',
   `whitelist_ip` varchar(1024) NOT NULL DEFAULT '' COMMENT 'ip白名单',
+  `notify_version` int(11) NOT NULL DEFAULT '1' COMMENT '回调数据版本',
   PRIMARY KEY (`id`),
   UNIQUE KEY `app_name` (`app_name`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -183,6 +339,7 @@ CREATE TABLE `t_product_notify` (
 
 CREATE TABLE `t_send` (
   `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `chain` varchar(32) NOT NULL DEFAULT 'eth' COMMENT '所属evm链 t_app_config_chain.name',
   `related_type` tinyint(4) NOT NULL COMMENT '关联类型 1 零钱整理 2 提币',
   `related_id` int(11) unsigned NOT NULL COMMENT '关联id',
   `token_id` int(11) unsigned NOT NULL,
@@ -190,8 +347,12 @@ CREATE TABLE `t_send` (
   `from_address` varchar(128) NOT NULL DEFAULT '' COMMENT '打币地址',
   `to_address` varchar(128) NOT NULL COMMENT '收币地址',
   `balance_real` varchar(128) NOT NULL COMMENT '打币金额 Ether',
-  `gas` bigint(20) NOT NULL COMMENT 'gas消耗',
+  `gas` bigint(20) NOT NULL COMMENT 'gas limit',
+  `gas_estimate` bigint(20) NOT NULL DEFAULT '0' COMMENT 'eth_estimateGas 估算值，0 为未估算',
+  `gas_used` bigint(20) NOT NULL DEFAULT '0' COMMENT '交易回执中实际消耗的gas',
   `gas_price` bigint(20) NOT NULL COMMENT 'gasPrice',
+  `max_fee_per_gas` bigint(20) NOT NULL DEFAULT '0' COMMENT 'EIP-1559 maxFeePerGas，传统交易为0',
+  `max_priority_fee_per_gas` bigint(20) NOT NULL DEFAULT '0' COMMENT 'EIP-1559 maxPriorityFeePerGas，传统交易为0',
   `nonce` int(11) NOT NULL COMMENT 'nonce',
   `hex` varchar(2048) NOT NULL COMMENT 'tx raw hex',
   `create_time` bigint(20) NOT NULL COMMENT '创建时间',
@@ -206,6 +367,40 @@ CREATE TABLE `t_send` (
 
 
 
+# Dump of table t_send_replace
+# ------------------------------------------------------------
+
+CREATE TABLE `t_send_replace` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `send_id` int(11) unsigned NOT NULL COMMENT 't_send.id 发送数据id',
+  `tx_id` varchar(128) NOT NULL COMMENT '被替换的 tx hash',
+  `replace_tx_id` varchar(128) NOT NULL COMMENT '替换后的 tx hash',
+  `nonce` int(11) NOT NULL COMMENT 'nonce',
+  `gas_price` bigint(20) NOT NULL COMMENT '被替换交易的 gasPrice',
+  `max_priority_fee_per_gas` bigint(20) NOT NULL DEFAULT '0' COMMENT '被替换交易的 maxPriorityFeePerGas',
+  `create_time` bigint(20) NOT NULL COMMENT '替换时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `tx_id` (`tx_id`),
+  KEY `send_id` (`send_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_send_withdraw
+# ------------------------------------------------------------
+
+CREATE TABLE `t_send_withdraw` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `send_id` int(11) unsigned NOT NULL COMMENT 't_send.id 批量提币的发送数据id',
+  `withdraw_id` int(11) unsigned NOT NULL COMMENT 't_withdraw.id 提币id',
+  `create_time` bigint(20) NOT NULL COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `withdraw_id` (`withdraw_id`),
+  KEY `send_id` (`send_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
 # Dump of table t_send_btc
 # ------------------------------------------------------------
 
@@ -262,8 +457,12 @@ CREATE TABLE `t_send_eos` (
 
 CREATE TABLE `t_tx` (
   `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `chain` varchar(32) NOT NULL DEFAULT 'eth' COMMENT '所属evm链 t_app_config_chain.name',
   `product_id` int(11) unsigned NOT NULL,
+  `block_number` bigint(20) NOT NULL DEFAULT '0' COMMENT '区块高度',
+  `block_hash` varchar(128) NOT NULL DEFAULT '' COMMENT '区块hash',
   `tx_id` varchar(128) NOT NULL DEFAULT '' COMMENT '交易id',
+  `trace_address` varchar(128) NOT NULL DEFAULT '' COMMENT '合约内部转账的调用位置，交易本身的转账为空',
   `from_address` varchar(128) NOT NULL DEFAULT '' COMMENT '来源地址',
   `to_address` varchar(128) NOT NULL DEFAULT '' COMMENT '目标地址',
   `balance_real` varchar(128) NOT NULL COMMENT '到账金额Ether',
@@ -275,8 +474,8 @@ CREATE TABLE `t_tx` (
   `org_msg` varchar(128) NOT NULL COMMENT '零钱整理消息',
   `org_time` bigint(20) unsigned NOT NULL COMMENT '零钱整理时间',
   PRIMARY KEY (`id`),
-  UNIQUE KEY `tx_id` (`tx_id`),
-  KEY `t_tx_org_status_idx` (`org_status`) USING BTREE
+  UNIQUE KEY `tx_id` (`tx_id`,`trace_address`,`block_hash`),
+  KEY `t_tx_org_status_idx` (`chain`,`org_status`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 
@@ -287,6 +486,7 @@ CREATE TABLE `t_tx` (
 CREATE TABLE `t_tx_btc` (
   `id` bigint(22) unsigned NOT NULL AUTO_INCREMENT,
   `product_id` bigint(22) unsigned NOT NULL,
+  `block_number` bigint(20) NOT NULL DEFAULT '0',
   `block_hash` varchar(128) NOT NULL DEFAULT '',
   `tx_id` varchar(128) NOT NULL DEFAULT '',
   `vout_n` int(11) NOT NULL,
@@ -297,7 +497,8 @@ CREATE TABLE `t_tx_btc` (
   `handle_msg` varchar(128) NOT NULL DEFAULT '',
   `handle_time` bigint(22) unsigned NOT NULL,
   PRIMARY KEY (`id`),
-  UNIQUE KEY `tx_id` (`tx_id`,`vout_n`)
+  UNIQUE KEY `tx_id` (`tx_id`,`vout_n`,`block_hash`),
+  KEY `block_hash` (`block_hash`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 
@@ -310,6 +511,7 @@ CREATE TABLE `t_tx_btc_token` (
   `product_id` bigint(22) unsigned NOT NULL,
   `token_index` int(11) NOT NULL,
   `token_symbol` varchar(128) NOT NULL,
+  `block_number` bigint(20) NOT NULL DEFAULT '0',
   `block_hash` varchar(128) NOT NULL DEFAULT '',
   `tx_id` varchar(128) NOT NULL DEFAULT '',
   `from_address` varchar(128) NOT NULL DEFAULT '',
@@ -324,7 +526,8 @@ CREATE TABLE `t_tx_btc_token` (
   `org_msg` varchar(128) NOT NULL,
   `org_at` bigint(22) unsigned NOT NULL,
   PRIMARY KEY (`id`),
-  UNIQUE KEY `tx_id` (`tx_id`),
+  UNIQUE KEY `tx_id` (`tx_id`,`block_hash`),
+  KEY `block_hash` (`block_hash`),
   KEY `t_tx_btc_token_org_status_idx` (`org_status`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
@@ -351,6 +554,7 @@ CREATE TABLE `t_tx_btc_uxto` (
   PRIMARY KEY (`id`),
   UNIQUE KEY `tx_id` (`tx_id`,`vout_n`),
   KEY `handle_status` (`handle_status`,`uxto_type`,`id`) USING BTREE,
+  KEY `block_hash` (`block_hash`),
   KEY `t_tx_btc_uxto_vout_address_handle_status_uxto_type_idx` (`vout_address`,`handle_status`,`uxto_type`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
@@ -362,6 +566,8 @@ CREATE TABLE `t_tx_btc_uxto` (
 CREATE TABLE `t_tx_eos` (
   `id` bigint(22) unsigned NOT NULL AUTO_INCREMENT,
   `product_id` bigint(22) unsigned NOT NULL,
+  `block_number` bigint(20) NOT NULL DEFAULT '0' COMMENT '区块高度',
+  `block_hash` varchar(128) NOT NULL DEFAULT '' COMMENT '区块hash',
   `tx_hash` varchar(128) NOT NULL DEFAULT '',
   `log_index` bigint(20) unsigned NOT NULL,
   `from_address` varchar(128) NOT NULL DEFAULT '',
@@ -383,8 +589,11 @@ CREATE TABLE `t_tx_eos` (
 
 CREATE TABLE `t_tx_erc20` (
   `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `chain` varchar(32) NOT NULL DEFAULT 'eth' COMMENT '所属evm链 t_app_config_chain.name',
   `token_id` int(11) unsigned NOT NULL,
   `product_id` int(11) unsigned NOT NULL,
+  `block_number` bigint(20) NOT NULL DEFAULT '0' COMMENT '区块高度',
+  `block_hash` varchar(128) NOT NULL DEFAULT '' COMMENT '区块hash',
   `tx_id` varchar(128) NOT NULL DEFAULT '' COMMENT '交易id',
   `from_address` varchar(128) NOT NULL DEFAULT '' COMMENT '来源地址',
   `to_address` varchar(128) NOT NULL DEFAULT '' COMMENT '目标地址',
@@ -397,8 +606,57 @@ CREATE TABLE `t_tx_erc20` (
   `org_msg` varchar(128) NOT NULL COMMENT '零钱整理消息',
   `org_time` bigint(20) unsigned NOT NULL COMMENT '零钱整理时间',
   PRIMARY KEY (`id`),
-  UNIQUE KEY `tx_id` (`tx_id`),
-  KEY `t_tx_erc20_org_status_idx` (`org_status`) USING BTREE
+  UNIQUE KEY `tx_id` (`tx_id`,`block_hash`),
+  KEY `t_tx_erc20_org_status_idx` (`chain`,`org_status`) USING BTREE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_tx_erc20_org_diff
+# ------------------------------------------------------------
+
+CREATE TABLE `t_tx_erc20_org_diff` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `token_id` int(11) unsigned NOT NULL,
+  `address` varchar(128) NOT NULL DEFAULT '' COMMENT '充币地址',
+  `tx_id` varchar(128) NOT NULL DEFAULT '' COMMENT '整理交易id，没有余额时为空',
+  `credited_balance` varchar(128) NOT NULL COMMENT '入账金额',
+  `real_balance` varchar(128) NOT NULL COMMENT '链上实际余额',
+  `diff_balance` varchar(128) NOT NULL COMMENT '差额 实际余额-入账金额',
+  `create_time` bigint(20) unsigned NOT NULL COMMENT '创建时间戳',
+  PRIMARY KEY (`id`),
+  KEY `token_id` (`token_id`,`address`),
+  KEY `tx_id` (`tx_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+
+
+# Dump of table t_tx_nft
+# ------------------------------------------------------------
+
+CREATE TABLE `t_tx_nft` (
+  `id` int(11) unsigned NOT NULL AUTO_INCREMENT,
+  `chain` varchar(32) NOT NULL DEFAULT 'eth' COMMENT '所属evm链 t_app_config_chain.name',
+  `nft_id` int(11) unsigned NOT NULL COMMENT 't_app_config_nft.id',
+  `product_id` int(11) unsigned NOT NULL,
+  `block_number` bigint(20) NOT NULL DEFAULT '0' COMMENT '区块高度',
+  `block_hash` varchar(128) NOT NULL DEFAULT '' COMMENT '区块hash',
+  `tx_id` varchar(128) NOT NULL DEFAULT '' COMMENT '交易id',
+  `log_index` int(11) unsigned NOT NULL DEFAULT '0' COMMENT '日志位置',
+  `from_address` varchar(128) NOT NULL DEFAULT '' COMMENT '来源地址',
+  `to_address` varchar(128) NOT NULL DEFAULT '' COMMENT '目标地址',
+  `token_id` varchar(128) NOT NULL DEFAULT '' COMMENT 'nft token id',
+  `amount` varchar(128) NOT NULL DEFAULT '1' COMMENT '数量，erc721 为 1',
+  `create_time` bigint(20) unsigned NOT NULL COMMENT '创建时间戳',
+  `handle_status` tinyint(4) NOT NULL COMMENT '处理状态',
+  `handle_msg` varchar(128) NOT NULL DEFAULT '' COMMENT '处理消息',
+  `handle_time` bigint(20) unsigned NOT NULL COMMENT '处理时间戳',
+  `org_status` tinyint(4) NOT NULL COMMENT '零钱整理状态',
+  `org_msg` varchar(128) NOT NULL COMMENT '零钱整理消息',
+  `org_time` bigint(20) unsigned NOT NULL COMMENT '零钱整理时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `tx_id` (`tx_id`,`block_hash`,`log_index`,`token_id`),
+  KEY `t_tx_nft_org_status_idx` (`chain`,`org_status`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
 
@@ -414,7 +672,11 @@ CREATE TABLE `t_withdraw` (
   `memo` varchar(256) NOT NULL DEFAULT '',
   `symbol` varchar(128) NOT NULL,
   `balance_real` varchar(128) NOT NULL DEFAULT '' COMMENT '提币金额',
+  `token_id` varchar(128) NOT NULL DEFAULT '' COMMENT 'nft token id',
   `tx_hash` varchar(128) NOT NULL DEFAULT '' COMMENT '提币tx hash',
+  `fee` varchar(128) NOT NULL DEFAULT '' COMMENT '实际手续费',
+  `block_number` bigint(20) NOT NULL DEFAULT '0' COMMENT '区块高度',
+  `block_hash` varchar(128) NOT NULL DEFAULT '' COMMENT '区块hash',
   `create_time` bigint(20) unsigned NOT NULL COMMENT '创建时间',
   `handle_status` int(11) NOT NULL COMMENT '处理状态',
   `handle_msg` varchar(128) NOT NULL COMMENT '处理消息',
@@ -1,29 +1,42 @@
 package model
 
 // TableNames 所有表名
-var TableNames = []string{"t_address_key", "t_app_config_int", "t_app_config_str", "t_app_config_token", "t_app_config_token_btc", "t_app_lock", "t_app_status_int", "t_product", "t_product_nonce", "t_product_notify", "t_send", "t_send_btc", "t_send_eos", "t_tx", "t_tx_btc", "t_tx_btc_token", "t_tx_btc_uxto", "t_tx_eos", "t_tx_erc20", "t_withdraw"}
+var TableNames = []string{"t_address_key", "t_app_alert", "t_app_config_chain", "t_app_config_int", "t_app_config_nft", "t_app_config_str", "t_app_config_token", "t_app_config_token_btc", "t_app_job", "t_app_job_run", "t_app_lock", "t_app_status_int", "t_btc_block", "t_eth_block", "t_eth_forwarder", "t_eth_nonce", "t_product", "t_product_nonce", "t_product_notify", "t_send", "t_send_btc", "t_send_eos", "t_send_replace", "t_send_withdraw", "t_tx", "t_tx_btc", "t_tx_btc_token", "t_tx_btc_uxto", "t_tx_eos", "t_tx_erc20", "t_tx_erc20_org_diff", "t_tx_nft", "t_withdraw"}
 
 // 表名
 const (
 	DbTableTAddressKey        = "t_address_key"
+	DbTableTAppAlert          = "t_app_alert"
+	DbTableTAppConfigChain    = "t_app_config_chain"
 	DbTableTAppConfigInt      = "t_app_config_int"
+	DbTableTAppConfigNft      = "t_app_config_nft"
 	DbTableTAppConfigStr      = "t_app_config_str"
 	DbTableTAppConfigToken    = "t_app_config_token"
 	DbTableTAppConfigTokenBtc = "t_app_config_token_btc"
+	DbTableTAppJob            = "t_app_job"
+	DbTableTAppJobRun         = "t_app_job_run"
 	DbTableTAppLock           = "t_app_lock"
 	DbTableTAppStatusInt      = "t_app_status_int"
+	DbTableTBtcBlock          = "t_btc_block"
+	DbTableTEthBlock          = "t_eth_block"
+	DbTableTEthForwarder      = "t_eth_forwarder"
+	DbTableTEthNonce          = "t_eth_nonce"
 	DbTableTProduct           = "t_product"
 	DbTableTProductNonce      = "t_product_nonce"
 	DbTableTProductNotify     = "t_product_notify"
 	DbTableTSend              = "t_send"
 	DbTableTSendBtc           = "t_send_btc"
 	DbTableTSendEos           = "t_send_eos"
+	DbTableTSendReplace       = "t_send_replace"
+	DbTableTSendWithdraw      = "t_send_withdraw"
 	DbTableTTx                = "t_tx"
 	DbTableTTxBtc             = "t_tx_btc"
 	DbTableTTxBtcToken        = "t_tx_btc_token"
 	DbTableTTxBtcUxto         = "t_tx_btc_uxto"
 	DbTableTTxEos             = "t_tx_eos"
 	DbTableTTxErc20           = "t_tx_erc20"
+	DbTableTTxErc20OrgDiff    = "t_tx_erc20_org_diff"
+	DbTableTTxNft             = "t_tx_nft"
 	DbTableTWithdraw          = "t_withdraw"
 )
 
@@ -73,6 +86,109 @@ type DBTAddressKey struct {
 	UseTag  int64  `db:"use_tag" json:"use_tag"` // 占用标志 -1 作为热钱包占用-0 未占用->0 作为用户冲币地址占用
 }
 
+// const TAppAlert full
+const (
+	DBColTAppAlertID         = "t_app_alert.id"
+	DBColTAppAlertK          = "t_app_alert.k"           // 报警键值
+	DBColTAppAlertCheckValue = "t_app_alert.check_value" // 最后检测值
+	DBColTAppAlertCheckTime  = "t_app_alert.check_time"  // 检测值变化时间
+	DBColTAppAlertAlertMsg   = "t_app_alert.alert_msg"   // 最后报警内容
+	DBColTAppAlertAlertTime  = "t_app_alert.alert_time"  // 最后报警时间 0 未报警
+)
+
+// const TAppAlert short
+const (
+	DBColShortTAppAlertID         = "id"
+	DBColShortTAppAlertK          = "k"           // 报警键值
+	DBColShortTAppAlertCheckValue = "check_value" // 最后检测值
+	DBColShortTAppAlertCheckTime  = "check_time"  // 检测值变化时间
+	DBColShortTAppAlertAlertMsg   = "alert_msg"   // 最后报警内容
+	DBColShortTAppAlertAlertTime  = "alert_time"  // 最后报警时间 0 未报警
+)
+
+// DBColTAppAlertAll 所有字段
+var DBColTAppAlertAll = []string{
+	"t_app_alert.id",
+	"t_app_alert.k",
+	"t_app_alert.check_value",
+	"t_app_alert.check_time",
+	"t_app_alert.alert_msg",
+	"t_app_alert.alert_time",
+}
+
+// 表结构
+// DBTAppAlert t_app_alert
+/*
+   id,
+   k,
+   check_value,
+   check_time,
+   alert_msg,
+   alert_time
+*/
+type DBTAppAlert struct {
+	ID         int64  `db:"id" json:"id"`
+	K          string `db:"k" json:"k"`                     // 报警键值
+	CheckValue int64  `db:"check_value" json:"check_value"` // 最后检测值
+	CheckTime  int64  `db:"check_time" json:"check_time"`   // 检测值变化时间
+	AlertMsg   string `db:"alert_msg" json:"alert_msg"`     // 最后报警内容
+	AlertTime  int64  `db:"alert_time" json:"alert_time"`   // 最后报警时间 0 未报警
+}
+
+// const TAppConfigChain full
+const (
+	DBColTAppConfigChainID         = "t_app_config_chain.id"
+	DBColTAppConfigChainName       = "t_app_config_chain.name"        // 链名称，作为配置、状态键和任务名的前缀
+	DBColTAppConfigChainCoinSymbol = "t_app_config_chain.coin_symbol" // 原生币符号
+	DBColTAppConfigChainChainID    = "t_app_config_chain.chain_id"    // 链id，为0时从节点获取
+	DBColTAppConfigChainRPC        = "t_app_config_chain.rpc"         // 节点rpc地址
+	DBColTAppConfigChainEnable     = "t_app_config_chain.enable"      // 是否开启
+	DBColTAppConfigChainCreateTime = "t_app_config_chain.create_time"
+)
+
+// const TAppConfigChain short
+const (
+	DBColShortTAppConfigChainID         = "id"
+	DBColShortTAppConfigChainName       = "name"        // 链名称，作为配置、状态键和任务名的前缀
+	DBColShortTAppConfigChainCoinSymbol = "coin_symbol" // 原生币符号
+	DBColShortTAppConfigChainChainID    = "chain_id"    // 链id，为0时从节点获取
+	DBColShortTAppConfigChainRPC        = "rpc"         // 节点rpc地址
+	DBColShortTAppConfigChainEnable     = "enable"      // 是否开启
+	DBColShortTAppConfigChainCreateTime = "create_time"
+)
+
+// DBColTAppConfigChainAll 所有字段
+var DBColTAppConfigChainAll = []string{
+	"t_app_config_chain.id",
+	"t_app_config_chain.name",
+	"t_app_config_chain.coin_symbol",
+	"t_app_config_chain.chain_id",
+	"t_app_config_chain.rpc",
+	"t_app_config_chain.enable",
+	"t_app_config_chain.create_time",
+}
+
+// 表结构
+// DBTAppConfigChain t_app_config_chain
+/*
+   id,
+   name,
+   coin_symbol,
+   chain_id,
+   rpc,
+   enable,
+   create_time
+*/
+type DBTAppConfigChain struct {
+	ID         int64  `db:"id" json:"id"`
+	Name       string `db:"name" json:"name"`               // 链名称，作为配置、状态键和任务名的前缀
+	CoinSymbol string `db:"coin_symbol" json:"coin_symbol"` // 原生币符号
+	ChainID    int64  `db:"chain_id" json:"chain_id"`       // 链id，为0时从节点获取
+	RPC        string `db:"rpc" json:"rpc"`                 // 节点rpc地址
+	Enable     int64  `db:"enable" json:"enable"`           // 是否开启
+	CreateTime int64  `db:"create_time" json:"create_time"`
+}
+
 // const TAppConfigInt full
 const (
 	DBColTAppConfigIntID = "t_app_config_int.id"
@@ -107,6 +223,65 @@ type DBTAppConfigInt struct {
 	V  int64  `db:"v" json:"v"` // 配置键值
 }
 
+// const TAppConfigNft full
+const (
+	DBColTAppConfigNftID            = "t_app_config_nft.id"
+	DBColTAppConfigNftChain         = "t_app_config_nft.chain" // 所属evm链 t_app_config_chain.name
+	DBColTAppConfigNftTokenAddress  = "t_app_config_nft.token_address"
+	DBColTAppConfigNftTokenStandard = "t_app_config_nft.token_standard" // 721 或 1155
+	DBColTAppConfigNftTokenSymbol   = "t_app_config_nft.token_symbol"
+	DBColTAppConfigNftColdAddress   = "t_app_config_nft.cold_address"
+	DBColTAppConfigNftHotAddress    = "t_app_config_nft.hot_address" // 热钱包地址，多个以逗号分隔
+	DBColTAppConfigNftCreateTime    = "t_app_config_nft.create_time"
+)
+
+// const TAppConfigNft short
+const (
+	DBColShortTAppConfigNftID            = "id"
+	DBColShortTAppConfigNftChain         = "chain" // 所属evm链 t_app_config_chain.name
+	DBColShortTAppConfigNftTokenAddress  = "token_address"
+	DBColShortTAppConfigNftTokenStandard = "token_standard" // 721 或 1155
+	DBColShortTAppConfigNftTokenSymbol   = "token_symbol"
+	DBColShortTAppConfigNftColdAddress   = "cold_address"
+	DBColShortTAppConfigNftHotAddress    = "hot_address" // 热钱包地址，多个以逗号分隔
+	DBColShortTAppConfigNftCreateTime    = "create_time"
+)
+
+// DBColTAppConfigNftAll 所有字段
+var DBColTAppConfigNftAll = []string{
+	"t_app_config_nft.id",
+	"t_app_config_nft.chain",
+	"t_app_config_nft.token_address",
+	"t_app_config_nft.token_standard",
+	"t_app_config_nft.token_symbol",
+	"t_app_config_nft.cold_address",
+	"t_app_config_nft.hot_address",
+	"t_app_config_nft.create_time",
+}
+
+// 表结构
+// DBTAppConfigNft t_app_config_nft
+/*
+   id,
+   chain,
+   token_address,
+   token_standard,
+   token_symbol,
+   cold_address,
+   hot_address,
+   create_time
+*/
+type DBTAppConfigNft struct {
+	ID            int64  `db:"id" json:"id"`
+	Chain         string `db:"chain" json:"chain"` // 所属evm链 t_app_config_chain.name
+	TokenAddress  string `db:"token_address" json:"token_address"`
+	TokenStandard int64  `db:"token_standard" json:"token_standard"` // 721 或 1155
+	TokenSymbol   string `db:"token_symbol" json:"token_symbol"`
+	ColdAddress   string `db:"cold_address" json:"cold_address"`
+	HotAddress    string `db:"hot_address" json:"hot_address"` // 热钱包地址，多个以逗号分隔
+	CreateTime    int64  `db:"create_time" json:"create_time"`
+}
+
 // const TAppConfigStr full
 const (
 	DBColTAppConfigStrID = "t_app_config_str.id"
@@ -143,37 +318,43 @@ type DBTAppConfigStr struct {
 
 // const TAppConfigToken full
 const (
-	DBColTAppConfigTokenID            = "t_app_config_token.id"
-	DBColTAppConfigTokenTokenAddress  = "t_app_config_token.token_address"
-	DBColTAppConfigTokenTokenDecimals = "t_app_config_token.token_decimals"
-	DBColTAppConfigTokenTokenSymbol   = "t_app_config_token.token_symbol"
-	DBColTAppConfigTokenColdAddress   = "t_app_config_token.cold_address"
-	DBColTAppConfigTokenHotAddress    = "t_app_config_token.hot_address"
-	DBColTAppConfigTokenOrgMinBalance = "t_app_config_token.org_min_balance"
-	DBColTAppConfigTokenCreateTime    = "t_app_config_token.create_time"
+	DBColTAppConfigTokenID             = "t_app_config_token.id"
+	DBColTAppConfigTokenChain          = "t_app_config_token.chain" // 所属evm链 t_app_config_chain.name
+	DBColTAppConfigTokenTokenAddress   = "t_app_config_token.token_address"
+	DBColTAppConfigTokenTokenDecimals  = "t_app_config_token.token_decimals"
+	DBColTAppConfigTokenTokenSymbol    = "t_app_config_token.token_symbol"
+	DBColTAppConfigTokenColdAddress    = "t_app_config_token.cold_address"
+	DBColTAppConfigTokenHotAddress     = "t_app_config_token.hot_address" // 热钱包地址，多个以逗号分隔
+	DBColTAppConfigTokenOrgMinBalance  = "t_app_config_token.org_min_balance"
+	DBColTAppConfigTokenOrgRealBalance = "t_app_config_token.org_real_balance" // 整理时以链上余额为准，用于转账扣费和rebase的token
+	DBColTAppConfigTokenCreateTime     = "t_app_config_token.create_time"
 )
 
 // const TAppConfigToken short
 const (
-	DBColShortTAppConfigTokenID            = "id"
-	DBColShortTAppConfigTokenTokenAddress  = "token_address"
-	DBColShortTAppConfigTokenTokenDecimals = "token_decimals"
-	DBColShortTAppConfigTokenTokenSymbol   = "token_symbol"
-	DBColShortTAppConfigTokenColdAddress   = "cold_address"
-	DBColShortTAppConfigTokenHotAddress    = "hot_address"
-	DBColShortTAppConfigTokenOrgMinBalance = "org_min_balance"
-	DBColShortTAppConfigTokenCreateTime    = "create_time"
+	DBColShortTAppConfigTokenID             = "id"
+	DBColShortTAppConfigTokenChain          = "chain" // 所属evm链 t_app_config_chain.name
+	DBColShortTAppConfigTokenTokenAddress   = "token_address"
+	DBColShortTAppConfigTokenTokenDecimals  = "token_decimals"
+	DBColShortTAppConfigTokenTokenSymbol    = "token_symbol"
+	DBColShortTAppConfigTokenColdAddress    = "cold_address"
+	DBColShortTAppConfigTokenHotAddress     = "hot_address" // 热钱包地址，多个以逗号分隔
+	DBColShortTAppConfigTokenOrgMinBalance  = "org_min_balance"
+	DBColShortTAppConfigTokenOrgRealBalance = "org_real_balance" // 整理时以链上余额为准，用于转账扣费和rebase的token
+	DBColShortTAppConfigTokenCreateTime     = "create_time"
 )
 
 // DBColTAppConfigTokenAll 所有字段
 var DBColTAppConfigTokenAll = []string{
 	"t_app_config_token.id",
+	"t_app_config_token.chain",
 	"t_app_config_token.token_address",
 	"t_app_config_token.token_decimals",
 	"t_app_config_token.token_symbol",
 	"t_app_config_token.cold_address",
 	"t_app_config_token.hot_address",
 	"t_app_config_token.org_min_balance",
+	"t_app_config_token.org_real_balance",
 	"t_app_config_token.create_time",
 }
 
@@ -181,23 +362,27 @@ var DBColTAppConfigTokenAll = []string{
 // DBTAppConfigToken t_app_config_token
 /*
    id,
+   chain,
    token_address,
    token_decimals,
    token_symbol,
    cold_address,
    hot_address,
    org_min_balance,
+   org_real_balance,
    create_time
 */
 type DBTAppConfigToken struct {
-	ID            int64  `db:"id" json:"id"`
-	TokenAddress  string `db:"token_address" json:"token_address"`
-	TokenDecimals int64  `db:"token_decimals" json:"token_decimals"`
-	TokenSymbol   string `db:"token_symbol" json:"token_symbol"`
-	ColdAddress   string `db:"cold_address" json:"cold_address"`
-	HotAddress    string `db:"hot_address" json:"hot_address"`
-	OrgMinBalance string `db:"org_min_balance" json:"org_min_balance"`
-	CreateTime    int64  `db:"create_time" json:"create_time"`
+	ID             int64  `db:"id" json:"id"`
+	Chain          string `db:"chain" json:"chain"` // 所属evm链 t_app_config_chain.name
+	TokenAddress   string `db:"token_address" json:"token_address"`
+	TokenDecimals  int64  `db:"token_decimals" json:"token_decimals"`
+	TokenSymbol    string `db:"token_symbol" json:"token_symbol"`
+	ColdAddress    string `db:"cold_address" json:"cold_address"`
+	HotAddress     string `db:"hot_address" json:"hot_address"` // 热钱包地址，多个以逗号分隔
+	OrgMinBalance  string `db:"org_min_balance" json:"org_min_balance"`
+	OrgRealBalance int64  `db:"org_real_balance" json:"org_real_balance"` // 整理时以链上余额为准，用于转账扣费和rebase的token
+	CreateTime     int64  `db:"create_time" json:"create_time"`
 }
 
 // const TAppConfigTokenBtc full
@@ -259,12 +444,123 @@ type DBTAppConfigTokenBtc struct {
 	CreateAt        int64  `db:"create_at" json:"create_at"`
 }
 
+// const TAppJob full
+const (
+	DBColTAppJobID             = "t_app_job.id"
+	DBColTAppJobName           = "t_app_job.name"            // 任务名称
+	DBColTAppJobSpec           = "t_app_job.spec"            // 运行间隔，为空时使用默认值
+	DBColTAppJobEnable         = "t_app_job.enable"          // 是否启用
+	DBColTAppJobTimeoutSeconds = "t_app_job.timeout_seconds" // 超时秒数，0为不限制
+)
+
+// const TAppJob short
+const (
+	DBColShortTAppJobID             = "id"
+	DBColShortTAppJobName           = "name"            // 任务名称
+	DBColShortTAppJobSpec           = "spec"            // 运行间隔，为空时使用默认值
+	DBColShortTAppJobEnable         = "enable"          // 是否启用
+	DBColShortTAppJobTimeoutSeconds = "timeout_seconds" // 超时秒数，0为不限制
+)
+
+// DBColTAppJobAll 所有字段
+var DBColTAppJobAll = []string{
+	"t_app_job.id",
+	"t_app_job.name",
+	"t_app_job.spec",
+	"t_app_job.enable",
+	"t_app_job.timeout_seconds",
+}
+
+// 表结构
+// DBTAppJob t_app_job
+/*
+   id,
+   name,
+   spec,
+   enable,
+   timeout_seconds
+*/
+type DBTAppJob struct {
+	ID             int64  `db:"id" json:"id"`
+	Name           string `db:"name" json:"name"`                       // 任务名称
+	Spec           string `db:"spec" json:"spec"`                       // 运行间隔，为空时使用默认值
+	Enable         int64  `db:"enable" json:"enable"`                   // 是否启用
+	TimeoutSeconds int64  `db:"timeout_seconds" json:"timeout_seconds"` // 超时秒数，0为不限制
+}
+
+// const TAppJobRun full
+const (
+	DBColTAppJobRunID          = "t_app_job_run.id"
+	DBColTAppJobRunName        = "t_app_job_run.name"         // 任务名称
+	DBColTAppJobRunInstance    = "t_app_job_run.instance"     // 运行实例 主机名-进程号
+	DBColTAppJobRunStartTime   = "t_app_job_run.start_time"   // 开始时间
+	DBColTAppJobRunEndTime     = "t_app_job_run.end_time"     // 结束时间
+	DBColTAppJobRunCostMs      = "t_app_job_run.cost_ms"      // 耗时毫秒
+	DBColTAppJobRunHandleCount = "t_app_job_run.handle_count" // 处理数量
+	DBColTAppJobRunLockSkipped = "t_app_job_run.lock_skipped" // 是否因未获取到锁跳过
+	DBColTAppJobRunErrMsg      = "t_app_job_run.err_msg"      // 错误信息
+)
+
+// const TAppJobRun short
+const (
+	DBColShortTAppJobRunID          = "id"
+	DBColShortTAppJobRunName        = "name"         // 任务名称
+	DBColShortTAppJobRunInstance    = "instance"     // 运行实例 主机名-进程号
+	DBColShortTAppJobRunStartTime   = "start_time"   // 开始时间
+	DBColShortTAppJobRunEndTime     = "end_time"     // 结束时间
+	DBColShortTAppJobRunCostMs      = "cost_ms"      // 耗时毫秒
+	DBColShortTAppJobRunHandleCount = "handle_count" // 处理数量
+	DBColShortTAppJobRunLockSkipped = "lock_skipped" // 是否因未获取到锁跳过
+	DBColShortTAppJobRunErrMsg      = "err_msg"      // 错误信息
+)
+
+// DBColTAppJobRunAll 所有字段
+var DBColTAppJobRunAll = []string{
+	"t_app_job_run.id",
+	"t_app_job_run.name",
+	"t_app_job_run.instance",
+	"t_app_job_run.start_time",
+	"t_app_job_run.end_time",
+	"t_app_job_run.cost_ms",
+	"t_app_job_run.handle_count",
+	"t_app_job_run.lock_skipped",
+	"t_app_job_run.err_msg",
+}
+
+// 表结构
+// DBTAppJobRun t_app_job_run
+/*
+   id,
+   name,
+   instance,
+   start_time,
+   end_time,
+   cost_ms,
+   handle_count,
+   lock_skipped,
+   err_msg
+*/
+type DBTAppJobRun struct {
+	ID          int64  `db:"id" json:"id"`
+	Name        string `db:"name" json:"name"`                 // 任务名称
+	Instance    string `db:"instance" json:"instance"`         // 运行实例 主机名-进程号
+	StartTime   int64  `db:"start_time" json:"start_time"`     // 开始时间
+	EndTime     int64  `db:"end_time" json:"end_time"`         // 结束时间
+	CostMs      int64  `db:"cost_ms" json:"cost_ms"`           // 耗时毫秒
+	HandleCount int64  `db:"handle_count" json:"handle_count"` // 处理数量
+	LockSkipped int64  `db:"lock_skipped" json:"lock_skipped"` // 是否因未获取到锁跳过
+	ErrMsg      string `db:"err_msg" json:"err_msg"`           // 错误信息
+}
+
 // const TAppLock full
 const (
 	DBColTAppLockID         = "t_app_lock.id"
 	DBColTAppLockK          = "t_app_lock.k"           // 上锁键值
 	DBColTAppLockV          = "t_app_lock.v"           // 是否锁定
+	DBColTAppLockOwner      = "t_app_lock.owner"       // 持有者
 	DBColTAppLockCreateTime = "t_app_lock.create_time" // 上锁时间
+	DBColTAppLockExpireTime = "t_app_lock.expire_time" // 租约到期时间
+	DBColTAppLockUpdateTime = "t_app_lock.update_time" // 最后续约时间
 )
 
 // const TAppLock short
@@ -272,7 +568,10 @@ const (
 	DBColShortTAppLockID         = "id"
 	DBColShortTAppLockK          = "k"           // 上锁键值
 	DBColShortTAppLockV          = "v"           // 是否锁定
+	DBColShortTAppLockOwner      = "owner"       // 持有者
 	DBColShortTAppLockCreateTime = "create_time" // 上锁时间
+	DBColShortTAppLockExpireTime = "expire_time" // 租约到期时间
+	DBColShortTAppLockUpdateTime = "update_time" // 最后续约时间
 )
 
 // DBColTAppLockAll 所有字段
@@ -280,7 +579,10 @@ var DBColTAppLockAll = []string{
 	"t_app_lock.id",
 	"t_app_lock.k",
 	"t_app_lock.v",
+	"t_app_lock.owner",
 	"t_app_lock.create_time",
+	"t_app_lock.expire_time",
+	"t_app_lock.update_time",
 }
 
 // 表结构
@@ -289,13 +591,19 @@ var DBColTAppLockAll = []string{
    id,
    k,
    v,
-   create_time
+   owner,
+   create_time,
+   expire_time,
+   update_time
 */
 type DBTAppLock struct {
 	ID         int64  `db:"id" json:"id"`
 	K          string `db:"k" json:"k"`                     // 上锁键值
 	V          int64  `db:"v" json:"v"`                     // 是否锁定
+	Owner      string `db:"owner" json:"owner"`             // 持有者
 	CreateTime int64  `db:"create_time" json:"create_time"` // 上锁时间
+	ExpireTime int64  `db:"expire_time" json:"expire_time"` // 租约到期时间
+	UpdateTime int64  `db:"update_time" json:"update_time"` // 最后续约时间
 }
 
 // const TAppStatusInt full
@@ -332,22 +640,210 @@ type DBTAppStatusInt struct {
 	V  int64  `db:"v" json:"v"` // 配置键值
 }
 
+// const TBtcBlock full
+const (
+	DBColTBtcBlockID          = "t_btc_block.id"
+	DBColTBtcBlockSeekKey     = "t_btc_block.seek_key"     // 记录该区块的检测任务进度键 t_app_status_int.k
+	DBColTBtcBlockBlockNumber = "t_btc_block.block_number" // 区块高度
+	DBColTBtcBlockBlockHash   = "t_btc_block.block_hash"   // 区块hash
+	DBColTBtcBlockParentHash  = "t_btc_block.parent_hash"  // 父区块hash
+	DBColTBtcBlockCreateTime  = "t_btc_block.create_time"  // 创建时间戳
+)
+
+// const TBtcBlock short
+const (
+	DBColShortTBtcBlockID          = "id"
+	DBColShortTBtcBlockSeekKey     = "seek_key"     // 记录该区块的检测任务进度键 t_app_status_int.k
+	DBColShortTBtcBlockBlockNumber = "block_number" // 区块高度
+	DBColShortTBtcBlockBlockHash   = "block_hash"   // 区块hash
+	DBColShortTBtcBlockParentHash  = "parent_hash"  // 父区块hash
+	DBColShortTBtcBlockCreateTime  = "create_time"  // 创建时间戳
+)
+
+// DBColTBtcBlockAll 所有字段
+var DBColTBtcBlockAll = []string{
+	"t_btc_block.id",
+	"t_btc_block.seek_key",
+	"t_btc_block.block_number",
+	"t_btc_block.block_hash",
+	"t_btc_block.parent_hash",
+	"t_btc_block.create_time",
+}
+
+// 表结构
+// DBTBtcBlock t_btc_block
+/*
+   id,
+   seek_key,
+   block_number,
+   block_hash,
+   parent_hash,
+   create_time
+*/
+type DBTBtcBlock struct {
+	ID          int64  `db:"id" json:"id"`
+	SeekKey     string `db:"seek_key" json:"seek_key"`         // 记录该区块的检测任务进度键 t_app_status_int.k
+	BlockNumber int64  `db:"block_number" json:"block_number"` // 区块高度
+	BlockHash   string `db:"block_hash" json:"block_hash"`     // 区块hash
+	ParentHash  string `db:"parent_hash" json:"parent_hash"`   // 父区块hash
+	CreateTime  int64  `db:"create_time" json:"create_time"`   // 创建时间戳
+}
+
+// const TEthBlock full
+const (
+	DBColTEthBlockID          = "t_eth_block.id"
+	DBColTEthBlockChain       = "t_eth_block.chain"        // 所属evm链 t_app_config_chain.name
+	DBColTEthBlockBlockNumber = "t_eth_block.block_number" // 区块高度
+	DBColTEthBlockBlockHash   = "t_eth_block.block_hash"   // 区块hash
+	DBColTEthBlockParentHash  = "t_eth_block.parent_hash"  // 父区块hash
+	DBColTEthBlockCreateTime  = "t_eth_block.create_time"  // 创建时间戳
+)
+
+// const TEthBlock short
+const (
+	DBColShortTEthBlockID          = "id"
+	DBColShortTEthBlockChain       = "chain"        // 所属evm链 t_app_config_chain.name
+	DBColShortTEthBlockBlockNumber = "block_number" // 区块高度
+	DBColShortTEthBlockBlockHash   = "block_hash"   // 区块hash
+	DBColShortTEthBlockParentHash  = "parent_hash"  // 父区块hash
+	DBColShortTEthBlockCreateTime  = "create_time"  // 创建时间戳
+)
+
+// DBColTEthBlockAll 所有字段
+var DBColTEthBlockAll = []string{
+	"t_eth_block.id",
+	"t_eth_block.chain",
+	"t_eth_block.block_number",
+	"t_eth_block.block_hash",
+	"t_eth_block.parent_hash",
+	"t_eth_block.create_time",
+}
+
+// 表结构
+// DBTEthBlock t_eth_block
+/*
+   id,
+   chain,
+   block_number,
+   block_hash,
+   parent_hash,
+   create_time
+*/
+type DBTEthBlock struct {
+	ID          int64  `db:"id" json:"id"`
+	Chain       string `db:"chain" json:"chain"`               // 所属evm链 t_app_config_chain.name
+	BlockNumber int64  `db:"block_number" json:"block_number"` // 区块高度
+	BlockHash   string `db:"block_hash" json:"block_hash"`     // 区块hash
+	ParentHash  string `db:"parent_hash" json:"parent_hash"`   // 父区块hash
+	CreateTime  int64  `db:"create_time" json:"create_time"`   // 创建时间戳
+}
+
+// const TEthForwarder full
+const (
+	DBColTEthForwarderID             = "t_eth_forwarder.id"
+	DBColTEthForwarderAddress        = "t_eth_forwarder.address"         // forwarder地址
+	DBColTEthForwarderSalt           = "t_eth_forwarder.salt"            // create2 salt
+	DBColTEthForwarderFactoryAddress = "t_eth_forwarder.factory_address" // 工厂合约地址
+	DBColTEthForwarderCreateTime     = "t_eth_forwarder.create_time"     // 创建时间
+)
+
+// const TEthForwarder short
+const (
+	DBColShortTEthForwarderID             = "id"
+	DBColShortTEthForwarderAddress        = "address"         // forwarder地址
+	DBColShortTEthForwarderSalt           = "salt"            // create2 salt
+	DBColShortTEthForwarderFactoryAddress = "factory_address" // 工厂合约地址
+	DBColShortTEthForwarderCreateTime     = "create_time"     // 创建时间
+)
+
+// DBColTEthForwarderAll 所有字段
+var DBColTEthForwarderAll = []string{
+	"t_eth_forwarder.id",
+	"t_eth_forwarder.address",
+	"t_eth_forwarder.salt",
+	"t_eth_forwarder.factory_address",
+	"t_eth_forwarder.create_time",
+}
+
+// 表结构
+// DBTEthForwarder t_eth_forwarder
+/*
+   id,
+   address,
+   salt,
+   factory_address,
+   create_time
+*/
+type DBTEthForwarder struct {
+	ID             int64  `db:"id" json:"id"`
+	Address        string `db:"address" json:"address"`                 // forwarder地址
+	Salt           string `db:"salt" json:"salt"`                       // create2 salt
+	FactoryAddress string `db:"factory_address" json:"factory_address"` // 工厂合约地址
+	CreateTime     int64  `db:"create_time" json:"create_time"`         // 创建时间
+}
+
+// const TEthNonce full
+const (
+	DBColTEthNonceID         = "t_eth_nonce.id"
+	DBColTEthNonceChain      = "t_eth_nonce.chain"       // 所属evm链 t_app_config_chain.name
+	DBColTEthNonceAddress    = "t_eth_nonce.address"     // 打币地址
+	DBColTEthNonceNonce      = "t_eth_nonce.nonce"       // 最后分配的nonce
+	DBColTEthNonceUpdateTime = "t_eth_nonce.update_time" // 更新时间
+)
+
+// const TEthNonce short
+const (
+	DBColShortTEthNonceID         = "id"
+	DBColShortTEthNonceChain      = "chain"       // 所属evm链 t_app_config_chain.name
+	DBColShortTEthNonceAddress    = "address"     // 打币地址
+	DBColShortTEthNonceNonce      = "nonce"       // 最后分配的nonce
+	DBColShortTEthNonceUpdateTime = "update_time" // 更新时间
+)
+
+// DBColTEthNonceAll 所有字段
+var DBColTEthNonceAll = []string{
+	"t_eth_nonce.id",
+	"t_eth_nonce.chain",
+	"t_eth_nonce.address",
+	"t_eth_nonce.nonce",
+	"t_eth_nonce.update_time",
+}
+
+// 表结构
+// DBTEthNonce t_eth_nonce
+/*
+   id,
+   chain,
+   address,
+   nonce,
+   update_time
+*/
+type DBTEthNonce struct {
+	ID         int64  `db:"id" json:"id"`
+	Chain      string `db:"chain" json:"chain"`             // 所属evm链 t_app_config_chain.name
+	Address    string `db:"address" json:"address"`         // 打币地址
+	Nonce      int64  `db:"nonce" json:"nonce"`             // 最后分配的nonce
+	UpdateTime int64  `db:"update_time" json:"update_time"` // 更新时间
+}
+
 // const TProduct full
 const (
-	DBColTProductID          = "t_product.id"
-	DBColTProductAppName     = "t_product.app_name"     // 应用名
-	DBColTProductAppSk       = "t_product.app_sk"       // 应用私钥
-	DBColTProductCbURL       = "t_product.cb_url"       // 回调地址
-	DBColTProductWhitelistIP = "t_product.whitelist_ip" // ip白名单
+	DBColTProductID            = "t_product.id"
+	DBColTProductAppName       = "t_product.app_name"       // 应用名
+	DBColTProductAppSk         = "t_product.app_sk"         // 应用私钥
+	DBColTProductCbURL         = "t_product.cb_url"         // 回调地址
+	DBColTProductWhitelistIP   = "t_product.whitelist_ip"   // ip白名单
+	DBColTProductNotifyVersion = "t_product.notify_version" // 回调数据版本
 )
 
 // const TProduct short
 const (
-	DBColShortTProductID          = "id"
-	DBColShortTProductAppName     = "app_name"     // 应用名
-	DBColShortTProductAppSk       = "app_sk"       // 应用私钥
-	DBColShortTProductCbURL       = "cb_url"       // 回调地址
-	DBColShortTProductWhitelistIP = "whitelist_ip" // ip白名单
+	DBColShortTProductID            = "id"
+	DBColShortTProductAppName       = "app_name"       // 应用名
+	DBColShortTProductAppSk         = "app_sk"         // 应用私钥
+	DBColShortTProductCbURL         = "cb_url"         // 回调地址
+	DBColShortTProductWhitelistIP   = "whitelist_ip"   // ip白名单
+	DBColShortTProductNotifyVersion = "notify_version" // 回调数据版本
 )
 
 // DBColTProductAll 所有字段
@@ -357,6 +853,7 @@ var DBColTProductAll = []string{
 	"t_product.app_sk",
 	"t_product.cb_url",
 	"t_product.whitelist_ip",
+	"t_product.notify_version",
 }
 
 // 表结构
@@ -366,14 +863,16 @@ var DBColTProductAll = []string{
    app_name,
    app_sk,
    cb_url,
-   whitelist_ip
+   whitelist_ip,
+   notify_version
 */
 type DBTProduct struct {
-	ID          int64  `db:"id" json:"id"`
-	AppName     string `db:"app_name" json:"app_name"`         // 应用名
-	AppSk       string `db:"app_sk" json:"app_sk"`             // 应用私钥
-	CbURL       string `db:"cb_url" json:"cb_url"`             // 回调地址
-	WhitelistIP string `db:"whitelist_ip" json:"whitelist_ip"` // ip白名单
+	ID            int64  `db:"id" json:"id"`
+	AppName       string `db:"app_name" json:"app_name"`             // 应用名
+	AppSk         string `db:"app_sk" json:"app_sk"`                 // 应用私钥
+	CbURL         string `db:"cb_url" json:"cb_url"`                 // 回调地址
+	WhitelistIP   string `db:"whitelist_ip" json:"whitelist_ip"`     // ip白名单
+	NotifyVersion int64  `db:"notify_version" json:"notify_version"` // 回调数据版本
 }
 
 // const TProductNonce full
@@ -496,47 +995,58 @@ type DBTProductNotify struct {
 
 // const TSend full
 const (
-	DBColTSendID           = "t_send.id"
-	DBColTSendRelatedType  = "t_send.related_type" // 关联类型 1 零钱整理 2 提币
-	DBColTSendRelatedID    = "t_send.related_id"   // 关联id
-	DBColTSendTokenID      = "t_send.token_id"
-	DBColTSendTxID         = "t_send.tx_id"         // tx hash
-	DBColTSendFromAddress  = "t_send.from_address"  // 打币地址
-	DBColTSendToAddress    = "t_send.to_address"    // 收币地址
-	DBColTSendBalanceReal  = "t_send.balance_real"  // 打币金额 Ether
-	DBColTSendGas          = "t_send.gas"           // gas消耗
-	DBColTSendGasPrice     = "t_send.gas_price"     // gasPrice
-	DBColTSendNonce        = "t_send.nonce"         // nonce
-	DBColTSendHex          = "t_send.hex"           // tx raw hex
-	DBColTSendCreateTime   = "t_send.create_time"   // 创建时间
-	DBColTSendHandleStatus = "t_send.handle_status" // 处理状态
-	DBColTSendHandleMsg    = "t_send.handle_msg"    // 处理消息
-	DBColTSendHandleTime   = "t_send.handle_time"   // 处理时间
+	DBColTSendID                   = "t_send.id"
+	DBColTSendChain                = "t_send.chain"        // 所属evm链 t_app_config_chain.name
+	DBColTSendRelatedType          = "t_send.related_type" // 关联类型 1 零钱整理 2 提币
+	DBColTSendRelatedID            = "t_send.related_id"   // 关联id
+	DBColTSendTokenID              = "t_send.token_id"
+	DBColTSendTxID                 = "t_send.tx_id"                    // tx hash
+	DBColTSendFromAddress          = "t_send.from_address"             // 打币地址
+	DBColTSendToAddress            = "t_send.to_address"               // 收币地址
+	DBColTSendBalanceReal          = "t_send.balance_real"             // 打币金额 Ether
+	DBColTSendGas                  = "t_send.gas"                      // gas limit
+	DBColTSendGasEstimate          = "t_send.gas_estimate"             // eth_estimateGas 估算值，0 为未估算
+	DBColTSendGasUsed              = "t_send.gas_used"                 // 交易回执中实际消耗的gas
+	DBColTSendGasPrice             = "t_send.gas_price"                // gasPrice
+	DBColTSendMaxFeePerGas         = "t_send.max_fee_per_gas"          // EIP-1559 maxFeePerGas，传统交易为0
+	DBColTSendMaxPriorityFeePerGas = "t_send.max_priority_fee_per_gas" // EIP-1559 maxPriorityFeePerGas，传统交易为0
+	DBColTSendNonce                = "t_send.nonce"                    // nonce
+	DBColTSendHex                  = "t_send.hex"                      // tx raw hex
+	DBColTSendCreateTime           = "t_send.create_time"              // 创建时间
+	DBColTSendHandleStatus         = "t_send.handle_status"            // 处理状态
+	DBColTSendHandleMsg            = "t_send.handle_msg"               // 处理消息
+	DBColTSendHandleTime           = "t_send.handle_time"              // 处理时间
 )
 
 // const TSend short
 const (
-	DBColShortTSendID           = "id"
-	DBColShortTSendRelatedType  = "related_type" // 关联类型 1 零钱整理 2 提币
-	DBColShortTSendRelatedID    = "related_id"   // 关联id
-	DBColShortTSendTokenID      = "token_id"
-	DBColShortTSendTxID         = "tx_id"         // tx hash
-	DBColShortTSendFromAddress  = "from_address"  // 打币地址
-	DBColShortTSendToAddress    = "to_address"    // 收币地址
-	DBColShortTSendBalanceReal  = "balance_real"  // 打币金额 Ether
-	DBColShortTSendGas          = "gas"           // gas消耗
-	DBColShortTSendGasPrice     = "gas_price"     // gasPrice
-	DBColShortTSendNonce        = "nonce"         // nonce
-	DBColShortTSendHex          = "hex"           // tx raw hex
-	DBColShortTSendCreateTime   = "create_time"   // 创建时间
-	DBColShortTSendHandleStatus = "handle_status" // 处理状态
-	DBColShortTSendHandleMsg    = "handle_msg"    // 处理消息
-	DBColShortTSendHandleTime   = "handle_time"   // 处理时间
+	DBColShortTSendID                   = "id"
+	DBColShortTSendChain                = "chain"        // 所属evm链 t_app_config_chain.name
+	DBColShortTSendRelatedType          = "related_type" // 关联类型 1 零钱整理 2 提币
+	DBColShortTSendRelatedID            = "related_id"   // 关联id
+	DBColShortTSendTokenID              = "token_id"
+	DBColShortTSendTxID                 = "tx_id"                    // tx hash
+	DBColShortTSendFromAddress          = "from_address"             // 打币地址
+	DBColShortTSendToAddress            = "to_address"               // 收币地址
+	DBColShortTSendBalanceReal          = "balance_real"             // 打币金额 Ether
+	DBColShortTSendGas                  = "gas"                      // gas limit
+	DBColShortTSendGasEstimate          = "gas_estimate"             // eth_estimateGas 估算值，0 为未估算
+	DBColShortTSendGasUsed              = "gas_used"                 // 交易回执中实际消耗的gas
+	DBColShortTSendGasPrice             = "gas_price"                // gasPrice
+	DBColShortTSendMaxFeePerGas         = "max_fee_per_gas"          // EIP-1559 maxFeePerGas，传统交易为0
+	DBColShortTSendMaxPriorityFeePerGas = "max_priority_fee_per_gas" // EIP-1559 maxPriorityFeePerGas，传统交易为0
+	DBColShortTSendNonce                = "nonce"                    // nonce
+	DBColShortTSendHex                  = "hex"                      // tx raw hex
+	DBColShortTSendCreateTime           = "create_time"              // 创建时间
+	DBColShortTSendHandleStatus         = "handle_status"            // 处理状态
+	DBColShortTSendHandleMsg            = "handle_msg"               // 处理消息
+	DBColShortTSendHandleTime           = "handle_time"              // 处理时间
 )
 
 // DBColTSendAll 所有字段
 var DBColTSendAll = []string{
 	"t_send.id",
+	"t_send.chain",
 	"t_send.related_type",
 	"t_send.related_id",
 	"t_send.token_id",
@@ -545,7 +1055,11 @@ var DBColTSendAll = []string{
 	"t_send.to_address",
 	"t_send.balance_real",
 	"t_send.gas",
+	"t_send.gas_estimate",
+	"t_send.gas_used",
 	"t_send.gas_price",
+	"t_send.max_fee_per_gas",
+	"t_send.max_priority_fee_per_gas",
 	"t_send.nonce",
 	"t_send.hex",
 	"t_send.create_time",
@@ -558,6 +1072,7 @@ var DBColTSendAll = []string{
 // DBTSend t_send
 /*
    id,
+   chain,
    related_type,
    related_id,
    token_id,
@@ -566,7 +1081,11 @@ var DBColTSendAll = []string{
    to_address,
    balance_real,
    gas,
+   gas_estimate,
+   gas_used,
    gas_price,
+   max_fee_per_gas,
+   max_priority_fee_per_gas,
    nonce,
    hex,
    create_time,
@@ -575,22 +1094,27 @@ var DBColTSendAll = []string{
    handle_time
 */
 type DBTSend struct {
-	ID           int64  `db:"id" json:"id"`
-	RelatedType  int64  `db:"related_type" json:"related_type"` // 关联类型 1 零钱整理 2 提币
-	RelatedID    int64  `db:"related_id" json:"related_id"`     // 关联id
-	TokenID      int64  `db:"token_id" json:"token_id"`
-	TxID         string `db:"tx_id" json:"tx_id"`                 // tx hash
-	FromAddress  string `db:"from_address" json:"from_address"`   // 打币地址
-	ToAddress    string `db:"to_address" json:"to_address"`       // 收币地址
-	BalanceReal  string `db:"balance_real" json:"balance_real"`   // 打币金额 Ether
-	Gas          int64  `db:"gas" json:"gas"`                     // gas消耗
-	GasPrice     int64  `db:"gas_price" json:"gas_price"`         // gasPrice
-	Nonce        int64  `db:"nonce" json:"nonce"`                 // nonce
-	Hex          string `db:"hex" json:"hex"`                     // tx raw hex
-	CreateTime   int64  `db:"create_time" json:"create_time"`     // 创建时间
-	HandleStatus int64  `db:"handle_status" json:"handle_status"` // 处理状态
-	HandleMsg    string `db:"handle_msg" json:"handle_msg"`       // 处理消息
-	HandleTime   int64  `db:"handle_time" json:"handle_time"`     // 处理时间
+	ID                   int64  `db:"id" json:"id"`
+	Chain                string `db:"chain" json:"chain"`               // 所属evm链 t_app_config_chain.name
+	RelatedType          int64  `db:"related_type" json:"related_type"` // 关联类型 1 零钱整理 2 提币
+	RelatedID            int64  `db:"related_id" json:"related_id"`     // 关联id
+	TokenID              int64  `db:"token_id" json:"token_id"`
+	TxID                 string `db:"tx_id" json:"tx_id"`                                       // tx hash
+	FromAddress          string `db:"from_address" json:"from_address"`                         // 打币地址
+	ToAddress            string `db:"to_address" json:"to_address"`                             // 收币地址
+	BalanceReal          string `db:"balance_real" json:"balance_real"`                         // 打币金额 Ether
+	Gas                  int64  `db:"gas" json:"gas"`                                           // gas limit
+	GasEstimate          int64  `db:"gas_estimate" json:"gas_estimate"`                         // eth_estimateGas 估算值，0 为未估算
+	GasUsed              int64  `db:"gas_used" json:"gas_used"`                                 // 交易回执中实际消耗的gas
+	GasPrice             int64  `db:"gas_price" json:"gas_price"`                               // gasPrice
+	MaxFeePerGas         int64  `db:"max_fee_per_gas" json:"max_fee_per_gas"`                   // EIP-1559 maxFeePerGas，传统交易为0
+	MaxPriorityFeePerGas int64  `db:"max_priority_fee_per_gas" json:"max_priority_fee_per_gas"` // EIP-1559 maxPriorityFeePerGas，传统交易为0
+	Nonce                int64  `db:"nonce" json:"nonce"`                                       // nonce
+	Hex                  string `db:"hex" json:"hex"`                                           // tx raw hex
+	CreateTime           int64  `db:"create_time" json:"create_time"`                           // 创建时间
+	HandleStatus         int64  `db:"handle_status" json:"handle_status"`                       // 处理状态
+	HandleMsg            string `db:"handle_msg" json:"handle_msg"`                             // 处理消息
+	HandleTime           int64  `db:"handle_time" json:"handle_time"`                           // 处理时间
 }
 
 // const TSendBtc full
@@ -771,11 +1295,113 @@ type DBTSendEos struct {
 	HandleAt     int64  `db:"handle_at" json:"handle_at"`         // 处理时间
 }
 
+// const TSendReplace full
+const (
+	DBColTSendReplaceID                   = "t_send_replace.id"
+	DBColTSendReplaceSendID               = "t_send_replace.send_id"                  // t_send.id 发送数据id
+	DBColTSendReplaceTxID                 = "t_send_replace.tx_id"                    // 被替换的 tx hash
+	DBColTSendReplaceReplaceTxID          = "t_send_replace.replace_tx_id"            // 替换后的 tx hash
+	DBColTSendReplaceNonce                = "t_send_replace.nonce"                    // nonce
+	DBColTSendReplaceGasPrice             = "t_send_replace.gas_price"                // 被替换交易的 gasPrice
+	DBColTSendReplaceMaxPriorityFeePerGas = "t_send_replace.max_priority_fee_per_gas" // 被替换交易的 maxPriorityFeePerGas
+	DBColTSendReplaceCreateTime           = "t_send_replace.create_time"              // 替换时间
+)
+
+// const TSendReplace short
+const (
+	DBColShortTSendReplaceID                   = "id"
+	DBColShortTSendReplaceSendID               = "send_id"                  // t_send.id 发送数据id
+	DBColShortTSendReplaceTxID                 = "tx_id"                    // 被替换的 tx hash
+	DBColShortTSendReplaceReplaceTxID          = "replace_tx_id"            // 替换后的 tx hash
+	DBColShortTSendReplaceNonce                = "nonce"                    // nonce
+	DBColShortTSendReplaceGasPrice             = "gas_price"                // 被替换交易的 gasPrice
+	DBColShortTSendReplaceMaxPriorityFeePerGas = "max_priority_fee_per_gas" // 被替换交易的 maxPriorityFeePerGas
+	DBColShortTSendReplaceCreateTime           = "create_time"              // 替换时间
+)
+
+// DBColTSendReplaceAll 所有字段
+var DBColTSendReplaceAll = []string{
+	"t_send_replace.id",
+	"t_send_replace.send_id",
+	"t_send_replace.tx_id",
+	"t_send_replace.replace_tx_id",
+	"t_send_replace.nonce",
+	"t_send_replace.gas_price",
+	"t_send_replace.max_priority_fee_per_gas",
+	"t_send_replace.create_time",
+}
+
+// 表结构
+// DBTSendReplace t_send_replace
+/*
+   id,
+   send_id,
+   tx_id,
+   replace_tx_id,
+   nonce,
+   gas_price,
+   max_priority_fee_per_gas,
+   create_time
+*/
+type DBTSendReplace struct {
+	ID                   int64  `db:"id" json:"id"`
+	SendID               int64  `db:"send_id" json:"send_id"`                                   // t_send.id 发送数据id
+	TxID                 string `db:"tx_id" json:"tx_id"`                                       // 被替换的 tx hash
+	ReplaceTxID          string `db:"replace_tx_id" json:"replace_tx_id"`                       // 替换后的 tx hash
+	Nonce                int64  `db:"nonce" json:"nonce"`                                       // nonce
+	GasPrice             int64  `db:"gas_price" json:"gas_price"`                               // 被替换交易的 gasPrice
+	MaxPriorityFeePerGas int64  `db:"max_priority_fee_per_gas" json:"max_priority_fee_per_gas"` // 被替换交易的 maxPriorityFeePerGas
+	CreateTime           int64  `db:"create_time" json:"create_time"`                           // 替换时间
+}
+
+// const TSendWithdraw full
+const (
+	DBColTSendWithdrawID         = "t_send_withdraw.id"
+	DBColTSendWithdrawSendID     = "t_send_withdraw.send_id"     // t_send.id 批量提币的发送数据id
+	DBColTSendWithdrawWithdrawID = "t_send_withdraw.withdraw_id" // t_withdraw.id 提币id
+	DBColTSendWithdrawCreateTime = "t_send_withdraw.create_time" // 创建时间
+)
+
+// const TSendWithdraw short
+const (
+	DBColShortTSendWithdrawID         = "id"
+	DBColShortTSendWithdrawSendID     = "send_id"     // t_send.id 批量提币的发送数据id
+	DBColShortTSendWithdrawWithdrawID = "withdraw_id" // t_withdraw.id 提币id
+	DBColShortTSendWithdrawCreateTime = "create_time" // 创建时间
+)
+
+// DBColTSendWithdrawAll 所有字段
+var DBColTSendWithdrawAll = []string{
+	"t_send_withdraw.id",
+	"t_send_withdraw.send_id",
+	"t_send_withdraw.withdraw_id",
+	"t_send_withdraw.create_time",
+}
+
+// 表结构
+// DBTSendWithdraw t_send_withdraw
+/*
+   id,
+   send_id,
+   withdraw_id,
+   create_time
+*/
+type DBTSendWithdraw struct {
+	ID         int64 `db:"id" json:"id"`
+	SendID     int64 `db:"send_id" json:"send_id"`         // t_send.id 批量提币的发送数据id
+	WithdrawID int64 `db:"withdraw_id" json:"withdraw_id"` // t_withdraw.id 提币id
+	CreateTime int64 `db:"create_time" json:"create_time"` // 创建时间
+}
+
 // const TTx full
 const (
 	DBColTTxID           = "t_tx.id"
+	DBColTTxChain        = "t_tx.chain" // 所属evm链 t_app_config_chain.name
 	DBColTTxProductID    = "t_tx.product_id"
+	DBColTTxBlockNumber  = "t_tx.block_number"  // 区块高度
+	DBColTTxBlockHash    = "t_tx.block_hash"    // 区块hash
 	DBColTTxTxID         = "t_tx.tx_id"         // 交易id
+	DBColTTxTraceAddress = "t_tx.trace_address" // 合约内部转账的调用位置，交易本身的转账为空
 	DBColTTxFromAddress  = "t_tx.from_address"  // 来源地址
 	DBColTTxToAddress    = "t_tx.to_address"    // 目标地址
 	DBColTTxBalanceReal  = "t_tx.balance_real"  // 到账金额Ether
@@ -791,8 +1417,12 @@ const (
 // const TTx short
 const (
 	DBColShortTTxID           = "id"
+	DBColShortTTxChain        = "chain" // 所属evm链 t_app_config_chain.name
 	DBColShortTTxProductID    = "product_id"
+	DBColShortTTxBlockNumber  = "block_number"  // 区块高度
+	DBColShortTTxBlockHash    = "block_hash"    // 区块hash
 	DBColShortTTxTxID         = "tx_id"         // 交易id
+	DBColShortTTxTraceAddress = "trace_address" // 合约内部转账的调用位置，交易本身的转账为空
 	DBColShortTTxFromAddress  = "from_address"  // 来源地址
 	DBColShortTTxToAddress    = "to_address"    // 目标地址
 	DBColShortTTxBalanceReal  = "balance_real"  // 到账金额Ether
@@ -808,8 +1438,12 @@ const (
 // DBColTTxAll 所有字段
 var DBColTTxAll = []string{
 	"t_tx.id",
+	"t_tx.chain",
 	"t_tx.product_id",
+	"t_tx.block_number",
+	"t_tx.block_hash",
 	"t_tx.tx_id",
+	"t_tx.trace_address",
 	"t_tx.from_address",
 	"t_tx.to_address",
 	"t_tx.balance_real",
@@ -826,8 +1460,12 @@ var DBColTTxAll = []string{
 // DBTTx t_tx
 /*
    id,
+   chain,
    product_id,
+   block_number,
+   block_hash,
    tx_id,
+   trace_address,
    from_address,
    to_address,
    balance_real,
@@ -841,8 +1479,12 @@ var DBColTTxAll = []string{
 */
 type DBTTx struct {
 	ID           int64  `db:"id" json:"id"`
+	Chain        string `db:"chain" json:"chain"` // 所属evm链 t_app_config_chain.name
 	ProductID    int64  `db:"product_id" json:"product_id"`
+	BlockNumber  int64  `db:"block_number" json:"block_number"`   // 区块高度
+	BlockHash    string `db:"block_hash" json:"block_hash"`       // 区块hash
 	TxID         string `db:"tx_id" json:"tx_id"`                 // 交易id
+	TraceAddress string `db:"trace_address" json:"trace_address"` // 合约内部转账的调用位置，交易本身的转账为空
 	FromAddress  string `db:"from_address" json:"from_address"`   // 来源地址
 	ToAddress    string `db:"to_address" json:"to_address"`       // 目标地址
 	BalanceReal  string `db:"balance_real" json:"balance_real"`   // 到账金额Ether
@@ -859,6 +1501,7 @@ type DBTTx struct {
 const (
 	DBColTTxBtcID           = "t_tx_btc.id"
 	DBColTTxBtcProductID    = "t_tx_btc.product_id"
+	DBColTTxBtcBlockNumber  = "t_tx_btc.block_number"
 	DBColTTxBtcBlockHash    = "t_tx_btc.block_hash"
 	DBColTTxBtcTxID         = "t_tx_btc.tx_id"
 	DBColTTxBtcVoutN        = "t_tx_btc.vout_n"
@@ -874,6 +1517,7 @@ const (
 const (
 	DBColShortTTxBtcID           = "id"
 	DBColShortTTxBtcProductID    = "product_id"
+	DBColShortTTxBtcBlockNumber  = "block_number"
 	DBColShortTTxBtcBlockHash    = "block_hash"
 	DBColShortTTxBtcTxID         = "tx_id"
 	DBColShortTTxBtcVoutN        = "vout_n"
@@ -889,6 +1533,7 @@ const (
 var DBColTTxBtcAll = []string{
 	"t_tx_btc.id",
 	"t_tx_btc.product_id",
+	"t_tx_btc.block_number",
 	"t_tx_btc.block_hash",
 	"t_tx_btc.tx_id",
 	"t_tx_btc.vout_n",
@@ -905,6 +1550,7 @@ var DBColTTxBtcAll = []string{
 /*
    id,
    product_id,
+   block_number,
    block_hash,
    tx_id,
    vout_n,
@@ -918,6 +1564,7 @@ var DBColTTxBtcAll = []string{
 type DBTTxBtc struct {
 	ID           int64  `db:"id" json:"id"`
 	ProductID    int64  `db:"product_id" json:"product_id"`
+	BlockNumber  int64  `db:"block_number" json:"block_number"`
 	BlockHash    string `db:"block_hash" json:"block_hash"`
 	TxID         string `db:"tx_id" json:"tx_id"`
 	VoutN        int64  `db:"vout_n" json:"vout_n"`
@@ -935,6 +1582,7 @@ const (
 	DBColTTxBtcTokenProductID    = "t_tx_btc_token.product_id"
 	DBColTTxBtcTokenTokenIndex   = "t_tx_btc_token.token_index"
 	DBColTTxBtcTokenTokenSymbol  = "t_tx_btc_token.token_symbol"
+	DBColTTxBtcTokenBlockNumber  = "t_tx_btc_token.block_number"
 	DBColTTxBtcTokenBlockHash    = "t_tx_btc_token.block_hash"
 	DBColTTxBtcTokenTxID         = "t_tx_btc_token.tx_id"
 	DBColTTxBtcTokenFromAddress  = "t_tx_btc_token.from_address"
@@ -956,6 +1604,7 @@ const (
 	DBColShortTTxBtcTokenProductID    = "product_id"
 	DBColShortTTxBtcTokenTokenIndex   = "token_index"
 	DBColShortTTxBtcTokenTokenSymbol  = "token_symbol"
+	DBColShortTTxBtcTokenBlockNumber  = "block_number"
 	DBColShortTTxBtcTokenBlockHash    = "block_hash"
 	DBColShortTTxBtcTokenTxID         = "tx_id"
 	DBColShortTTxBtcTokenFromAddress  = "from_address"
@@ -977,6 +1626,7 @@ var DBColTTxBtcTokenAll = []string{
 	"t_tx_btc_token.product_id",
 	"t_tx_btc_token.token_index",
 	"t_tx_btc_token.token_symbol",
+	"t_tx_btc_token.block_number",
 	"t_tx_btc_token.block_hash",
 	"t_tx_btc_token.tx_id",
 	"t_tx_btc_token.from_address",
@@ -999,6 +1649,7 @@ var DBColTTxBtcTokenAll = []string{
    product_id,
    token_index,
    token_symbol,
+   block_number,
    block_hash,
    tx_id,
    from_address,
@@ -1018,6 +1669,7 @@ type DBTTxBtcToken struct {
 	ProductID    int64  `db:"product_id" json:"product_id"`
 	TokenIndex   int64  `db:"token_index" json:"token_index"`
 	TokenSymbol  string `db:"token_symbol" json:"token_symbol"`
+	BlockNumber  int64  `db:"block_number" json:"block_number"`
 	BlockHash    string `db:"block_hash" json:"block_hash"`
 	TxID         string `db:"tx_id" json:"tx_id"`
 	FromAddress  string `db:"from_address" json:"from_address"`
@@ -1126,6 +1778,8 @@ type DBTTxBtcUxto struct {
 const (
 	DBColTTxEosID           = "t_tx_eos.id"
 	DBColTTxEosProductID    = "t_tx_eos.product_id"
+	DBColTTxEosBlockNumber  = "t_tx_eos.block_number" // 区块高度
+	DBColTTxEosBlockHash    = "t_tx_eos.block_hash"   // 区块hash
 	DBColTTxEosTxHash       = "t_tx_eos.tx_hash"
 	DBColTTxEosLogIndex     = "t_tx_eos.log_index"
 	DBColTTxEosFromAddress  = "t_tx_eos.from_address"
@@ -1142,6 +1796,8 @@ const (
 const (
 	DBColShortTTxEosID           = "id"
 	DBColShortTTxEosProductID    = "product_id"
+	DBColShortTTxEosBlockNumber  = "block_number" // 区块高度
+	DBColShortTTxEosBlockHash    = "block_hash"   // 区块hash
 	DBColShortTTxEosTxHash       = "tx_hash"
 	DBColShortTTxEosLogIndex     = "log_index"
 	DBColShortTTxEosFromAddress  = "from_address"
@@ -1158,6 +1814,8 @@ const (
 var DBColTTxEosAll = []string{
 	"t_tx_eos.id",
 	"t_tx_eos.product_id",
+	"t_tx_eos.block_number",
+	"t_tx_eos.block_hash",
 	"t_tx_eos.tx_hash",
 	"t_tx_eos.log_index",
 	"t_tx_eos.from_address",
@@ -1175,6 +1833,8 @@ var DBColTTxEosAll = []string{
 /*
    id,
    product_id,
+   block_number,
+   block_hash,
    tx_hash,
    log_index,
    from_address,
@@ -1189,6 +1849,8 @@ var DBColTTxEosAll = []string{
 type DBTTxEos struct {
 	ID           int64  `db:"id" json:"id"`
 	ProductID    int64  `db:"product_id" json:"product_id"`
+	BlockNumber  int64  `db:"block_number" json:"block_number"` // 区块高度
+	BlockHash    string `db:"block_hash" json:"block_hash"`     // 区块hash
 	TxHash       string `db:"tx_hash" json:"tx_hash"`
 	LogIndex     int64  `db:"log_index" json:"log_index"`
 	FromAddress  string `db:"from_address" json:"from_address"`
@@ -1204,8 +1866,11 @@ type DBTTxEos struct {
 // const TTxErc20 full
 const (
 	DBColTTxErc20ID           = "t_tx_erc20.id"
+	DBColTTxErc20Chain        = "t_tx_erc20.chain" // 所属evm链 t_app_config_chain.name
 	DBColTTxErc20TokenID      = "t_tx_erc20.token_id"
 	DBColTTxErc20ProductID    = "t_tx_erc20.product_id"
+	DBColTTxErc20BlockNumber  = "t_tx_erc20.block_number"  // 区块高度
+	DBColTTxErc20BlockHash    = "t_tx_erc20.block_hash"    // 区块hash
 	DBColTTxErc20TxID         = "t_tx_erc20.tx_id"         // 交易id
 	DBColTTxErc20FromAddress  = "t_tx_erc20.from_address"  // 来源地址
 	DBColTTxErc20ToAddress    = "t_tx_erc20.to_address"    // 目标地址
@@ -1222,8 +1887,11 @@ const (
 // const TTxErc20 short
 const (
 	DBColShortTTxErc20ID           = "id"
+	DBColShortTTxErc20Chain        = "chain" // 所属evm链 t_app_config_chain.name
 	DBColShortTTxErc20TokenID      = "token_id"
 	DBColShortTTxErc20ProductID    = "product_id"
+	DBColShortTTxErc20BlockNumber  = "block_number"  // 区块高度
+	DBColShortTTxErc20BlockHash    = "block_hash"    // 区块hash
 	DBColShortTTxErc20TxID         = "tx_id"         // 交易id
 	DBColShortTTxErc20FromAddress  = "from_address"  // 来源地址
 	DBColShortTTxErc20ToAddress    = "to_address"    // 目标地址
@@ -1240,8 +1908,11 @@ const (
 // DBColTTxErc20All 所有字段
 var DBColTTxErc20All = []string{
 	"t_tx_erc20.id",
+	"t_tx_erc20.chain",
 	"t_tx_erc20.token_id",
 	"t_tx_erc20.product_id",
+	"t_tx_erc20.block_number",
+	"t_tx_erc20.block_hash",
 	"t_tx_erc20.tx_id",
 	"t_tx_erc20.from_address",
 	"t_tx_erc20.to_address",
@@ -1259,8 +1930,11 @@ var DBColTTxErc20All = []string{
 // DBTTxErc20 t_tx_erc20
 /*
    id,
+   chain,
    token_id,
    product_id,
+   block_number,
+   block_hash,
    tx_id,
    from_address,
    to_address,
@@ -1275,8 +1949,11 @@ var DBColTTxErc20All = []string{
 */
 type DBTTxErc20 struct {
 	ID           int64  `db:"id" json:"id"`
+	Chain        string `db:"chain" json:"chain"` // 所属evm链 t_app_config_chain.name
 	TokenID      int64  `db:"token_id" json:"token_id"`
 	ProductID    int64  `db:"product_id" json:"product_id"`
+	BlockNumber  int64  `db:"block_number" json:"block_number"`   // 区块高度
+	BlockHash    string `db:"block_hash" json:"block_hash"`       // 区块hash
 	TxID         string `db:"tx_id" json:"tx_id"`                 // 交易id
 	FromAddress  string `db:"from_address" json:"from_address"`   // 来源地址
 	ToAddress    string `db:"to_address" json:"to_address"`       // 目标地址
@@ -1290,6 +1967,179 @@ type DBTTxErc20 struct {
 	OrgTime      int64  `db:"org_time" json:"org_time"`           // 零钱整理时间
 }
 
+// const TTxErc20OrgDiff full
+const (
+	DBColTTxErc20OrgDiffID              = "t_tx_erc20_org_diff.id"
+	DBColTTxErc20OrgDiffTokenID         = "t_tx_erc20_org_diff.token_id"
+	DBColTTxErc20OrgDiffAddress         = "t_tx_erc20_org_diff.address"          // 充币地址
+	DBColTTxErc20OrgDiffTxID            = "t_tx_erc20_org_diff.tx_id"            // 整理交易id，没有余额时为空
+	DBColTTxErc20OrgDiffCreditedBalance = "t_tx_erc20_org_diff.credited_balance" // 入账金额
+	DBColTTxErc20OrgDiffRealBalance     = "t_tx_erc20_org_diff.real_balance"     // 链上实际余额
+	DBColTTxErc20OrgDiffDiffBalance     = "t_tx_erc20_org_diff.diff_balance"     // 差额 实际余额-入账金额
+	DBColTTxErc20OrgDiffCreateTime      = "t_tx_erc20_org_diff.create_time"      // 创建时间戳
+)
+
+// const TTxErc20OrgDiff short
+const (
+	DBColShortTTxErc20OrgDiffID              = "id"
+	DBColShortTTxErc20OrgDiffTokenID         = "token_id"
+	DBColShortTTxErc20OrgDiffAddress         = "address"          // 充币地址
+	DBColShortTTxErc20OrgDiffTxID            = "tx_id"            // 整理交易id，没有余额时为空
+	DBColShortTTxErc20OrgDiffCreditedBalance = "credited_balance" // 入账金额
+	DBColShortTTxErc20OrgDiffRealBalance     = "real_balance"     // 链上实际余额
+	DBColShortTTxErc20OrgDiffDiffBalance     = "diff_balance"     // 差额 实际余额-入账金额
+	DBColShortTTxErc20OrgDiffCreateTime      = "create_time"      // 创建时间戳
+)
+
+// DBColTTxErc20OrgDiffAll 所有字段
+var DBColTTxErc20OrgDiffAll = []string{
+	"t_tx_erc20_org_diff.id",
+	"t_tx_erc20_org_diff.token_id",
+	"t_tx_erc20_org_diff.address",
+	"t_tx_erc20_org_diff.tx_id",
+	"t_tx_erc20_org_diff.credited_balance",
+	"t_tx_erc20_org_diff.real_balance",
+	"t_tx_erc20_org_diff.diff_balance",
+	"t_tx_erc20_org_diff.create_time",
+}
+
+// 表结构
+// DBTTxErc20OrgDiff t_tx_erc20_org_diff
+/*
+   id,
+   token_id,
+   address,
+   tx_id,
+   credited_balance,
+   real_balance,
+   diff_balance,
+   create_time
+*/
+type DBTTxErc20OrgDiff struct {
+	ID              int64  `db:"id" json:"id"`
+	TokenID         int64  `db:"token_id" json:"token_id"`
+	Address         string `db:"address" json:"address"`                   // 充币地址
+	TxID            string `db:"tx_id" json:"tx_id"`                       // 整理交易id，没有余额时为空
+	CreditedBalance string `db:"credited_balance" json:"credited_balance"` // 入账金额
+	RealBalance     string `db:"real_balance" json:"real_balance"`         // 链上实际余额
+	DiffBalance     string `db:"diff_balance" json:"diff_balance"`         // 差额 实际余额-入账金额
+	CreateTime      int64  `db:"create_time" json:"create_time"`           // 创建时间戳
+}
+
+// const TTxNft full
+const (
+	DBColTTxNftID           = "t_tx_nft.id"
+	DBColTTxNftChain        = "t_tx_nft.chain"  // 所属evm链 t_app_config_chain.name
+	DBColTTxNftNftID        = "t_tx_nft.nft_id" // t_app_config_nft.id
+	DBColTTxNftProductID    = "t_tx_nft.product_id"
+	DBColTTxNftBlockNumber  = "t_tx_nft.block_number"  // 区块高度
+	DBColTTxNftBlockHash    = "t_tx_nft.block_hash"    // 区块hash
+	DBColTTxNftTxID         = "t_tx_nft.tx_id"         // 交易id
+	DBColTTxNftLogIndex     = "t_tx_nft.log_index"     // 日志位置
+	DBColTTxNftFromAddress  = "t_tx_nft.from_address"  // 来源地址
+	DBColTTxNftToAddress    = "t_tx_nft.to_address"    // 目标地址
+	DBColTTxNftTokenID      = "t_tx_nft.token_id"      // nft token id
+	DBColTTxNftAmount       = "t_tx_nft.amount"        // 数量，erc721 为 1
+	DBColTTxNftCreateTime   = "t_tx_nft.create_time"   // 创建时间戳
+	DBColTTxNftHandleStatus = "t_tx_nft.handle_status" // 处理状态
+	DBColTTxNftHandleMsg    = "t_tx_nft.handle_msg"    // 处理消息
+	DBColTTxNftHandleTime   = "t_tx_nft.handle_time"   // 处理时间戳
+	DBColTTxNftOrgStatus    = "t_tx_nft.org_status"    // 零钱整理状态
+	DBColTTxNftOrgMsg       = "t_tx_nft.org_msg"       // 零钱整理消息
+	DBColTTxNftOrgTime      = "t_tx_nft.org_time"      // 零钱整理时间
+)
+
+// const TTxNft short
+const (
+	DBColShortTTxNftID           = "id"
+	DBColShortTTxNftChain        = "chain"  // 所属evm链 t_app_config_chain.name
+	DBColShortTTxNftNftID        = "nft_id" // t_app_config_nft.id
+	DBColShortTTxNftProductID    = "product_id"
+	DBColShortTTxNftBlockNumber  = "block_number"  // 区块高度
+	DBColShortTTxNftBlockHash    = "block_hash"    // 区块hash
+	DBColShortTTxNftTxID         = "tx_id"         // 交易id
+	DBColShortTTxNftLogIndex     = "log_index"     // 日志位置
+	DBColShortTTxNftFromAddress  = "from_address"  // 来源地址
+	DBColShortTTxNftToAddress    = "to_address"    // 目标地址
+	DBColShortTTxNftTokenID      = "token_id"      // nft token id
+	DBColShortTTxNftAmount       = "amount"        // 数量，erc721 为 1
+	DBColShortTTxNftCreateTime   = "create_time"   // 创建时间戳
+	DBColShortTTxNftHandleStatus = "handle_status" // 处理状态
+	DBColShortTTxNftHandleMsg    = "handle_msg"    // 处理消息
+	DBColShortTTxNftHandleTime   = "handle_time"   // 处理时间戳
+	DBColShortTTxNftOrgStatus    = "org_status"    // 零钱整理状态
+	DBColShortTTxNftOrgMsg       = "org_msg"       // 零钱整理消息
+	DBColShortTTxNftOrgTime      = "org_time"      // 零钱整理时间
+)
+
+// DBColTTxNftAll 所有字段
+var DBColTTxNftAll = []string{
+	"t_tx_nft.id",
+	"t_tx_nft.chain",
+	"t_tx_nft.nft_id",
+	"t_tx_nft.product_id",
+	"t_tx_nft.block_number",
+	"t_tx_nft.block_hash",
+	"t_tx_nft.tx_id",
+	"t_tx_nft.log_index",
+	"t_tx_nft.from_address",
+	"t_tx_nft.to_address",
+	"t_tx_nft.token_id",
+	"t_tx_nft.amount",
+	"t_tx_nft.create_time",
+	"t_tx_nft.handle_status",
+	"t_tx_nft.handle_msg",
+	"t_tx_nft.handle_time",
+	"t_tx_nft.org_status",
+	"t_tx_nft.org_msg",
+	"t_tx_nft.org_time",
+}
+
+// 表结构
+// DBTTxNft t_tx_nft
+/*
+   id,
+   chain,
+   nft_id,
+   product_id,
+   block_number,
+   block_hash,
+   tx_id,
+   log_index,
+   from_address,
+   to_address,
+   token_id,
+   amount,
+   create_time,
+   handle_status,
+   handle_msg,
+   handle_time,
+   org_status,
+   org_msg,
+   org_time
+*/
+type DBTTxNft struct {
+	ID           int64  `db:"id" json:"id"`
+	Chain        string `db:"chain" json:"chain"`   // 所属evm链 t_app_config_chain.name
+	NftID        int64  `db:"nft_id" json:"nft_id"` // t_app_config_nft.id
+	ProductID    int64  `db:"product_id" json:"product_id"`
+	BlockNumber  int64  `db:"block_number" json:"block_number"`   // 区块高度
+	BlockHash    string `db:"block_hash" json:"block_hash"`       // 区块hash
+	TxID         string `db:"tx_id" json:"tx_id"`                 // 交易id
+	LogIndex     int64  `db:"log_index" json:"log_index"`         // 日志位置
+	FromAddress  string `db:"from_address" json:"from_address"`   // 来源地址
+	ToAddress    string `db:"to_address" json:"to_address"`       // 目标地址
+	TokenID      string `db:"token_id" json:"token_id"`           // nft token id
+	Amount       string `db:"amount" json:"amount"`               // 数量，erc721 为 1
+	CreateTime   int64  `db:"create_time" json:"create_time"`     // 创建时间戳
+	HandleStatus int64  `db:"handle_status" json:"handle_status"` // 处理状态
+	HandleMsg    string `db:"handle_msg" json:"handle_msg"`       // 处理消息
+	HandleTime   int64  `db:"handle_time" json:"handle_time"`     // 处理时间戳
+	OrgStatus    int64  `db:"org_status" json:"org_status"`       // 零钱整理状态
+	OrgMsg       string `db:"org_msg" json:"org_msg"`             // 零钱整理消息
+	OrgTime      int64  `db:"org_time" json:"org_time"`           // 零钱整理时间
+}
+
 // const TWithdraw full
 const (
 	DBColTWithdrawID           = "t_withdraw.id"
@@ -1299,7 +2149,11 @@ const (
 	DBColTWithdrawMemo         = "t_withdraw.memo"
 	DBColTWithdrawSymbol       = "t_withdraw.symbol"
 	DBColTWithdrawBalanceReal  = "t_withdraw.balance_real"  // 提币金额
+	DBColTWithdrawTokenID      = "t_withdraw.token_id"      // nft token id
 	DBColTWithdrawTxHash       = "t_withdraw.tx_hash"       // 提币tx hash
+	DBColTWithdrawFee          = "t_withdraw.fee"           // 实际手续费
+	DBColTWithdrawBlockNumber  = "t_withdraw.block_number"  // 区块高度
+	DBColTWithdrawBlockHash    = "t_withdraw.block_hash"    // 区块hash
 	DBColTWithdrawCreateTime   = "t_withdraw.create_time"   // 创建时间
 	DBColTWithdrawHandleStatus = "t_withdraw.handle_status" // 处理状态
 	DBColTWithdrawHandleMsg    = "t_withdraw.handle_msg"    // 处理消息
@@ -1315,7 +2169,11 @@ const (
 	DBColShortTWithdrawMemo         = "memo"
 	DBColShortTWithdrawSymbol       = "symbol"
 	DBColShortTWithdrawBalanceReal  = "balance_real"  // 提币金额
+	DBColShortTWithdrawTokenID      = "token_id"      // nft token id
 	DBColShortTWithdrawTxHash       = "tx_hash"       // 提币tx hash
+	DBColShortTWithdrawFee          = "fee"           // 实际手续费
+	DBColShortTWithdrawBlockNumber  = "block_number"  // 区块高度
+	DBColShortTWithdrawBlockHash    = "block_hash"    // 区块hash
 	DBColShortTWithdrawCreateTime   = "create_time"   // 创建时间
 	DBColShortTWithdrawHandleStatus = "handle_status" // 处理状态
 	DBColShortTWithdrawHandleMsg    = "handle_msg"    // 处理消息
@@ -1331,7 +2189,11 @@ var DBColTWithdrawAll = []string{
 	"t_withdraw.memo",
 	"t_withdraw.symbol",
 	"t_withdraw.balance_real",
+	"t_withdraw.token_id",
 	"t_withdraw.tx_hash",
+	"t_withdraw.fee",
+	"t_withdraw.block_number",
+	"t_withdraw.block_hash",
 	"t_withdraw.create_time",
 	"t_withdraw.handle_status",
 	"t_withdraw.handle_msg",
@@ -1348,7 +2210,11 @@ var DBColTWithdrawAll = []string{
    memo,
    symbol,
    balance_real,
+   token_id,
    tx_hash,
+   fee,
+   block_number,
+   block_hash,
    create_time,
    handle_status,
    handle_msg,
@@ -1362,7 +2228,11 @@ type DBTWithdraw struct {
 	Memo         string `db:"memo" json:"memo"`
 	Symbol       string `db:"symbol" json:"symbol"`
 	BalanceReal  string `db:"balance_real" json:"balance_real"`   // 提币金额
+	TokenID      string `db:"token_id" json:"token_id"`           // nft token id
 	TxHash       string `db:"tx_hash" json:"tx_hash"`             // 提币tx hash
+	Fee          string `db:"fee" json:"fee"`                     // 实际手续费
+	BlockNumber  int64  `db:"block_number" json:"block_number"`   // 区块高度
+	BlockHash    string `db:"block_hash" json:"block_hash"`       // 区块hash
 	CreateTime   int64  `db:"create_time" json:"create_time"`     // 创建时间
 	HandleStatus int64  `db:"handle_status" json:"handle_status"` // 处理状态
 	HandleMsg    string `db:"handle_msg" json:"handle_msg"`       // 处理消息
@@ -495,8 +495,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTAppConfigInt 创建
-func SQLCreateTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigInt, isIgnore bool) (int64, error) {
+// SQLCreateTAppAlert 创建
+func SQLCreateTAppAlert(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppAlert, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -504,29 +504,38 @@ func SQLCreateTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTA
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_config_int ( ")
+	query.WriteString("INTO t_app_alert ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
        k,
-       v
+       check_value,
+       check_time,
+       alert_msg,
+       alert_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
     :k,
-    :v
+    :check_value,
+    :check_time,
+    :alert_msg,
+    :alert_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id": row.ID,
-			"k":  row.K,
-			"v":  row.V,
+			"id":          row.ID,
+			"k":           row.K,
+			"check_value": row.CheckValue,
+			"check_time":  row.CheckTime,
+			"alert_msg":   row.AlertMsg,
+			"alert_time":  row.AlertTime,
 		},
 	)
 	if err != nil {
@@ -535,25 +544,31 @@ func SQLCreateTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTA
 	return lastID, nil
 }
 
-// SQLCreateTAppConfigIntDuplicate 创建更新
-func SQLCreateTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigInt, updates []string) (int64, error) {
+// SQLCreateTAppAlertDuplicate 创建更新
+func SQLCreateTAppAlertDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppAlert, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_config_int ( ")
+	query.WriteString("INSERT INTO t_app_alert ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
        k,
-       v
+       check_value,
+       check_time,
+       alert_msg,
+       alert_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
     :k,
-    :v
+    :check_value,
+    :check_time,
+    :alert_msg,
+    :alert_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -576,9 +591,12 @@ func SQLCreateTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id": row.ID,
-			"k":  row.K,
-			"v":  row.V,
+			"id":          row.ID,
+			"k":           row.K,
+			"check_value": row.CheckValue,
+			"check_time":  row.CheckTime,
+			"alert_msg":   row.AlertMsg,
+			"alert_time":  row.AlertTime,
 		},
 	)
 	if err != nil {
@@ -587,8 +605,8 @@ func SQLCreateTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 	return lastID, nil
 }
 
-// SQLCreateManyTAppConfigInt 创建多个
-func SQLCreateManyTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigInt, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppAlert 创建多个
+func SQLCreateManyTAppAlert(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppAlert, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -600,7 +618,10 @@ func SQLCreateManyTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, rows
 				[]interface{}{
 					row.ID,
 					row.K,
-					row.V,
+					row.CheckValue,
+					row.CheckTime,
+					row.AlertMsg,
+					row.AlertTime,
 				},
 			)
 		}
@@ -610,7 +631,10 @@ func SQLCreateManyTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, rows
 				args,
 				[]interface{}{
 					row.K,
-					row.V,
+					row.CheckValue,
+					row.CheckTime,
+					row.AlertMsg,
+					row.AlertTime,
 				},
 			)
 		}
@@ -622,13 +646,16 @@ func SQLCreateManyTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, rows
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_config_int ( ")
+	query.WriteString("INTO t_app_alert ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
     k,
-    v
+    check_value,
+    check_time,
+    alert_msg,
+    alert_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -644,8 +671,8 @@ func SQLCreateManyTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, rows
 	return count, nil
 }
 
-// SQLCreateManyTAppConfigIntDuplicate 创建多个
-func SQLCreateManyTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigInt, updates []string) (int64, error) {
+// SQLCreateManyTAppAlertDuplicate 创建多个
+func SQLCreateManyTAppAlertDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppAlert, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -657,7 +684,10 @@ func SQLCreateManyTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAb
 				[]interface{}{
 					row.ID,
 					row.K,
-					row.V,
+					row.CheckValue,
+					row.CheckTime,
+					row.AlertMsg,
+					row.AlertTime,
 				},
 			)
 		}
@@ -667,7 +697,10 @@ func SQLCreateManyTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAb
 				args,
 				[]interface{}{
 					row.K,
-					row.V,
+					row.CheckValue,
+					row.CheckTime,
+					row.AlertMsg,
+					row.AlertTime,
 				},
 			)
 		}
@@ -675,13 +708,16 @@ func SQLCreateManyTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAb
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_config_int ( ")
+	query.WriteString("INSERT INTO t_app_alert ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
     k,
-    v
+    check_value,
+    check_time,
+    alert_msg,
+    alert_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -713,18 +749,18 @@ func SQLCreateManyTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAb
 	return count, nil
 }
 
-// SQLGetTAppConfigIntCol 根据id查询
-func SQLGetTAppConfigIntCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigInt, error) {
+// SQLGetTAppAlertCol 根据id查询
+func SQLGetTAppAlertCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppAlert, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_int
+	t_app_alert
 WHERE
 	id=:id`)
 
-	var row DBTAppConfigInt
+	var row DBTAppAlert
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -743,8 +779,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTAppConfigIntColKV 根据id查询
-func SQLGetTAppConfigIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigInt, error) {
+// SQLGetTAppAlertColKV 根据id查询
+func SQLGetTAppAlertColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppAlert, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -755,7 +791,7 @@ func SQLGetTAppConfigIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_int
+	t_app_alert
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -785,7 +821,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTAppConfigInt
+	var row DBTAppAlert
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -802,8 +838,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTAppConfigIntCol 根据ids获取
-func SQLSelectTAppConfigIntCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigInt, error) {
+// SQLSelectTAppAlertCol 根据ids获取
+func SQLSelectTAppAlertCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppAlert, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -812,7 +848,7 @@ func SQLSelectTAppConfigIntCol(ctx context.Context, tx mcommon.DbExeAble, cols [
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_int
+	t_app_alert
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -826,7 +862,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTAppConfigInt
+	var rows []*DBTAppAlert
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -842,8 +878,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTAppConfigIntColKV 根据ids获取
-func SQLSelectTAppConfigIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigInt, error) {
+// SQLSelectTAppAlertColKV 根据ids获取
+func SQLSelectTAppAlertColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppAlert, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -854,7 +890,7 @@ func SQLSelectTAppConfigIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_int
+	t_app_alert
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -895,7 +931,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTAppConfigInt
+	var rows []*DBTAppAlert
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -909,22 +945,28 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTAppConfigInt 更新
-func SQLUpdateTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigInt) (int64, error) {
+// SQLUpdateTAppAlert 更新
+func SQLUpdateTAppAlert(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppAlert) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_app_config_int
+	t_app_alert
 SET
     k=:k,
-    v=:v
+    check_value=:check_value,
+    check_time=:check_time,
+    alert_msg=:alert_msg,
+    alert_time=:alert_time
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id": row.ID,
-			"k":  row.K,
-			"v":  row.V,
+			"id":          row.ID,
+			"k":           row.K,
+			"check_value": row.CheckValue,
+			"check_time":  row.CheckTime,
+			"alert_msg":   row.AlertMsg,
+			"alert_time":  row.AlertTime,
 		},
 	)
 	if err != nil {
@@ -933,14 +975,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTAppConfigInt 删除
-func SQLDeleteTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppAlert 删除
+func SQLDeleteTAppAlert(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_app_config_int
+	t_app_alert
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -953,8 +995,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTAppConfigStr 创建
-func SQLCreateTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigStr, isIgnore bool) (int64, error) {
+// SQLCreateTAppConfigChain 创建
+func SQLCreateTAppConfigChain(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigChain, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -962,29 +1004,41 @@ func SQLCreateTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, row *DBTA
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_config_str ( ")
+	query.WriteString("INTO t_app_config_chain ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       k,
-       v
+       name,
+       coin_symbol,
+       chain_id,
+       rpc,
+       enable,
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :k,
-    :v
+    :name,
+    :coin_symbol,
+    :chain_id,
+    :rpc,
+    :enable,
+    :create_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id": row.ID,
-			"k":  row.K,
-			"v":  row.V,
+			"id":          row.ID,
+			"name":        row.Name,
+			"coin_symbol": row.CoinSymbol,
+			"chain_id":    row.ChainID,
+			"rpc":         row.RPC,
+			"enable":      row.Enable,
+			"create_time": row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -993,25 +1047,33 @@ func SQLCreateTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, row *DBTA
 	return lastID, nil
 }
 
-// SQLCreateTAppConfigStrDuplicate 创建更新
-func SQLCreateTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigStr, updates []string) (int64, error) {
+// SQLCreateTAppConfigChainDuplicate 创建更新
+func SQLCreateTAppConfigChainDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigChain, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_config_str ( ")
+	query.WriteString("INSERT INTO t_app_config_chain ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       k,
-       v
+       name,
+       coin_symbol,
+       chain_id,
+       rpc,
+       enable,
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :k,
-    :v
+    :name,
+    :coin_symbol,
+    :chain_id,
+    :rpc,
+    :enable,
+    :create_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -1034,9 +1096,13 @@ func SQLCreateTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id": row.ID,
-			"k":  row.K,
-			"v":  row.V,
+			"id":          row.ID,
+			"name":        row.Name,
+			"coin_symbol": row.CoinSymbol,
+			"chain_id":    row.ChainID,
+			"rpc":         row.RPC,
+			"enable":      row.Enable,
+			"create_time": row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -1045,8 +1111,8 @@ func SQLCreateTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 	return lastID, nil
 }
 
-// SQLCreateManyTAppConfigStr 创建多个
-func SQLCreateManyTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigStr, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppConfigChain 创建多个
+func SQLCreateManyTAppConfigChain(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigChain, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -1057,8 +1123,12 @@ func SQLCreateManyTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, rows
 				args,
 				[]interface{}{
 					row.ID,
-					row.K,
-					row.V,
+					row.Name,
+					row.CoinSymbol,
+					row.ChainID,
+					row.RPC,
+					row.Enable,
+					row.CreateTime,
 				},
 			)
 		}
@@ -1067,8 +1137,12 @@ func SQLCreateManyTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, rows
 			args = append(
 				args,
 				[]interface{}{
-					row.K,
-					row.V,
+					row.Name,
+					row.CoinSymbol,
+					row.ChainID,
+					row.RPC,
+					row.Enable,
+					row.CreateTime,
 				},
 			)
 		}
@@ -1080,13 +1154,17 @@ func SQLCreateManyTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, rows
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_config_str ( ")
+	query.WriteString("INTO t_app_config_chain ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    k,
-    v
+    name,
+    coin_symbol,
+    chain_id,
+    rpc,
+    enable,
+    create_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -1102,8 +1180,8 @@ func SQLCreateManyTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, rows
 	return count, nil
 }
 
-// SQLCreateManyTAppConfigStrDuplicate 创建多个
-func SQLCreateManyTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigStr, updates []string) (int64, error) {
+// SQLCreateManyTAppConfigChainDuplicate 创建多个
+func SQLCreateManyTAppConfigChainDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigChain, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -1114,8 +1192,12 @@ func SQLCreateManyTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAb
 				args,
 				[]interface{}{
 					row.ID,
-					row.K,
-					row.V,
+					row.Name,
+					row.CoinSymbol,
+					row.ChainID,
+					row.RPC,
+					row.Enable,
+					row.CreateTime,
 				},
 			)
 		}
@@ -1124,8 +1206,12 @@ func SQLCreateManyTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAb
 			args = append(
 				args,
 				[]interface{}{
-					row.K,
-					row.V,
+					row.Name,
+					row.CoinSymbol,
+					row.ChainID,
+					row.RPC,
+					row.Enable,
+					row.CreateTime,
 				},
 			)
 		}
@@ -1133,13 +1219,17 @@ func SQLCreateManyTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAb
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_config_str ( ")
+	query.WriteString("INSERT INTO t_app_config_chain ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    k,
-    v
+    name,
+    coin_symbol,
+    chain_id,
+    rpc,
+    enable,
+    create_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -1171,18 +1261,18 @@ func SQLCreateManyTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAb
 	return count, nil
 }
 
-// SQLGetTAppConfigStrCol 根据id查询
-func SQLGetTAppConfigStrCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigStr, error) {
+// SQLGetTAppConfigChainCol 根据id查询
+func SQLGetTAppConfigChainCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigChain, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_str
+	t_app_config_chain
 WHERE
 	id=:id`)
 
-	var row DBTAppConfigStr
+	var row DBTAppConfigChain
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -1201,8 +1291,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTAppConfigStrColKV 根据id查询
-func SQLGetTAppConfigStrColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigStr, error) {
+// SQLGetTAppConfigChainColKV 根据id查询
+func SQLGetTAppConfigChainColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigChain, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -1213,7 +1303,7 @@ func SQLGetTAppConfigStrColKV(ctx context.Context, tx mcommon.DbExeAble, cols []
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_str
+	t_app_config_chain
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -1243,7 +1333,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTAppConfigStr
+	var row DBTAppConfigChain
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -1260,8 +1350,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTAppConfigStrCol 根据ids获取
-func SQLSelectTAppConfigStrCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigStr, error) {
+// SQLSelectTAppConfigChainCol 根据ids获取
+func SQLSelectTAppConfigChainCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigChain, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -1270,7 +1360,7 @@ func SQLSelectTAppConfigStrCol(ctx context.Context, tx mcommon.DbExeAble, cols [
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_str
+	t_app_config_chain
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -1284,7 +1374,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTAppConfigStr
+	var rows []*DBTAppConfigChain
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -1300,8 +1390,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTAppConfigStrColKV 根据ids获取
-func SQLSelectTAppConfigStrColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigStr, error) {
+// SQLSelectTAppConfigChainColKV 根据ids获取
+func SQLSelectTAppConfigChainColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigChain, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -1312,7 +1402,7 @@ func SQLSelectTAppConfigStrColKV(ctx context.Context, tx mcommon.DbExeAble, cols
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_str
+	t_app_config_chain
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -1353,7 +1443,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTAppConfigStr
+	var rows []*DBTAppConfigChain
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -1367,22 +1457,30 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTAppConfigStr 更新
-func SQLUpdateTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigStr) (int64, error) {
+// SQLUpdateTAppConfigChain 更新
+func SQLUpdateTAppConfigChain(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigChain) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_app_config_str
+	t_app_config_chain
 SET
-    k=:k,
-    v=:v
+    name=:name,
+    coin_symbol=:coin_symbol,
+    chain_id=:chain_id,
+    rpc=:rpc,
+    enable=:enable,
+    create_time=:create_time
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id": row.ID,
-			"k":  row.K,
-			"v":  row.V,
+			"id":          row.ID,
+			"name":        row.Name,
+			"coin_symbol": row.CoinSymbol,
+			"chain_id":    row.ChainID,
+			"rpc":         row.RPC,
+			"enable":      row.Enable,
+			"create_time": row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -1391,14 +1489,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTAppConfigStr 删除
-func SQLDeleteTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppConfigChain 删除
+func SQLDeleteTAppConfigChain(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_app_config_str
+	t_app_config_chain
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -1411,8 +1509,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTAppConfigToken 创建
-func SQLCreateTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigToken, isIgnore bool) (int64, error) {
+// SQLCreateTAppConfigInt 创建
+func SQLCreateTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigInt, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -1420,44 +1518,29 @@ func SQLCreateTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row *DB
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_config_token ( ")
+	query.WriteString("INTO t_app_config_int ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       token_address,
-       token_decimals,
-       token_symbol,
-       cold_address,
-       hot_address,
-       org_min_balance,
-       create_time
+       k,
+       v
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :token_address,
-    :token_decimals,
-    :token_symbol,
-    :cold_address,
-    :hot_address,
-    :org_min_balance,
-    :create_time
+    :k,
+    :v
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":              row.ID,
-			"token_address":   row.TokenAddress,
-			"token_decimals":  row.TokenDecimals,
-			"token_symbol":    row.TokenSymbol,
-			"cold_address":    row.ColdAddress,
-			"hot_address":     row.HotAddress,
-			"org_min_balance": row.OrgMinBalance,
-			"create_time":     row.CreateTime,
+			"id": row.ID,
+			"k":  row.K,
+			"v":  row.V,
 		},
 	)
 	if err != nil {
@@ -1466,35 +1549,25 @@ func SQLCreateTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row *DB
 	return lastID, nil
 }
 
-// SQLCreateTAppConfigTokenDuplicate 创建更新
-func SQLCreateTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigToken, updates []string) (int64, error) {
+// SQLCreateTAppConfigIntDuplicate 创建更新
+func SQLCreateTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigInt, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_config_token ( ")
+	query.WriteString("INSERT INTO t_app_config_int ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       token_address,
-       token_decimals,
-       token_symbol,
-       cold_address,
-       hot_address,
-       org_min_balance,
-       create_time
+       k,
+       v
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :token_address,
-    :token_decimals,
-    :token_symbol,
-    :cold_address,
-    :hot_address,
-    :org_min_balance,
-    :create_time
+    :k,
+    :v
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -1517,14 +1590,9 @@ func SQLCreateTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":              row.ID,
-			"token_address":   row.TokenAddress,
-			"token_decimals":  row.TokenDecimals,
-			"token_symbol":    row.TokenSymbol,
-			"cold_address":    row.ColdAddress,
-			"hot_address":     row.HotAddress,
-			"org_min_balance": row.OrgMinBalance,
-			"create_time":     row.CreateTime,
+			"id": row.ID,
+			"k":  row.K,
+			"v":  row.V,
 		},
 	)
 	if err != nil {
@@ -1533,8 +1601,8 @@ func SQLCreateTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble
 	return lastID, nil
 }
 
-// SQLCreateManyTAppConfigToken 创建多个
-func SQLCreateManyTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigToken, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppConfigInt 创建多个
+func SQLCreateManyTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigInt, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -1545,13 +1613,8 @@ func SQLCreateManyTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row
 				args,
 				[]interface{}{
 					row.ID,
-					row.TokenAddress,
-					row.TokenDecimals,
-					row.TokenSymbol,
-					row.ColdAddress,
-					row.HotAddress,
-					row.OrgMinBalance,
-					row.CreateTime,
+					row.K,
+					row.V,
 				},
 			)
 		}
@@ -1560,13 +1623,8 @@ func SQLCreateManyTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row
 			args = append(
 				args,
 				[]interface{}{
-					row.TokenAddress,
-					row.TokenDecimals,
-					row.TokenSymbol,
-					row.ColdAddress,
-					row.HotAddress,
-					row.OrgMinBalance,
-					row.CreateTime,
+					row.K,
+					row.V,
 				},
 			)
 		}
@@ -1578,18 +1636,13 @@ func SQLCreateManyTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_config_token ( ")
+	query.WriteString("INTO t_app_config_int ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    token_address,
-    token_decimals,
-    token_symbol,
-    cold_address,
-    hot_address,
-    org_min_balance,
-    create_time
+    k,
+    v
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -1605,8 +1658,8 @@ func SQLCreateManyTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row
 	return count, nil
 }
 
-// SQLCreateManyTAppConfigTokenDuplicate 创建多个
-func SQLCreateManyTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigToken, updates []string) (int64, error) {
+// SQLCreateManyTAppConfigIntDuplicate 创建多个
+func SQLCreateManyTAppConfigIntDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigInt, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -1617,13 +1670,8 @@ func SQLCreateManyTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExe
 				args,
 				[]interface{}{
 					row.ID,
-					row.TokenAddress,
-					row.TokenDecimals,
-					row.TokenSymbol,
-					row.ColdAddress,
-					row.HotAddress,
-					row.OrgMinBalance,
-					row.CreateTime,
+					row.K,
+					row.V,
 				},
 			)
 		}
@@ -1632,13 +1680,8 @@ func SQLCreateManyTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExe
 			args = append(
 				args,
 				[]interface{}{
-					row.TokenAddress,
-					row.TokenDecimals,
-					row.TokenSymbol,
-					row.ColdAddress,
-					row.HotAddress,
-					row.OrgMinBalance,
-					row.CreateTime,
+					row.K,
+					row.V,
 				},
 			)
 		}
@@ -1646,18 +1689,13 @@ func SQLCreateManyTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExe
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_config_token ( ")
+	query.WriteString("INSERT INTO t_app_config_int ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    token_address,
-    token_decimals,
-    token_symbol,
-    cold_address,
-    hot_address,
-    org_min_balance,
-    create_time
+    k,
+    v
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -1689,18 +1727,18 @@ func SQLCreateManyTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExe
 	return count, nil
 }
 
-// SQLGetTAppConfigTokenCol 根据id查询
-func SQLGetTAppConfigTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigToken, error) {
+// SQLGetTAppConfigIntCol 根据id查询
+func SQLGetTAppConfigIntCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigInt, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token
+	t_app_config_int
 WHERE
 	id=:id`)
 
-	var row DBTAppConfigToken
+	var row DBTAppConfigInt
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -1719,8 +1757,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTAppConfigTokenColKV 根据id查询
-func SQLGetTAppConfigTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigToken, error) {
+// SQLGetTAppConfigIntColKV 根据id查询
+func SQLGetTAppConfigIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigInt, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -1731,7 +1769,7 @@ func SQLGetTAppConfigTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token
+	t_app_config_int
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -1761,7 +1799,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTAppConfigToken
+	var row DBTAppConfigInt
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -1778,8 +1816,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTAppConfigTokenCol 根据ids获取
-func SQLSelectTAppConfigTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigToken, error) {
+// SQLSelectTAppConfigIntCol 根据ids获取
+func SQLSelectTAppConfigIntCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigInt, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -1788,7 +1826,7 @@ func SQLSelectTAppConfigTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token
+	t_app_config_int
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -1802,7 +1840,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTAppConfigToken
+	var rows []*DBTAppConfigInt
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -1818,8 +1856,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTAppConfigTokenColKV 根据ids获取
-func SQLSelectTAppConfigTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigToken, error) {
+// SQLSelectTAppConfigIntColKV 根据ids获取
+func SQLSelectTAppConfigIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigInt, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -1830,7 +1868,7 @@ func SQLSelectTAppConfigTokenColKV(ctx context.Context, tx mcommon.DbExeAble, co
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token
+	t_app_config_int
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -1871,7 +1909,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTAppConfigToken
+	var rows []*DBTAppConfigInt
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -1885,32 +1923,22 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTAppConfigToken 更新
-func SQLUpdateTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigToken) (int64, error) {
+// SQLUpdateTAppConfigInt 更新
+func SQLUpdateTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigInt) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_app_config_token
+	t_app_config_int
 SET
-    token_address=:token_address,
-    token_decimals=:token_decimals,
-    token_symbol=:token_symbol,
-    cold_address=:cold_address,
-    hot_address=:hot_address,
-    org_min_balance=:org_min_balance,
-    create_time=:create_time
+    k=:k,
+    v=:v
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id":              row.ID,
-			"token_address":   row.TokenAddress,
-			"token_decimals":  row.TokenDecimals,
-			"token_symbol":    row.TokenSymbol,
-			"cold_address":    row.ColdAddress,
-			"hot_address":     row.HotAddress,
-			"org_min_balance": row.OrgMinBalance,
-			"create_time":     row.CreateTime,
+			"id": row.ID,
+			"k":  row.K,
+			"v":  row.V,
 		},
 	)
 	if err != nil {
@@ -1919,14 +1947,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTAppConfigToken 删除
-func SQLDeleteTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppConfigInt 删除
+func SQLDeleteTAppConfigInt(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_app_config_token
+	t_app_config_int
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -1939,8 +1967,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTAppConfigTokenBtc 创建
-func SQLCreateTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenBtc, isIgnore bool) (int64, error) {
+// SQLCreateTAppConfigNft 创建
+func SQLCreateTAppConfigNft(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigNft, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -1948,44 +1976,44 @@ func SQLCreateTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, row
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_config_token_btc ( ")
+	query.WriteString("INTO t_app_config_nft ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       token_index,
+       chain,
+       token_address,
+       token_standard,
        token_symbol,
        cold_address,
        hot_address,
-       fee_address,
-       tx_org_min_balance,
-       create_at
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :token_index,
+    :chain,
+    :token_address,
+    :token_standard,
     :token_symbol,
     :cold_address,
     :hot_address,
-    :fee_address,
-    :tx_org_min_balance,
-    :create_at
+    :create_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":                 row.ID,
-			"token_index":        row.TokenIndex,
-			"token_symbol":       row.TokenSymbol,
-			"cold_address":       row.ColdAddress,
-			"hot_address":        row.HotAddress,
-			"fee_address":        row.FeeAddress,
-			"tx_org_min_balance": row.TxOrgMinBalance,
-			"create_at":          row.CreateAt,
+			"id":             row.ID,
+			"chain":          row.Chain,
+			"token_address":  row.TokenAddress,
+			"token_standard": row.TokenStandard,
+			"token_symbol":   row.TokenSymbol,
+			"cold_address":   row.ColdAddress,
+			"hot_address":    row.HotAddress,
+			"create_time":    row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -1994,35 +2022,35 @@ func SQLCreateTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, row
 	return lastID, nil
 }
 
-// SQLCreateTAppConfigTokenBtcDuplicate 创建更新
-func SQLCreateTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenBtc, updates []string) (int64, error) {
+// SQLCreateTAppConfigNftDuplicate 创建更新
+func SQLCreateTAppConfigNftDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigNft, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_config_token_btc ( ")
+	query.WriteString("INSERT INTO t_app_config_nft ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       token_index,
+       chain,
+       token_address,
+       token_standard,
        token_symbol,
        cold_address,
        hot_address,
-       fee_address,
-       tx_org_min_balance,
-       create_at
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :token_index,
+    :chain,
+    :token_address,
+    :token_standard,
     :token_symbol,
     :cold_address,
     :hot_address,
-    :fee_address,
-    :tx_org_min_balance,
-    :create_at
+    :create_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -2045,14 +2073,14 @@ func SQLCreateTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.DbExeA
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":                 row.ID,
-			"token_index":        row.TokenIndex,
-			"token_symbol":       row.TokenSymbol,
-			"cold_address":       row.ColdAddress,
-			"hot_address":        row.HotAddress,
-			"fee_address":        row.FeeAddress,
-			"tx_org_min_balance": row.TxOrgMinBalance,
-			"create_at":          row.CreateAt,
+			"id":             row.ID,
+			"chain":          row.Chain,
+			"token_address":  row.TokenAddress,
+			"token_standard": row.TokenStandard,
+			"token_symbol":   row.TokenSymbol,
+			"cold_address":   row.ColdAddress,
+			"hot_address":    row.HotAddress,
+			"create_time":    row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -2061,8 +2089,8 @@ func SQLCreateTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.DbExeA
 	return lastID, nil
 }
 
-// SQLCreateManyTAppConfigTokenBtc 创建多个
-func SQLCreateManyTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigTokenBtc, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppConfigNft 创建多个
+func SQLCreateManyTAppConfigNft(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigNft, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -2073,13 +2101,13 @@ func SQLCreateManyTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble,
 				args,
 				[]interface{}{
 					row.ID,
-					row.TokenIndex,
+					row.Chain,
+					row.TokenAddress,
+					row.TokenStandard,
 					row.TokenSymbol,
 					row.ColdAddress,
 					row.HotAddress,
-					row.FeeAddress,
-					row.TxOrgMinBalance,
-					row.CreateAt,
+					row.CreateTime,
 				},
 			)
 		}
@@ -2088,13 +2116,13 @@ func SQLCreateManyTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble,
 			args = append(
 				args,
 				[]interface{}{
-					row.TokenIndex,
+					row.Chain,
+					row.TokenAddress,
+					row.TokenStandard,
 					row.TokenSymbol,
 					row.ColdAddress,
 					row.HotAddress,
-					row.FeeAddress,
-					row.TxOrgMinBalance,
-					row.CreateAt,
+					row.CreateTime,
 				},
 			)
 		}
@@ -2106,18 +2134,18 @@ func SQLCreateManyTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble,
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_config_token_btc ( ")
+	query.WriteString("INTO t_app_config_nft ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    token_index,
+    chain,
+    token_address,
+    token_standard,
     token_symbol,
     cold_address,
     hot_address,
-    fee_address,
-    tx_org_min_balance,
-    create_at
+    create_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -2133,8 +2161,8 @@ func SQLCreateManyTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble,
 	return count, nil
 }
 
-// SQLCreateManyTAppConfigTokenBtcDuplicate 创建多个
-func SQLCreateManyTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigTokenBtc, updates []string) (int64, error) {
+// SQLCreateManyTAppConfigNftDuplicate 创建多个
+func SQLCreateManyTAppConfigNftDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigNft, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -2145,13 +2173,13 @@ func SQLCreateManyTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.Db
 				args,
 				[]interface{}{
 					row.ID,
-					row.TokenIndex,
+					row.Chain,
+					row.TokenAddress,
+					row.TokenStandard,
 					row.TokenSymbol,
 					row.ColdAddress,
 					row.HotAddress,
-					row.FeeAddress,
-					row.TxOrgMinBalance,
-					row.CreateAt,
+					row.CreateTime,
 				},
 			)
 		}
@@ -2160,13 +2188,13 @@ func SQLCreateManyTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.Db
 			args = append(
 				args,
 				[]interface{}{
-					row.TokenIndex,
+					row.Chain,
+					row.TokenAddress,
+					row.TokenStandard,
 					row.TokenSymbol,
 					row.ColdAddress,
 					row.HotAddress,
-					row.FeeAddress,
-					row.TxOrgMinBalance,
-					row.CreateAt,
+					row.CreateTime,
 				},
 			)
 		}
@@ -2174,18 +2202,18 @@ func SQLCreateManyTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.Db
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_config_token_btc ( ")
+	query.WriteString("INSERT INTO t_app_config_nft ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    token_index,
+    chain,
+    token_address,
+    token_standard,
     token_symbol,
     cold_address,
     hot_address,
-    fee_address,
-    tx_org_min_balance,
-    create_at
+    create_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -2217,18 +2245,18 @@ func SQLCreateManyTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.Db
 	return count, nil
 }
 
-// SQLGetTAppConfigTokenBtcCol 根据id查询
-func SQLGetTAppConfigTokenBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigTokenBtc, error) {
+// SQLGetTAppConfigNftCol 根据id查询
+func SQLGetTAppConfigNftCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigNft, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token_btc
+	t_app_config_nft
 WHERE
 	id=:id`)
 
-	var row DBTAppConfigTokenBtc
+	var row DBTAppConfigNft
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -2247,8 +2275,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTAppConfigTokenBtcColKV 根据id查询
-func SQLGetTAppConfigTokenBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigTokenBtc, error) {
+// SQLGetTAppConfigNftColKV 根据id查询
+func SQLGetTAppConfigNftColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigNft, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -2259,7 +2287,7 @@ func SQLGetTAppConfigTokenBtcColKV(ctx context.Context, tx mcommon.DbExeAble, co
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token_btc
+	t_app_config_nft
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -2289,7 +2317,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTAppConfigTokenBtc
+	var row DBTAppConfigNft
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -2306,8 +2334,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTAppConfigTokenBtcCol 根据ids获取
-func SQLSelectTAppConfigTokenBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigTokenBtc, error) {
+// SQLSelectTAppConfigNftCol 根据ids获取
+func SQLSelectTAppConfigNftCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigNft, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -2316,7 +2344,7 @@ func SQLSelectTAppConfigTokenBtcCol(ctx context.Context, tx mcommon.DbExeAble, c
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token_btc
+	t_app_config_nft
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -2330,7 +2358,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTAppConfigTokenBtc
+	var rows []*DBTAppConfigNft
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -2346,8 +2374,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTAppConfigTokenBtcColKV 根据ids获取
-func SQLSelectTAppConfigTokenBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigTokenBtc, error) {
+// SQLSelectTAppConfigNftColKV 根据ids获取
+func SQLSelectTAppConfigNftColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigNft, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -2358,7 +2386,7 @@ func SQLSelectTAppConfigTokenBtcColKV(ctx context.Context, tx mcommon.DbExeAble,
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_config_token_btc
+	t_app_config_nft
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -2399,7 +2427,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTAppConfigTokenBtc
+	var rows []*DBTAppConfigNft
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -2413,32 +2441,32 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTAppConfigTokenBtc 更新
-func SQLUpdateTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenBtc) (int64, error) {
+// SQLUpdateTAppConfigNft 更新
+func SQLUpdateTAppConfigNft(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigNft) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_app_config_token_btc
+	t_app_config_nft
 SET
-    token_index=:token_index,
+    chain=:chain,
+    token_address=:token_address,
+    token_standard=:token_standard,
     token_symbol=:token_symbol,
     cold_address=:cold_address,
     hot_address=:hot_address,
-    fee_address=:fee_address,
-    tx_org_min_balance=:tx_org_min_balance,
-    create_at=:create_at
+    create_time=:create_time
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id":                 row.ID,
-			"token_index":        row.TokenIndex,
-			"token_symbol":       row.TokenSymbol,
-			"cold_address":       row.ColdAddress,
-			"hot_address":        row.HotAddress,
-			"fee_address":        row.FeeAddress,
-			"tx_org_min_balance": row.TxOrgMinBalance,
-			"create_at":          row.CreateAt,
+			"id":             row.ID,
+			"chain":          row.Chain,
+			"token_address":  row.TokenAddress,
+			"token_standard": row.TokenStandard,
+			"token_symbol":   row.TokenSymbol,
+			"cold_address":   row.ColdAddress,
+			"hot_address":    row.HotAddress,
+			"create_time":    row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -2447,14 +2475,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTAppConfigTokenBtc 删除
-func SQLDeleteTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppConfigNft 删除
+func SQLDeleteTAppConfigNft(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_app_config_token_btc
+	t_app_config_nft
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -2467,8 +2495,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTAppLock 创建
-func SQLCreateTAppLock(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppLock, isIgnore bool) (int64, error) {
+// SQLCreateTAppConfigStr 创建
+func SQLCreateTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigStr, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -2476,32 +2504,29 @@ func SQLCreateTAppLock(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppLoc
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_lock ( ")
+	query.WriteString("INTO t_app_config_str ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
        k,
-       v,
-       create_time
+       v
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
     :k,
-    :v,
-    :create_time
+    :v
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":          row.ID,
-			"k":           row.K,
-			"v":           row.V,
-			"create_time": row.CreateTime,
+			"id": row.ID,
+			"k":  row.K,
+			"v":  row.V,
 		},
 	)
 	if err != nil {
@@ -2510,27 +2535,25 @@ func SQLCreateTAppLock(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppLoc
 	return lastID, nil
 }
 
-// SQLCreateTAppLockDuplicate 创建更新
-func SQLCreateTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppLock, updates []string) (int64, error) {
+// SQLCreateTAppConfigStrDuplicate 创建更新
+func SQLCreateTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigStr, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_lock ( ")
+	query.WriteString("INSERT INTO t_app_config_str ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
        k,
-       v,
-       create_time
+       v
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
     :k,
-    :v,
-    :create_time
+    :v
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -2553,10 +2576,9 @@ func SQLCreateTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":          row.ID,
-			"k":           row.K,
-			"v":           row.V,
-			"create_time": row.CreateTime,
+			"id": row.ID,
+			"k":  row.K,
+			"v":  row.V,
 		},
 	)
 	if err != nil {
@@ -2565,8 +2587,8 @@ func SQLCreateTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 	return lastID, nil
 }
 
-// SQLCreateManyTAppLock 创建多个
-func SQLCreateManyTAppLock(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppLock, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppConfigStr 创建多个
+func SQLCreateManyTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigStr, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -2579,7 +2601,6 @@ func SQLCreateManyTAppLock(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 					row.ID,
 					row.K,
 					row.V,
-					row.CreateTime,
 				},
 			)
 		}
@@ -2590,7 +2611,6 @@ func SQLCreateManyTAppLock(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 				[]interface{}{
 					row.K,
 					row.V,
-					row.CreateTime,
 				},
 			)
 		}
@@ -2602,14 +2622,13 @@ func SQLCreateManyTAppLock(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_lock ( ")
+	query.WriteString("INTO t_app_config_str ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
     k,
-    v,
-    create_time
+    v
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -2625,8 +2644,8 @@ func SQLCreateManyTAppLock(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	return count, nil
 }
 
-// SQLCreateManyTAppLockDuplicate 创建多个
-func SQLCreateManyTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppLock, updates []string) (int64, error) {
+// SQLCreateManyTAppConfigStrDuplicate 创建多个
+func SQLCreateManyTAppConfigStrDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigStr, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -2639,7 +2658,6 @@ func SQLCreateManyTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 					row.ID,
 					row.K,
 					row.V,
-					row.CreateTime,
 				},
 			)
 		}
@@ -2650,7 +2668,6 @@ func SQLCreateManyTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 				[]interface{}{
 					row.K,
 					row.V,
-					row.CreateTime,
 				},
 			)
 		}
@@ -2658,14 +2675,13 @@ func SQLCreateManyTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_lock ( ")
+	query.WriteString("INSERT INTO t_app_config_str ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
     k,
-    v,
-    create_time
+    v
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -2697,18 +2713,18 @@ func SQLCreateManyTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	return count, nil
 }
 
-// SQLGetTAppLockCol 根据id查询
-func SQLGetTAppLockCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppLock, error) {
+// SQLGetTAppConfigStrCol 根据id查询
+func SQLGetTAppConfigStrCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigStr, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_lock
+	t_app_config_str
 WHERE
 	id=:id`)
 
-	var row DBTAppLock
+	var row DBTAppConfigStr
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -2727,8 +2743,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTAppLockColKV 根据id查询
-func SQLGetTAppLockColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppLock, error) {
+// SQLGetTAppConfigStrColKV 根据id查询
+func SQLGetTAppConfigStrColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigStr, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -2739,7 +2755,7 @@ func SQLGetTAppLockColKV(ctx context.Context, tx mcommon.DbExeAble, cols []strin
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_lock
+	t_app_config_str
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -2769,7 +2785,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTAppLock
+	var row DBTAppConfigStr
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -2786,8 +2802,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTAppLockCol 根据ids获取
-func SQLSelectTAppLockCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppLock, error) {
+// SQLSelectTAppConfigStrCol 根据ids获取
+func SQLSelectTAppConfigStrCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigStr, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -2796,7 +2812,7 @@ func SQLSelectTAppLockCol(ctx context.Context, tx mcommon.DbExeAble, cols []stri
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_lock
+	t_app_config_str
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -2810,7 +2826,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTAppLock
+	var rows []*DBTAppConfigStr
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -2826,8 +2842,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTAppLockColKV 根据ids获取
-func SQLSelectTAppLockColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppLock, error) {
+// SQLSelectTAppConfigStrColKV 根据ids获取
+func SQLSelectTAppConfigStrColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigStr, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -2838,7 +2854,7 @@ func SQLSelectTAppLockColKV(ctx context.Context, tx mcommon.DbExeAble, cols []st
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_lock
+	t_app_config_str
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -2879,7 +2895,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTAppLock
+	var rows []*DBTAppConfigStr
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -2893,24 +2909,22 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTAppLock 更新
-func SQLUpdateTAppLock(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppLock) (int64, error) {
+// SQLUpdateTAppConfigStr 更新
+func SQLUpdateTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigStr) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_app_lock
+	t_app_config_str
 SET
     k=:k,
-    v=:v,
-    create_time=:create_time
+    v=:v
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id":          row.ID,
-			"k":           row.K,
-			"v":           row.V,
-			"create_time": row.CreateTime,
+			"id": row.ID,
+			"k":  row.K,
+			"v":  row.V,
 		},
 	)
 	if err != nil {
@@ -2919,14 +2933,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTAppLock 删除
-func SQLDeleteTAppLock(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppConfigStr 删除
+func SQLDeleteTAppConfigStr(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_app_lock
+	t_app_config_str
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -2939,8 +2953,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTAppStatusInt 创建
-func SQLCreateTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppStatusInt, isIgnore bool) (int64, error) {
+// SQLCreateTAppConfigToken 创建
+func SQLCreateTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigToken, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -2948,29 +2962,50 @@ func SQLCreateTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTA
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_status_int ( ")
+	query.WriteString("INTO t_app_config_token ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       k,
-       v
+       chain,
+       token_address,
+       token_decimals,
+       token_symbol,
+       cold_address,
+       hot_address,
+       org_min_balance,
+       org_real_balance,
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :k,
-    :v
+    :chain,
+    :token_address,
+    :token_decimals,
+    :token_symbol,
+    :cold_address,
+    :hot_address,
+    :org_min_balance,
+    :org_real_balance,
+    :create_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id": row.ID,
-			"k":  row.K,
-			"v":  row.V,
+			"id":               row.ID,
+			"chain":            row.Chain,
+			"token_address":    row.TokenAddress,
+			"token_decimals":   row.TokenDecimals,
+			"token_symbol":     row.TokenSymbol,
+			"cold_address":     row.ColdAddress,
+			"hot_address":      row.HotAddress,
+			"org_min_balance":  row.OrgMinBalance,
+			"org_real_balance": row.OrgRealBalance,
+			"create_time":      row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -2979,25 +3014,39 @@ func SQLCreateTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTA
 	return lastID, nil
 }
 
-// SQLCreateTAppStatusIntDuplicate 创建更新
-func SQLCreateTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppStatusInt, updates []string) (int64, error) {
+// SQLCreateTAppConfigTokenDuplicate 创建更新
+func SQLCreateTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigToken, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_status_int ( ")
+	query.WriteString("INSERT INTO t_app_config_token ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       k,
-       v
+       chain,
+       token_address,
+       token_decimals,
+       token_symbol,
+       cold_address,
+       hot_address,
+       org_min_balance,
+       org_real_balance,
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :k,
-    :v
+    :chain,
+    :token_address,
+    :token_decimals,
+    :token_symbol,
+    :cold_address,
+    :hot_address,
+    :org_min_balance,
+    :org_real_balance,
+    :create_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -3020,9 +3069,16 @@ func SQLCreateTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id": row.ID,
-			"k":  row.K,
-			"v":  row.V,
+			"id":               row.ID,
+			"chain":            row.Chain,
+			"token_address":    row.TokenAddress,
+			"token_decimals":   row.TokenDecimals,
+			"token_symbol":     row.TokenSymbol,
+			"cold_address":     row.ColdAddress,
+			"hot_address":      row.HotAddress,
+			"org_min_balance":  row.OrgMinBalance,
+			"org_real_balance": row.OrgRealBalance,
+			"create_time":      row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -3031,8 +3087,8 @@ func SQLCreateTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 	return lastID, nil
 }
 
-// SQLCreateManyTAppStatusInt 创建多个
-func SQLCreateManyTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppStatusInt, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppConfigToken 创建多个
+func SQLCreateManyTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigToken, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -3043,8 +3099,15 @@ func SQLCreateManyTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, rows
 				args,
 				[]interface{}{
 					row.ID,
-					row.K,
-					row.V,
+					row.Chain,
+					row.TokenAddress,
+					row.TokenDecimals,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.OrgMinBalance,
+					row.OrgRealBalance,
+					row.CreateTime,
 				},
 			)
 		}
@@ -3053,8 +3116,15 @@ func SQLCreateManyTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, rows
 			args = append(
 				args,
 				[]interface{}{
-					row.K,
-					row.V,
+					row.Chain,
+					row.TokenAddress,
+					row.TokenDecimals,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.OrgMinBalance,
+					row.OrgRealBalance,
+					row.CreateTime,
 				},
 			)
 		}
@@ -3066,13 +3136,20 @@ func SQLCreateManyTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, rows
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_app_status_int ( ")
+	query.WriteString("INTO t_app_config_token ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    k,
-    v
+    chain,
+    token_address,
+    token_decimals,
+    token_symbol,
+    cold_address,
+    hot_address,
+    org_min_balance,
+    org_real_balance,
+    create_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -3088,8 +3165,8 @@ func SQLCreateManyTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, rows
 	return count, nil
 }
 
-// SQLCreateManyTAppStatusIntDuplicate 创建多个
-func SQLCreateManyTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppStatusInt, updates []string) (int64, error) {
+// SQLCreateManyTAppConfigTokenDuplicate 创建多个
+func SQLCreateManyTAppConfigTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigToken, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -3100,8 +3177,15 @@ func SQLCreateManyTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAb
 				args,
 				[]interface{}{
 					row.ID,
-					row.K,
-					row.V,
+					row.Chain,
+					row.TokenAddress,
+					row.TokenDecimals,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.OrgMinBalance,
+					row.OrgRealBalance,
+					row.CreateTime,
 				},
 			)
 		}
@@ -3110,8 +3194,15 @@ func SQLCreateManyTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAb
 			args = append(
 				args,
 				[]interface{}{
-					row.K,
-					row.V,
+					row.Chain,
+					row.TokenAddress,
+					row.TokenDecimals,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.OrgMinBalance,
+					row.OrgRealBalance,
+					row.CreateTime,
 				},
 			)
 		}
@@ -3119,13 +3210,20 @@ func SQLCreateManyTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAb
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_app_status_int ( ")
+	query.WriteString("INSERT INTO t_app_config_token ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    k,
-    v
+    chain,
+    token_address,
+    token_decimals,
+    token_symbol,
+    cold_address,
+    hot_address,
+    org_min_balance,
+    org_real_balance,
+    create_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -3157,18 +3255,18 @@ func SQLCreateManyTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAb
 	return count, nil
 }
 
-// SQLGetTAppStatusIntCol 根据id查询
-func SQLGetTAppStatusIntCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppStatusInt, error) {
+// SQLGetTAppConfigTokenCol 根据id查询
+func SQLGetTAppConfigTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigToken, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_status_int
+	t_app_config_token
 WHERE
 	id=:id`)
 
-	var row DBTAppStatusInt
+	var row DBTAppConfigToken
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -3187,8 +3285,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTAppStatusIntColKV 根据id查询
-func SQLGetTAppStatusIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppStatusInt, error) {
+// SQLGetTAppConfigTokenColKV 根据id查询
+func SQLGetTAppConfigTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigToken, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -3199,7 +3297,7 @@ func SQLGetTAppStatusIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_status_int
+	t_app_config_token
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -3229,7 +3327,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTAppStatusInt
+	var row DBTAppConfigToken
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -3246,8 +3344,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTAppStatusIntCol 根据ids获取
-func SQLSelectTAppStatusIntCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppStatusInt, error) {
+// SQLSelectTAppConfigTokenCol 根据ids获取
+func SQLSelectTAppConfigTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigToken, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -3256,7 +3354,7 @@ func SQLSelectTAppStatusIntCol(ctx context.Context, tx mcommon.DbExeAble, cols [
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_status_int
+	t_app_config_token
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -3270,7 +3368,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTAppStatusInt
+	var rows []*DBTAppConfigToken
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -3286,8 +3384,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTAppStatusIntColKV 根据ids获取
-func SQLSelectTAppStatusIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppStatusInt, error) {
+// SQLSelectTAppConfigTokenColKV 根据ids获取
+func SQLSelectTAppConfigTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigToken, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -3298,7 +3396,7 @@ func SQLSelectTAppStatusIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_app_status_int
+	t_app_config_token
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -3339,7 +3437,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTAppStatusInt
+	var rows []*DBTAppConfigToken
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -3353,22 +3451,36 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTAppStatusInt 更新
-func SQLUpdateTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppStatusInt) (int64, error) {
+// SQLUpdateTAppConfigToken 更新
+func SQLUpdateTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigToken) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_app_status_int
+	t_app_config_token
 SET
-    k=:k,
-    v=:v
+    chain=:chain,
+    token_address=:token_address,
+    token_decimals=:token_decimals,
+    token_symbol=:token_symbol,
+    cold_address=:cold_address,
+    hot_address=:hot_address,
+    org_min_balance=:org_min_balance,
+    org_real_balance=:org_real_balance,
+    create_time=:create_time
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id": row.ID,
-			"k":  row.K,
-			"v":  row.V,
+			"id":               row.ID,
+			"chain":            row.Chain,
+			"token_address":    row.TokenAddress,
+			"token_decimals":   row.TokenDecimals,
+			"token_symbol":     row.TokenSymbol,
+			"cold_address":     row.ColdAddress,
+			"hot_address":      row.HotAddress,
+			"org_min_balance":  row.OrgMinBalance,
+			"org_real_balance": row.OrgRealBalance,
+			"create_time":      row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -3377,14 +3489,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTAppStatusInt 删除
-func SQLDeleteTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppConfigToken 删除
+func SQLDeleteTAppConfigToken(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_app_status_int
+	t_app_config_token
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -3397,8 +3509,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTProduct 创建
-func SQLCreateTProduct(ctx context.Context, tx mcommon.DbExeAble, row *DBTProduct, isIgnore bool) (int64, error) {
+// SQLCreateTAppConfigTokenBtc 创建
+func SQLCreateTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenBtc, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -3406,66 +3518,81 @@ func SQLCreateTProduct(ctx context.Context, tx mcommon.DbExeAble, row *DBTProduc
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_product ( ")
+	query.WriteString("INTO t_app_config_token_btc ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       app_name,
-       app_sk,
-       cb_url,
-       whitelist_ip
+       token_index,
+       token_symbol,
+       cold_address,
+       hot_address,
+       fee_address,
+       tx_org_min_balance,
+       create_at
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :app_name,
-    :app_sk,
-    :cb_url,
-    :whitelist_ip
+    :token_index,
+    :token_symbol,
+    :cold_address,
+    :hot_address,
+    :fee_address,
+    :tx_org_min_balance,
+    :create_at
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":           row.ID,
-			"app_name":     row.AppName,
-			"app_sk":       row.AppSk,
-			"cb_url":       row.CbURL,
-			"whitelist_ip": row.WhitelistIP,
-		},
-	)
-	if err != nil {
+			"id":                 row.ID,
+			"token_index":        row.TokenIndex,
+			"token_symbol":       row.TokenSymbol,
+			"cold_address":       row.ColdAddress,
+			"hot_address":        row.HotAddress,
+			"fee_address":        row.FeeAddress,
+			"tx_org_min_balance": row.TxOrgMinBalance,
+			"create_at":          row.CreateAt,
+		},
+	)
+	if err != nil {
 		return 0, err
 	}
 	return lastID, nil
 }
 
-// SQLCreateTProductDuplicate 创建更新
-func SQLCreateTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTProduct, updates []string) (int64, error) {
+// SQLCreateTAppConfigTokenBtcDuplicate 创建更新
+func SQLCreateTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenBtc, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_product ( ")
+	query.WriteString("INSERT INTO t_app_config_token_btc ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       app_name,
-       app_sk,
-       cb_url,
-       whitelist_ip
+       token_index,
+       token_symbol,
+       cold_address,
+       hot_address,
+       fee_address,
+       tx_org_min_balance,
+       create_at
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :app_name,
-    :app_sk,
-    :cb_url,
-    :whitelist_ip
+    :token_index,
+    :token_symbol,
+    :cold_address,
+    :hot_address,
+    :fee_address,
+    :tx_org_min_balance,
+    :create_at
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -3488,11 +3615,14 @@ func SQLCreateTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":           row.ID,
-			"app_name":     row.AppName,
-			"app_sk":       row.AppSk,
-			"cb_url":       row.CbURL,
-			"whitelist_ip": row.WhitelistIP,
+			"id":                 row.ID,
+			"token_index":        row.TokenIndex,
+			"token_symbol":       row.TokenSymbol,
+			"cold_address":       row.ColdAddress,
+			"hot_address":        row.HotAddress,
+			"fee_address":        row.FeeAddress,
+			"tx_org_min_balance": row.TxOrgMinBalance,
+			"create_at":          row.CreateAt,
 		},
 	)
 	if err != nil {
@@ -3501,8 +3631,8 @@ func SQLCreateTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 	return lastID, nil
 }
 
-// SQLCreateManyTProduct 创建多个
-func SQLCreateManyTProduct(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProduct, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppConfigTokenBtc 创建多个
+func SQLCreateManyTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigTokenBtc, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -3513,10 +3643,13 @@ func SQLCreateManyTProduct(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 				args,
 				[]interface{}{
 					row.ID,
-					row.AppName,
-					row.AppSk,
-					row.CbURL,
-					row.WhitelistIP,
+					row.TokenIndex,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.FeeAddress,
+					row.TxOrgMinBalance,
+					row.CreateAt,
 				},
 			)
 		}
@@ -3525,10 +3658,13 @@ func SQLCreateManyTProduct(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 			args = append(
 				args,
 				[]interface{}{
-					row.AppName,
-					row.AppSk,
-					row.CbURL,
-					row.WhitelistIP,
+					row.TokenIndex,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.FeeAddress,
+					row.TxOrgMinBalance,
+					row.CreateAt,
 				},
 			)
 		}
@@ -3540,15 +3676,18 @@ func SQLCreateManyTProduct(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_product ( ")
+	query.WriteString("INTO t_app_config_token_btc ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    app_name,
-    app_sk,
-    cb_url,
-    whitelist_ip
+    token_index,
+    token_symbol,
+    cold_address,
+    hot_address,
+    fee_address,
+    tx_org_min_balance,
+    create_at
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -3564,8 +3703,8 @@ func SQLCreateManyTProduct(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	return count, nil
 }
 
-// SQLCreateManyTProductDuplicate 创建多个
-func SQLCreateManyTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProduct, updates []string) (int64, error) {
+// SQLCreateManyTAppConfigTokenBtcDuplicate 创建多个
+func SQLCreateManyTAppConfigTokenBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppConfigTokenBtc, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -3576,10 +3715,13 @@ func SQLCreateManyTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 				args,
 				[]interface{}{
 					row.ID,
-					row.AppName,
-					row.AppSk,
-					row.CbURL,
-					row.WhitelistIP,
+					row.TokenIndex,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.FeeAddress,
+					row.TxOrgMinBalance,
+					row.CreateAt,
 				},
 			)
 		}
@@ -3588,10 +3730,13 @@ func SQLCreateManyTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 			args = append(
 				args,
 				[]interface{}{
-					row.AppName,
-					row.AppSk,
-					row.CbURL,
-					row.WhitelistIP,
+					row.TokenIndex,
+					row.TokenSymbol,
+					row.ColdAddress,
+					row.HotAddress,
+					row.FeeAddress,
+					row.TxOrgMinBalance,
+					row.CreateAt,
 				},
 			)
 		}
@@ -3599,15 +3744,18 @@ func SQLCreateManyTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_product ( ")
+	query.WriteString("INSERT INTO t_app_config_token_btc ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    app_name,
-    app_sk,
-    cb_url,
-    whitelist_ip
+    token_index,
+    token_symbol,
+    cold_address,
+    hot_address,
+    fee_address,
+    tx_org_min_balance,
+    create_at
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -3639,18 +3787,18 @@ func SQLCreateManyTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	return count, nil
 }
 
-// SQLGetTProductCol 根据id查询
-func SQLGetTProductCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTProduct, error) {
+// SQLGetTAppConfigTokenBtcCol 根据id查询
+func SQLGetTAppConfigTokenBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppConfigTokenBtc, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product
+	t_app_config_token_btc
 WHERE
 	id=:id`)
 
-	var row DBTProduct
+	var row DBTAppConfigTokenBtc
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -3669,8 +3817,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTProductColKV 根据id查询
-func SQLGetTProductColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTProduct, error) {
+// SQLGetTAppConfigTokenBtcColKV 根据id查询
+func SQLGetTAppConfigTokenBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppConfigTokenBtc, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -3681,7 +3829,7 @@ func SQLGetTProductColKV(ctx context.Context, tx mcommon.DbExeAble, cols []strin
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product
+	t_app_config_token_btc
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -3711,7 +3859,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTProduct
+	var row DBTAppConfigTokenBtc
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -3728,8 +3876,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTProductCol 根据ids获取
-func SQLSelectTProductCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTProduct, error) {
+// SQLSelectTAppConfigTokenBtcCol 根据ids获取
+func SQLSelectTAppConfigTokenBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppConfigTokenBtc, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -3738,7 +3886,7 @@ func SQLSelectTProductCol(ctx context.Context, tx mcommon.DbExeAble, cols []stri
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product
+	t_app_config_token_btc
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -3752,7 +3900,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTProduct
+	var rows []*DBTAppConfigTokenBtc
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -3768,8 +3916,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTProductColKV 根据ids获取
-func SQLSelectTProductColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTProduct, error) {
+// SQLSelectTAppConfigTokenBtcColKV 根据ids获取
+func SQLSelectTAppConfigTokenBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppConfigTokenBtc, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -3780,7 +3928,7 @@ func SQLSelectTProductColKV(ctx context.Context, tx mcommon.DbExeAble, cols []st
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product
+	t_app_config_token_btc
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -3821,7 +3969,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTProduct
+	var rows []*DBTAppConfigTokenBtc
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -3835,26 +3983,32 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTProduct 更新
-func SQLUpdateTProduct(ctx context.Context, tx mcommon.DbExeAble, row *DBTProduct) (int64, error) {
+// SQLUpdateTAppConfigTokenBtc 更新
+func SQLUpdateTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppConfigTokenBtc) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_product
+	t_app_config_token_btc
 SET
-    app_name=:app_name,
-    app_sk=:app_sk,
-    cb_url=:cb_url,
-    whitelist_ip=:whitelist_ip
+    token_index=:token_index,
+    token_symbol=:token_symbol,
+    cold_address=:cold_address,
+    hot_address=:hot_address,
+    fee_address=:fee_address,
+    tx_org_min_balance=:tx_org_min_balance,
+    create_at=:create_at
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id":           row.ID,
-			"app_name":     row.AppName,
-			"app_sk":       row.AppSk,
-			"cb_url":       row.CbURL,
-			"whitelist_ip": row.WhitelistIP,
+			"id":                 row.ID,
+			"token_index":        row.TokenIndex,
+			"token_symbol":       row.TokenSymbol,
+			"cold_address":       row.ColdAddress,
+			"hot_address":        row.HotAddress,
+			"fee_address":        row.FeeAddress,
+			"tx_org_min_balance": row.TxOrgMinBalance,
+			"create_at":          row.CreateAt,
 		},
 	)
 	if err != nil {
@@ -3863,14 +4017,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTProduct 删除
-func SQLDeleteTProduct(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppConfigTokenBtc 删除
+func SQLDeleteTAppConfigTokenBtc(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_product
+	t_app_config_token_btc
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -3883,8 +4037,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTProductNonce 创建
-func SQLCreateTProductNonce(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNonce, isIgnore bool) (int64, error) {
+// SQLCreateTAppJob 创建
+func SQLCreateTAppJob(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppJob, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -3892,29 +4046,35 @@ func SQLCreateTProductNonce(ctx context.Context, tx mcommon.DbExeAble, row *DBTP
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_product_nonce ( ")
+	query.WriteString("INTO t_app_job ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       c,
-       create_time
+       name,
+       spec,
+       enable,
+       timeout_seconds
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :c,
-    :create_time
+    :name,
+    :spec,
+    :enable,
+    :timeout_seconds
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":          row.ID,
-			"c":           row.C,
-			"create_time": row.CreateTime,
+			"id":              row.ID,
+			"name":            row.Name,
+			"spec":            row.Spec,
+			"enable":          row.Enable,
+			"timeout_seconds": row.TimeoutSeconds,
 		},
 	)
 	if err != nil {
@@ -3923,25 +4083,29 @@ func SQLCreateTProductNonce(ctx context.Context, tx mcommon.DbExeAble, row *DBTP
 	return lastID, nil
 }
 
-// SQLCreateTProductNonceDuplicate 创建更新
-func SQLCreateTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNonce, updates []string) (int64, error) {
+// SQLCreateTAppJobDuplicate 创建更新
+func SQLCreateTAppJobDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppJob, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_product_nonce ( ")
+	query.WriteString("INSERT INTO t_app_job ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       c,
-       create_time
+       name,
+       spec,
+       enable,
+       timeout_seconds
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :c,
-    :create_time
+    :name,
+    :spec,
+    :enable,
+    :timeout_seconds
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -3964,9 +4128,11 @@ func SQLCreateTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":          row.ID,
-			"c":           row.C,
-			"create_time": row.CreateTime,
+			"id":              row.ID,
+			"name":            row.Name,
+			"spec":            row.Spec,
+			"enable":          row.Enable,
+			"timeout_seconds": row.TimeoutSeconds,
 		},
 	)
 	if err != nil {
@@ -3975,8 +4141,8 @@ func SQLCreateTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 	return lastID, nil
 }
 
-// SQLCreateManyTProductNonce 创建多个
-func SQLCreateManyTProductNonce(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProductNonce, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppJob 创建多个
+func SQLCreateManyTAppJob(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppJob, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -3987,8 +4153,10 @@ func SQLCreateManyTProductNonce(ctx context.Context, tx mcommon.DbExeAble, rows
 				args,
 				[]interface{}{
 					row.ID,
-					row.C,
-					row.CreateTime,
+					row.Name,
+					row.Spec,
+					row.Enable,
+					row.TimeoutSeconds,
 				},
 			)
 		}
@@ -3997,8 +4165,10 @@ func SQLCreateManyTProductNonce(ctx context.Context, tx mcommon.DbExeAble, rows
 			args = append(
 				args,
 				[]interface{}{
-					row.C,
-					row.CreateTime,
+					row.Name,
+					row.Spec,
+					row.Enable,
+					row.TimeoutSeconds,
 				},
 			)
 		}
@@ -4010,13 +4180,15 @@ func SQLCreateManyTProductNonce(ctx context.Context, tx mcommon.DbExeAble, rows
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_product_nonce ( ")
+	query.WriteString("INTO t_app_job ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    c,
-    create_time
+    name,
+    spec,
+    enable,
+    timeout_seconds
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -4032,8 +4204,8 @@ func SQLCreateManyTProductNonce(ctx context.Context, tx mcommon.DbExeAble, rows
 	return count, nil
 }
 
-// SQLCreateManyTProductNonceDuplicate 创建多个
-func SQLCreateManyTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProductNonce, updates []string) (int64, error) {
+// SQLCreateManyTAppJobDuplicate 创建多个
+func SQLCreateManyTAppJobDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppJob, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -4044,8 +4216,10 @@ func SQLCreateManyTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAb
 				args,
 				[]interface{}{
 					row.ID,
-					row.C,
-					row.CreateTime,
+					row.Name,
+					row.Spec,
+					row.Enable,
+					row.TimeoutSeconds,
 				},
 			)
 		}
@@ -4054,8 +4228,10 @@ func SQLCreateManyTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAb
 			args = append(
 				args,
 				[]interface{}{
-					row.C,
-					row.CreateTime,
+					row.Name,
+					row.Spec,
+					row.Enable,
+					row.TimeoutSeconds,
 				},
 			)
 		}
@@ -4063,13 +4239,15 @@ func SQLCreateManyTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAb
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_product_nonce ( ")
+	query.WriteString("INSERT INTO t_app_job ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    c,
-    create_time
+    name,
+    spec,
+    enable,
+    timeout_seconds
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -4101,18 +4279,18 @@ func SQLCreateManyTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAb
 	return count, nil
 }
 
-// SQLGetTProductNonceCol 根据id查询
-func SQLGetTProductNonceCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTProductNonce, error) {
+// SQLGetTAppJobCol 根据id查询
+func SQLGetTAppJobCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppJob, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product_nonce
+	t_app_job
 WHERE
 	id=:id`)
 
-	var row DBTProductNonce
+	var row DBTAppJob
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -4131,8 +4309,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTProductNonceColKV 根据id查询
-func SQLGetTProductNonceColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTProductNonce, error) {
+// SQLGetTAppJobColKV 根据id查询
+func SQLGetTAppJobColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppJob, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -4143,7 +4321,7 @@ func SQLGetTProductNonceColKV(ctx context.Context, tx mcommon.DbExeAble, cols []
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product_nonce
+	t_app_job
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -4173,7 +4351,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTProductNonce
+	var row DBTAppJob
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -4190,8 +4368,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTProductNonceCol 根据ids获取
-func SQLSelectTProductNonceCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTProductNonce, error) {
+// SQLSelectTAppJobCol 根据ids获取
+func SQLSelectTAppJobCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppJob, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -4200,7 +4378,7 @@ func SQLSelectTProductNonceCol(ctx context.Context, tx mcommon.DbExeAble, cols [
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product_nonce
+	t_app_job
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -4214,7 +4392,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTProductNonce
+	var rows []*DBTAppJob
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -4230,8 +4408,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTProductNonceColKV 根据ids获取
-func SQLSelectTProductNonceColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTProductNonce, error) {
+// SQLSelectTAppJobColKV 根据ids获取
+func SQLSelectTAppJobColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppJob, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -4242,7 +4420,7 @@ func SQLSelectTProductNonceColKV(ctx context.Context, tx mcommon.DbExeAble, cols
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product_nonce
+	t_app_job
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -4283,7 +4461,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTProductNonce
+	var rows []*DBTAppJob
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -4297,22 +4475,26 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTProductNonce 更新
-func SQLUpdateTProductNonce(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNonce) (int64, error) {
+// SQLUpdateTAppJob 更新
+func SQLUpdateTAppJob(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppJob) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_product_nonce
+	t_app_job
 SET
-    c=:c,
-    create_time=:create_time
+    name=:name,
+    spec=:spec,
+    enable=:enable,
+    timeout_seconds=:timeout_seconds
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id":          row.ID,
-			"c":           row.C,
-			"create_time": row.CreateTime,
+			"id":              row.ID,
+			"name":            row.Name,
+			"spec":            row.Spec,
+			"enable":          row.Enable,
+			"timeout_seconds": row.TimeoutSeconds,
 		},
 	)
 	if err != nil {
@@ -4321,14 +4503,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTProductNonce 删除
-func SQLDeleteTProductNonce(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppJob 删除
+func SQLDeleteTAppJob(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_product_nonce
+	t_app_job
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -4341,8 +4523,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTProductNotify 创建
-func SQLCreateTProductNotify(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNotify, isIgnore bool) (int64, error) {
+// SQLCreateTAppJobRun 创建
+func SQLCreateTAppJobRun(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppJobRun, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -4350,59 +4532,47 @@ func SQLCreateTProductNotify(ctx context.Context, tx mcommon.DbExeAble, row *DBT
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_product_notify ( ")
+	query.WriteString("INTO t_app_job_run ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       nonce,
-       product_id,
-       item_type,
-       item_id,
-       notify_type,
-       token_symbol,
-       url,
-       msg,
-       handle_status,
-       handle_msg,
-       create_time,
-       update_time
+       name,
+       instance,
+       start_time,
+       end_time,
+       cost_ms,
+       handle_count,
+       lock_skipped,
+       err_msg
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :nonce,
-    :product_id,
-    :item_type,
-    :item_id,
-    :notify_type,
-    :token_symbol,
-    :url,
-    :msg,
-    :handle_status,
-    :handle_msg,
-    :create_time,
-    :update_time
+    :name,
+    :instance,
+    :start_time,
+    :end_time,
+    :cost_ms,
+    :handle_count,
+    :lock_skipped,
+    :err_msg
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":            row.ID,
-			"nonce":         row.Nonce,
-			"product_id":    row.ProductID,
-			"item_type":     row.ItemType,
-			"item_id":       row.ItemID,
-			"notify_type":   row.NotifyType,
-			"token_symbol":  row.TokenSymbol,
-			"url":           row.URL,
-			"msg":           row.Msg,
-			"handle_status": row.HandleStatus,
-			"handle_msg":    row.HandleMsg,
-			"create_time":   row.CreateTime,
-			"update_time":   row.UpdateTime,
+			"id":           row.ID,
+			"name":         row.Name,
+			"instance":     row.Instance,
+			"start_time":   row.StartTime,
+			"end_time":     row.EndTime,
+			"cost_ms":      row.CostMs,
+			"handle_count": row.HandleCount,
+			"lock_skipped": row.LockSkipped,
+			"err_msg":      row.ErrMsg,
 		},
 	)
 	if err != nil {
@@ -4411,45 +4581,37 @@ func SQLCreateTProductNotify(ctx context.Context, tx mcommon.DbExeAble, row *DBT
 	return lastID, nil
 }
 
-// SQLCreateTProductNotifyDuplicate 创建更新
-func SQLCreateTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNotify, updates []string) (int64, error) {
+// SQLCreateTAppJobRunDuplicate 创建更新
+func SQLCreateTAppJobRunDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppJobRun, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_product_notify ( ")
+	query.WriteString("INSERT INTO t_app_job_run ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       nonce,
-       product_id,
-       item_type,
-       item_id,
-       notify_type,
-       token_symbol,
-       url,
-       msg,
-       handle_status,
-       handle_msg,
-       create_time,
-       update_time
+       name,
+       instance,
+       start_time,
+       end_time,
+       cost_ms,
+       handle_count,
+       lock_skipped,
+       err_msg
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :nonce,
-    :product_id,
-    :item_type,
-    :item_id,
-    :notify_type,
-    :token_symbol,
-    :url,
-    :msg,
-    :handle_status,
-    :handle_msg,
-    :create_time,
-    :update_time
+    :name,
+    :instance,
+    :start_time,
+    :end_time,
+    :cost_ms,
+    :handle_count,
+    :lock_skipped,
+    :err_msg
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -4472,19 +4634,15 @@ func SQLCreateTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":            row.ID,
-			"nonce":         row.Nonce,
-			"product_id":    row.ProductID,
-			"item_type":     row.ItemType,
-			"item_id":       row.ItemID,
-			"notify_type":   row.NotifyType,
-			"token_symbol":  row.TokenSymbol,
-			"url":           row.URL,
-			"msg":           row.Msg,
-			"handle_status": row.HandleStatus,
-			"handle_msg":    row.HandleMsg,
-			"create_time":   row.CreateTime,
-			"update_time":   row.UpdateTime,
+			"id":           row.ID,
+			"name":         row.Name,
+			"instance":     row.Instance,
+			"start_time":   row.StartTime,
+			"end_time":     row.EndTime,
+			"cost_ms":      row.CostMs,
+			"handle_count": row.HandleCount,
+			"lock_skipped": row.LockSkipped,
+			"err_msg":      row.ErrMsg,
 		},
 	)
 	if err != nil {
@@ -4493,8 +4651,8 @@ func SQLCreateTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 	return lastID, nil
 }
 
-// SQLCreateManyTProductNotify 创建多个
-func SQLCreateManyTProductNotify(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProductNotify, isIgnore bool) (int64, error) {
+// SQLCreateManyTAppJobRun 创建多个
+func SQLCreateManyTAppJobRun(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppJobRun, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -4505,18 +4663,14 @@ func SQLCreateManyTProductNotify(ctx context.Context, tx mcommon.DbExeAble, rows
 				args,
 				[]interface{}{
 					row.ID,
-					row.Nonce,
-					row.ProductID,
-					row.ItemType,
-					row.ItemID,
-					row.NotifyType,
-					row.TokenSymbol,
-					row.URL,
-					row.Msg,
-					row.HandleStatus,
-					row.HandleMsg,
-					row.CreateTime,
-					row.UpdateTime,
+					row.Name,
+					row.Instance,
+					row.StartTime,
+					row.EndTime,
+					row.CostMs,
+					row.HandleCount,
+					row.LockSkipped,
+					row.ErrMsg,
 				},
 			)
 		}
@@ -4525,18 +4679,14 @@ func SQLCreateManyTProductNotify(ctx context.Context, tx mcommon.DbExeAble, rows
 			args = append(
 				args,
 				[]interface{}{
-					row.Nonce,
-					row.ProductID,
-					row.ItemType,
-					row.ItemID,
-					row.NotifyType,
-					row.TokenSymbol,
-					row.URL,
-					row.Msg,
-					row.HandleStatus,
-					row.HandleMsg,
-					row.CreateTime,
-					row.UpdateTime,
+					row.Name,
+					row.Instance,
+					row.StartTime,
+					row.EndTime,
+					row.CostMs,
+					row.HandleCount,
+					row.LockSkipped,
+					row.ErrMsg,
 				},
 			)
 		}
@@ -4548,23 +4698,19 @@ func SQLCreateManyTProductNotify(ctx context.Context, tx mcommon.DbExeAble, rows
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_product_notify ( ")
+	query.WriteString("INTO t_app_job_run ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    nonce,
-    product_id,
-    item_type,
-    item_id,
-    notify_type,
-    token_symbol,
-    url,
-    msg,
-    handle_status,
-    handle_msg,
-    create_time,
-    update_time
+    name,
+    instance,
+    start_time,
+    end_time,
+    cost_ms,
+    handle_count,
+    lock_skipped,
+    err_msg
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -4580,8 +4726,8 @@ func SQLCreateManyTProductNotify(ctx context.Context, tx mcommon.DbExeAble, rows
 	return count, nil
 }
 
-// SQLCreateManyTProductNotifyDuplicate 创建多个
-func SQLCreateManyTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProductNotify, updates []string) (int64, error) {
+// SQLCreateManyTAppJobRunDuplicate 创建多个
+func SQLCreateManyTAppJobRunDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppJobRun, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -4592,18 +4738,14 @@ func SQLCreateManyTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeA
 				args,
 				[]interface{}{
 					row.ID,
-					row.Nonce,
-					row.ProductID,
-					row.ItemType,
-					row.ItemID,
-					row.NotifyType,
-					row.TokenSymbol,
-					row.URL,
-					row.Msg,
-					row.HandleStatus,
-					row.HandleMsg,
-					row.CreateTime,
-					row.UpdateTime,
+					row.Name,
+					row.Instance,
+					row.StartTime,
+					row.EndTime,
+					row.CostMs,
+					row.HandleCount,
+					row.LockSkipped,
+					row.ErrMsg,
 				},
 			)
 		}
@@ -4612,18 +4754,14 @@ func SQLCreateManyTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeA
 			args = append(
 				args,
 				[]interface{}{
-					row.Nonce,
-					row.ProductID,
-					row.ItemType,
-					row.ItemID,
-					row.NotifyType,
-					row.TokenSymbol,
-					row.URL,
-					row.Msg,
-					row.HandleStatus,
-					row.HandleMsg,
-					row.CreateTime,
-					row.UpdateTime,
+					row.Name,
+					row.Instance,
+					row.StartTime,
+					row.EndTime,
+					row.CostMs,
+					row.HandleCount,
+					row.LockSkipped,
+					row.ErrMsg,
 				},
 			)
 		}
@@ -4631,23 +4769,19 @@ func SQLCreateManyTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeA
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_product_notify ( ")
+	query.WriteString("INSERT INTO t_app_job_run ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    nonce,
-    product_id,
-    item_type,
-    item_id,
-    notify_type,
-    token_symbol,
-    url,
-    msg,
-    handle_status,
-    handle_msg,
-    create_time,
-    update_time
+    name,
+    instance,
+    start_time,
+    end_time,
+    cost_ms,
+    handle_count,
+    lock_skipped,
+    err_msg
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -4679,18 +4813,18 @@ func SQLCreateManyTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeA
 	return count, nil
 }
 
-// SQLGetTProductNotifyCol 根据id查询
-func SQLGetTProductNotifyCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTProductNotify, error) {
+// SQLGetTAppJobRunCol 根据id查询
+func SQLGetTAppJobRunCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppJobRun, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product_notify
+	t_app_job_run
 WHERE
 	id=:id`)
 
-	var row DBTProductNotify
+	var row DBTAppJobRun
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -4709,8 +4843,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTProductNotifyColKV 根据id查询
-func SQLGetTProductNotifyColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTProductNotify, error) {
+// SQLGetTAppJobRunColKV 根据id查询
+func SQLGetTAppJobRunColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppJobRun, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -4721,7 +4855,7 @@ func SQLGetTProductNotifyColKV(ctx context.Context, tx mcommon.DbExeAble, cols [
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product_notify
+	t_app_job_run
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -4751,7 +4885,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTProductNotify
+	var row DBTAppJobRun
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -4768,8 +4902,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTProductNotifyCol 根据ids获取
-func SQLSelectTProductNotifyCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTProductNotify, error) {
+// SQLSelectTAppJobRunCol 根据ids获取
+func SQLSelectTAppJobRunCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppJobRun, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -4778,7 +4912,7 @@ func SQLSelectTProductNotifyCol(ctx context.Context, tx mcommon.DbExeAble, cols
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product_notify
+	t_app_job_run
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -4792,7 +4926,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTProductNotify
+	var rows []*DBTAppJobRun
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -4808,8 +4942,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTProductNotifyColKV 根据ids获取
-func SQLSelectTProductNotifyColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTProductNotify, error) {
+// SQLSelectTAppJobRunColKV 根据ids获取
+func SQLSelectTAppJobRunColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppJobRun, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -4820,7 +4954,7 @@ func SQLSelectTProductNotifyColKV(ctx context.Context, tx mcommon.DbExeAble, col
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_product_notify
+	t_app_job_run
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -4861,7 +4995,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTProductNotify
+	var rows []*DBTAppJobRun
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -4875,42 +5009,34 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTProductNotify 更新
-func SQLUpdateTProductNotify(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNotify) (int64, error) {
+// SQLUpdateTAppJobRun 更新
+func SQLUpdateTAppJobRun(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppJobRun) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_product_notify
+	t_app_job_run
 SET
-    nonce=:nonce,
-    product_id=:product_id,
-    item_type=:item_type,
-    item_id=:item_id,
-    notify_type=:notify_type,
-    token_symbol=:token_symbol,
-    url=:url,
-    msg=:msg,
-    handle_status=:handle_status,
-    handle_msg=:handle_msg,
-    create_time=:create_time,
-    update_time=:update_time
+    name=:name,
+    instance=:instance,
+    start_time=:start_time,
+    end_time=:end_time,
+    cost_ms=:cost_ms,
+    handle_count=:handle_count,
+    lock_skipped=:lock_skipped,
+    err_msg=:err_msg
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id":            row.ID,
-			"nonce":         row.Nonce,
-			"product_id":    row.ProductID,
-			"item_type":     row.ItemType,
-			"item_id":       row.ItemID,
-			"notify_type":   row.NotifyType,
-			"token_symbol":  row.TokenSymbol,
-			"url":           row.URL,
-			"msg":           row.Msg,
-			"handle_status": row.HandleStatus,
-			"handle_msg":    row.HandleMsg,
-			"create_time":   row.CreateTime,
-			"update_time":   row.UpdateTime,
+			"id":           row.ID,
+			"name":         row.Name,
+			"instance":     row.Instance,
+			"start_time":   row.StartTime,
+			"end_time":     row.EndTime,
+			"cost_ms":      row.CostMs,
+			"handle_count": row.HandleCount,
+			"lock_skipped": row.LockSkipped,
+			"err_msg":      row.ErrMsg,
 		},
 	)
 	if err != nil {
@@ -4919,14 +5045,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTProductNotify 删除
-func SQLDeleteTProductNotify(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTAppJobRun 删除
+func SQLDeleteTAppJobRun(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_product_notify
+	t_app_job_run
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -4939,8 +5065,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTSend 创建
-func SQLCreateTSend(ctx context.Context, tx mcommon.DbExeAble, row *DBTSend, isIgnore bool) (int64, error) {
+// SQLCreateTAppLock 创建
+func SQLCreateTAppLock(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppLock, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -4948,68 +5074,6994 @@ func SQLCreateTSend(ctx context.Context, tx mcommon.DbExeAble, row *DBTSend, isI
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_send ( ")
+	query.WriteString("INTO t_app_lock ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       related_type,
-       related_id,
-       token_id,
-       tx_id,
-       from_address,
-       to_address,
-       balance_real,
-       gas,
-       gas_price,
-       nonce,
-       hex,
+       k,
+       v,
+       owner,
        create_time,
-       handle_status,
-       handle_msg,
-       handle_time
+       expire_time,
+       update_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :related_type,
-    :related_id,
-    :token_id,
-    :tx_id,
-    :from_address,
-    :to_address,
-    :balance_real,
-    :gas,
-    :gas_price,
-    :nonce,
-    :hex,
+    :k,
+    :v,
+    :owner,
     :create_time,
-    :handle_status,
-    :handle_msg,
-    :handle_time
+    :expire_time,
+    :update_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":            row.ID,
-			"related_type":  row.RelatedType,
-			"related_id":    row.RelatedID,
-			"token_id":      row.TokenID,
-			"tx_id":         row.TxID,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
-			"gas":           row.Gas,
-			"gas_price":     row.GasPrice,
-			"nonce":         row.Nonce,
+			"id":          row.ID,
+			"k":           row.K,
+			"v":           row.V,
+			"owner":       row.Owner,
+			"create_time": row.CreateTime,
+			"expire_time": row.ExpireTime,
+			"update_time": row.UpdateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTAppLockDuplicate 创建更新
+func SQLCreateTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppLock, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_app_lock ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       k,
+       v,
+       owner,
+       create_time,
+       expire_time,
+       update_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :k,
+    :v,
+    :owner,
+    :create_time,
+    :expire_time,
+    :update_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":          row.ID,
+			"k":           row.K,
+			"v":           row.V,
+			"owner":       row.Owner,
+			"create_time": row.CreateTime,
+			"expire_time": row.ExpireTime,
+			"update_time": row.UpdateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTAppLock 创建多个
+func SQLCreateManyTAppLock(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppLock, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.K,
+					row.V,
+					row.Owner,
+					row.CreateTime,
+					row.ExpireTime,
+					row.UpdateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.K,
+					row.V,
+					row.Owner,
+					row.CreateTime,
+					row.ExpireTime,
+					row.UpdateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_app_lock ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    k,
+    v,
+    owner,
+    create_time,
+    expire_time,
+    update_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTAppLockDuplicate 创建多个
+func SQLCreateManyTAppLockDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppLock, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.K,
+					row.V,
+					row.Owner,
+					row.CreateTime,
+					row.ExpireTime,
+					row.UpdateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.K,
+					row.V,
+					row.Owner,
+					row.CreateTime,
+					row.ExpireTime,
+					row.UpdateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_app_lock ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    k,
+    v,
+    owner,
+    create_time,
+    expire_time,
+    update_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTAppLockCol 根据id查询
+func SQLGetTAppLockCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppLock, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_lock
+WHERE
+	id=:id`)
+
+	var row DBTAppLock
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTAppLockColKV 根据id查询
+func SQLGetTAppLockColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppLock, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_lock
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTAppLock
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTAppLockCol 根据ids获取
+func SQLSelectTAppLockCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppLock, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_lock
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTAppLock
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTAppLockColKV 根据ids获取
+func SQLSelectTAppLockColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppLock, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_lock
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTAppLock
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTAppLock 更新
+func SQLUpdateTAppLock(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppLock) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_app_lock
+SET
+    k=:k,
+    v=:v,
+    owner=:owner,
+    create_time=:create_time,
+    expire_time=:expire_time,
+    update_time=:update_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":          row.ID,
+			"k":           row.K,
+			"v":           row.V,
+			"owner":       row.Owner,
+			"create_time": row.CreateTime,
+			"expire_time": row.ExpireTime,
+			"update_time": row.UpdateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTAppLock 删除
+func SQLDeleteTAppLock(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_app_lock
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTAppStatusInt 创建
+func SQLCreateTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppStatusInt, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_app_status_int ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       k,
+       v
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :k,
+    :v
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id": row.ID,
+			"k":  row.K,
+			"v":  row.V,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTAppStatusIntDuplicate 创建更新
+func SQLCreateTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppStatusInt, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_app_status_int ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       k,
+       v
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :k,
+    :v
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id": row.ID,
+			"k":  row.K,
+			"v":  row.V,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTAppStatusInt 创建多个
+func SQLCreateManyTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppStatusInt, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.K,
+					row.V,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.K,
+					row.V,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_app_status_int ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    k,
+    v
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTAppStatusIntDuplicate 创建多个
+func SQLCreateManyTAppStatusIntDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTAppStatusInt, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.K,
+					row.V,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.K,
+					row.V,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_app_status_int ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    k,
+    v
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTAppStatusIntCol 根据id查询
+func SQLGetTAppStatusIntCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTAppStatusInt, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_status_int
+WHERE
+	id=:id`)
+
+	var row DBTAppStatusInt
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTAppStatusIntColKV 根据id查询
+func SQLGetTAppStatusIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTAppStatusInt, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_status_int
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTAppStatusInt
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTAppStatusIntCol 根据ids获取
+func SQLSelectTAppStatusIntCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTAppStatusInt, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_status_int
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTAppStatusInt
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTAppStatusIntColKV 根据ids获取
+func SQLSelectTAppStatusIntColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTAppStatusInt, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_app_status_int
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTAppStatusInt
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTAppStatusInt 更新
+func SQLUpdateTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, row *DBTAppStatusInt) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_app_status_int
+SET
+    k=:k,
+    v=:v
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": row.ID,
+			"k":  row.K,
+			"v":  row.V,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTAppStatusInt 删除
+func SQLDeleteTAppStatusInt(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_app_status_int
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTBtcBlock 创建
+func SQLCreateTBtcBlock(ctx context.Context, tx mcommon.DbExeAble, row *DBTBtcBlock, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_btc_block ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       seek_key,
+       block_number,
+       block_hash,
+       parent_hash,
+       create_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :seek_key,
+    :block_number,
+    :block_hash,
+    :parent_hash,
+    :create_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":           row.ID,
+			"seek_key":     row.SeekKey,
+			"block_number": row.BlockNumber,
+			"block_hash":   row.BlockHash,
+			"parent_hash":  row.ParentHash,
+			"create_time":  row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTBtcBlockDuplicate 创建更新
+func SQLCreateTBtcBlockDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTBtcBlock, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_btc_block ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       seek_key,
+       block_number,
+       block_hash,
+       parent_hash,
+       create_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :seek_key,
+    :block_number,
+    :block_hash,
+    :parent_hash,
+    :create_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":           row.ID,
+			"seek_key":     row.SeekKey,
+			"block_number": row.BlockNumber,
+			"block_hash":   row.BlockHash,
+			"parent_hash":  row.ParentHash,
+			"create_time":  row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTBtcBlock 创建多个
+func SQLCreateManyTBtcBlock(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTBtcBlock, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.SeekKey,
+					row.BlockNumber,
+					row.BlockHash,
+					row.ParentHash,
+					row.CreateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.SeekKey,
+					row.BlockNumber,
+					row.BlockHash,
+					row.ParentHash,
+					row.CreateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_btc_block ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    seek_key,
+    block_number,
+    block_hash,
+    parent_hash,
+    create_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTBtcBlockDuplicate 创建多个
+func SQLCreateManyTBtcBlockDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTBtcBlock, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.SeekKey,
+					row.BlockNumber,
+					row.BlockHash,
+					row.ParentHash,
+					row.CreateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.SeekKey,
+					row.BlockNumber,
+					row.BlockHash,
+					row.ParentHash,
+					row.CreateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_btc_block ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    seek_key,
+    block_number,
+    block_hash,
+    parent_hash,
+    create_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTBtcBlockCol 根据id查询
+func SQLGetTBtcBlockCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTBtcBlock, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_btc_block
+WHERE
+	id=:id`)
+
+	var row DBTBtcBlock
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTBtcBlockColKV 根据id查询
+func SQLGetTBtcBlockColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTBtcBlock, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_btc_block
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTBtcBlock
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTBtcBlockCol 根据ids获取
+func SQLSelectTBtcBlockCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTBtcBlock, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_btc_block
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTBtcBlock
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTBtcBlockColKV 根据ids获取
+func SQLSelectTBtcBlockColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTBtcBlock, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_btc_block
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTBtcBlock
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTBtcBlock 更新
+func SQLUpdateTBtcBlock(ctx context.Context, tx mcommon.DbExeAble, row *DBTBtcBlock) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_btc_block
+SET
+    seek_key=:seek_key,
+    block_number=:block_number,
+    block_hash=:block_hash,
+    parent_hash=:parent_hash,
+    create_time=:create_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":           row.ID,
+			"seek_key":     row.SeekKey,
+			"block_number": row.BlockNumber,
+			"block_hash":   row.BlockHash,
+			"parent_hash":  row.ParentHash,
+			"create_time":  row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTBtcBlock 删除
+func SQLDeleteTBtcBlock(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_btc_block
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTEthBlock 创建
+func SQLCreateTEthBlock(ctx context.Context, tx mcommon.DbExeAble, row *DBTEthBlock, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_eth_block ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       chain,
+       block_number,
+       block_hash,
+       parent_hash,
+       create_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :chain,
+    :block_number,
+    :block_hash,
+    :parent_hash,
+    :create_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":           row.ID,
+			"chain":        row.Chain,
+			"block_number": row.BlockNumber,
+			"block_hash":   row.BlockHash,
+			"parent_hash":  row.ParentHash,
+			"create_time":  row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTEthBlockDuplicate 创建更新
+func SQLCreateTEthBlockDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTEthBlock, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_eth_block ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       chain,
+       block_number,
+       block_hash,
+       parent_hash,
+       create_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :chain,
+    :block_number,
+    :block_hash,
+    :parent_hash,
+    :create_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":           row.ID,
+			"chain":        row.Chain,
+			"block_number": row.BlockNumber,
+			"block_hash":   row.BlockHash,
+			"parent_hash":  row.ParentHash,
+			"create_time":  row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTEthBlock 创建多个
+func SQLCreateManyTEthBlock(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTEthBlock, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.Chain,
+					row.BlockNumber,
+					row.BlockHash,
+					row.ParentHash,
+					row.CreateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.Chain,
+					row.BlockNumber,
+					row.BlockHash,
+					row.ParentHash,
+					row.CreateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_eth_block ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    chain,
+    block_number,
+    block_hash,
+    parent_hash,
+    create_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTEthBlockDuplicate 创建多个
+func SQLCreateManyTEthBlockDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTEthBlock, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.Chain,
+					row.BlockNumber,
+					row.BlockHash,
+					row.ParentHash,
+					row.CreateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.Chain,
+					row.BlockNumber,
+					row.BlockHash,
+					row.ParentHash,
+					row.CreateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_eth_block ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    chain,
+    block_number,
+    block_hash,
+    parent_hash,
+    create_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTEthBlockCol 根据id查询
+func SQLGetTEthBlockCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTEthBlock, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_eth_block
+WHERE
+	id=:id`)
+
+	var row DBTEthBlock
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTEthBlockColKV 根据id查询
+func SQLGetTEthBlockColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTEthBlock, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_eth_block
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTEthBlock
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTEthBlockCol 根据ids获取
+func SQLSelectTEthBlockCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTEthBlock, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_eth_block
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTEthBlock
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTEthBlockColKV 根据ids获取
+func SQLSelectTEthBlockColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTEthBlock, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_eth_block
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTEthBlock
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTEthBlock 更新
+func SQLUpdateTEthBlock(ctx context.Context, tx mcommon.DbExeAble, row *DBTEthBlock) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_eth_block
+SET
+    chain=:chain,
+    block_number=:block_number,
+    block_hash=:block_hash,
+    parent_hash=:parent_hash,
+    create_time=:create_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":           row.ID,
+			"chain":        row.Chain,
+			"block_number": row.BlockNumber,
+			"block_hash":   row.BlockHash,
+			"parent_hash":  row.ParentHash,
+			"create_time":  row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTEthBlock 删除
+func SQLDeleteTEthBlock(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_eth_block
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTEthForwarder 创建
+func SQLCreateTEthForwarder(ctx context.Context, tx mcommon.DbExeAble, row *DBTEthForwarder, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_eth_forwarder ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       address,
+       salt,
+       factory_address,
+       create_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :address,
+    :salt,
+    :factory_address,
+    :create_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":              row.ID,
+			"address":         row.Address,
+			"salt":            row.Salt,
+			"factory_address": row.FactoryAddress,
+			"create_time":     row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTEthForwarderDuplicate 创建更新
+func SQLCreateTEthForwarderDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTEthForwarder, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_eth_forwarder ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       address,
+       salt,
+       factory_address,
+       create_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :address,
+    :salt,
+    :factory_address,
+    :create_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":              row.ID,
+			"address":         row.Address,
+			"salt":            row.Salt,
+			"factory_address": row.FactoryAddress,
+			"create_time":     row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTEthForwarder 创建多个
+func SQLCreateManyTEthForwarder(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTEthForwarder, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.Address,
+					row.Salt,
+					row.FactoryAddress,
+					row.CreateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.Address,
+					row.Salt,
+					row.FactoryAddress,
+					row.CreateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_eth_forwarder ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    address,
+    salt,
+    factory_address,
+    create_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTEthForwarderDuplicate 创建多个
+func SQLCreateManyTEthForwarderDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTEthForwarder, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.Address,
+					row.Salt,
+					row.FactoryAddress,
+					row.CreateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.Address,
+					row.Salt,
+					row.FactoryAddress,
+					row.CreateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_eth_forwarder ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    address,
+    salt,
+    factory_address,
+    create_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTEthForwarderCol 根据id查询
+func SQLGetTEthForwarderCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTEthForwarder, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_eth_forwarder
+WHERE
+	id=:id`)
+
+	var row DBTEthForwarder
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTEthForwarderColKV 根据id查询
+func SQLGetTEthForwarderColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTEthForwarder, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_eth_forwarder
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTEthForwarder
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTEthForwarderCol 根据ids获取
+func SQLSelectTEthForwarderCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTEthForwarder, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_eth_forwarder
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTEthForwarder
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTEthForwarderColKV 根据ids获取
+func SQLSelectTEthForwarderColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTEthForwarder, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_eth_forwarder
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTEthForwarder
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTEthForwarder 更新
+func SQLUpdateTEthForwarder(ctx context.Context, tx mcommon.DbExeAble, row *DBTEthForwarder) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_eth_forwarder
+SET
+    address=:address,
+    salt=:salt,
+    factory_address=:factory_address,
+    create_time=:create_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":              row.ID,
+			"address":         row.Address,
+			"salt":            row.Salt,
+			"factory_address": row.FactoryAddress,
+			"create_time":     row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTEthForwarder 删除
+func SQLDeleteTEthForwarder(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_eth_forwarder
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTEthNonce 创建
+func SQLCreateTEthNonce(ctx context.Context, tx mcommon.DbExeAble, row *DBTEthNonce, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_eth_nonce ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       chain,
+       address,
+       nonce,
+       update_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :chain,
+    :address,
+    :nonce,
+    :update_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":          row.ID,
+			"chain":       row.Chain,
+			"address":     row.Address,
+			"nonce":       row.Nonce,
+			"update_time": row.UpdateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTEthNonceDuplicate 创建更新
+func SQLCreateTEthNonceDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTEthNonce, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_eth_nonce ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       chain,
+       address,
+       nonce,
+       update_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :chain,
+    :address,
+    :nonce,
+    :update_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":          row.ID,
+			"chain":       row.Chain,
+			"address":     row.Address,
+			"nonce":       row.Nonce,
+			"update_time": row.UpdateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTEthNonce 创建多个
+func SQLCreateManyTEthNonce(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTEthNonce, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.Chain,
+					row.Address,
+					row.Nonce,
+					row.UpdateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.Chain,
+					row.Address,
+					row.Nonce,
+					row.UpdateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_eth_nonce ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    chain,
+    address,
+    nonce,
+    update_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTEthNonceDuplicate 创建多个
+func SQLCreateManyTEthNonceDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTEthNonce, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.Chain,
+					row.Address,
+					row.Nonce,
+					row.UpdateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.Chain,
+					row.Address,
+					row.Nonce,
+					row.UpdateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_eth_nonce ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    chain,
+    address,
+    nonce,
+    update_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTEthNonceCol 根据id查询
+func SQLGetTEthNonceCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTEthNonce, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_eth_nonce
+WHERE
+	id=:id`)
+
+	var row DBTEthNonce
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTEthNonceColKV 根据id查询
+func SQLGetTEthNonceColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTEthNonce, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_eth_nonce
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTEthNonce
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTEthNonceCol 根据ids获取
+func SQLSelectTEthNonceCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTEthNonce, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_eth_nonce
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTEthNonce
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTEthNonceColKV 根据ids获取
+func SQLSelectTEthNonceColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTEthNonce, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_eth_nonce
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTEthNonce
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTEthNonce 更新
+func SQLUpdateTEthNonce(ctx context.Context, tx mcommon.DbExeAble, row *DBTEthNonce) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_eth_nonce
+SET
+    chain=:chain,
+    address=:address,
+    nonce=:nonce,
+    update_time=:update_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":          row.ID,
+			"chain":       row.Chain,
+			"address":     row.Address,
+			"nonce":       row.Nonce,
+			"update_time": row.UpdateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTEthNonce 删除
+func SQLDeleteTEthNonce(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_eth_nonce
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTProduct 创建
+func SQLCreateTProduct(ctx context.Context, tx mcommon.DbExeAble, row *DBTProduct, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_product ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       app_name,
+       app_sk,
+       cb_url,
+       whitelist_ip,
+       notify_version
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :app_name,
+    :app_sk,
+    :cb_url,
+    :whitelist_ip,
+    :notify_version
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":             row.ID,
+			"app_name":       row.AppName,
+			"app_sk":         row.AppSk,
+			"cb_url":         row.CbURL,
+			"whitelist_ip":   row.WhitelistIP,
+			"notify_version": row.NotifyVersion,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTProductDuplicate 创建更新
+func SQLCreateTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTProduct, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_product ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       app_name,
+       app_sk,
+       cb_url,
+       whitelist_ip,
+       notify_version
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :app_name,
+    :app_sk,
+    :cb_url,
+    :whitelist_ip,
+    :notify_version
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":             row.ID,
+			"app_name":       row.AppName,
+			"app_sk":         row.AppSk,
+			"cb_url":         row.CbURL,
+			"whitelist_ip":   row.WhitelistIP,
+			"notify_version": row.NotifyVersion,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTProduct 创建多个
+func SQLCreateManyTProduct(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProduct, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.AppName,
+					row.AppSk,
+					row.CbURL,
+					row.WhitelistIP,
+					row.NotifyVersion,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.AppName,
+					row.AppSk,
+					row.CbURL,
+					row.WhitelistIP,
+					row.NotifyVersion,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_product ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    app_name,
+    app_sk,
+    cb_url,
+    whitelist_ip,
+    notify_version
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTProductDuplicate 创建多个
+func SQLCreateManyTProductDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProduct, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.AppName,
+					row.AppSk,
+					row.CbURL,
+					row.WhitelistIP,
+					row.NotifyVersion,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.AppName,
+					row.AppSk,
+					row.CbURL,
+					row.WhitelistIP,
+					row.NotifyVersion,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_product ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    app_name,
+    app_sk,
+    cb_url,
+    whitelist_ip,
+    notify_version
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTProductCol 根据id查询
+func SQLGetTProductCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTProduct, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product
+WHERE
+	id=:id`)
+
+	var row DBTProduct
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTProductColKV 根据id查询
+func SQLGetTProductColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTProduct, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTProduct
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTProductCol 根据ids获取
+func SQLSelectTProductCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTProduct, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTProduct
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTProductColKV 根据ids获取
+func SQLSelectTProductColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTProduct, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTProduct
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTProduct 更新
+func SQLUpdateTProduct(ctx context.Context, tx mcommon.DbExeAble, row *DBTProduct) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_product
+SET
+    app_name=:app_name,
+    app_sk=:app_sk,
+    cb_url=:cb_url,
+    whitelist_ip=:whitelist_ip,
+    notify_version=:notify_version
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":             row.ID,
+			"app_name":       row.AppName,
+			"app_sk":         row.AppSk,
+			"cb_url":         row.CbURL,
+			"whitelist_ip":   row.WhitelistIP,
+			"notify_version": row.NotifyVersion,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTProduct 删除
+func SQLDeleteTProduct(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_product
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTProductNonce 创建
+func SQLCreateTProductNonce(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNonce, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_product_nonce ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       c,
+       create_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :c,
+    :create_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":          row.ID,
+			"c":           row.C,
+			"create_time": row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTProductNonceDuplicate 创建更新
+func SQLCreateTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNonce, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_product_nonce ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       c,
+       create_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :c,
+    :create_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":          row.ID,
+			"c":           row.C,
+			"create_time": row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTProductNonce 创建多个
+func SQLCreateManyTProductNonce(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProductNonce, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.C,
+					row.CreateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.C,
+					row.CreateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_product_nonce ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    c,
+    create_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTProductNonceDuplicate 创建多个
+func SQLCreateManyTProductNonceDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProductNonce, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.C,
+					row.CreateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.C,
+					row.CreateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_product_nonce ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    c,
+    create_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTProductNonceCol 根据id查询
+func SQLGetTProductNonceCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTProductNonce, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_nonce
+WHERE
+	id=:id`)
+
+	var row DBTProductNonce
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTProductNonceColKV 根据id查询
+func SQLGetTProductNonceColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTProductNonce, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_nonce
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTProductNonce
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTProductNonceCol 根据ids获取
+func SQLSelectTProductNonceCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTProductNonce, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_nonce
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTProductNonce
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTProductNonceColKV 根据ids获取
+func SQLSelectTProductNonceColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTProductNonce, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_nonce
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTProductNonce
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTProductNonce 更新
+func SQLUpdateTProductNonce(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNonce) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_product_nonce
+SET
+    c=:c,
+    create_time=:create_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":          row.ID,
+			"c":           row.C,
+			"create_time": row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTProductNonce 删除
+func SQLDeleteTProductNonce(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_product_nonce
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTProductNotify 创建
+func SQLCreateTProductNotify(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNotify, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_product_notify ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       nonce,
+       product_id,
+       item_type,
+       item_id,
+       notify_type,
+       token_symbol,
+       url,
+       msg,
+       handle_status,
+       handle_msg,
+       create_time,
+       update_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :nonce,
+    :product_id,
+    :item_type,
+    :item_id,
+    :notify_type,
+    :token_symbol,
+    :url,
+    :msg,
+    :handle_status,
+    :handle_msg,
+    :create_time,
+    :update_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"nonce":         row.Nonce,
+			"product_id":    row.ProductID,
+			"item_type":     row.ItemType,
+			"item_id":       row.ItemID,
+			"notify_type":   row.NotifyType,
+			"token_symbol":  row.TokenSymbol,
+			"url":           row.URL,
+			"msg":           row.Msg,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"create_time":   row.CreateTime,
+			"update_time":   row.UpdateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTProductNotifyDuplicate 创建更新
+func SQLCreateTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNotify, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_product_notify ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       nonce,
+       product_id,
+       item_type,
+       item_id,
+       notify_type,
+       token_symbol,
+       url,
+       msg,
+       handle_status,
+       handle_msg,
+       create_time,
+       update_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :nonce,
+    :product_id,
+    :item_type,
+    :item_id,
+    :notify_type,
+    :token_symbol,
+    :url,
+    :msg,
+    :handle_status,
+    :handle_msg,
+    :create_time,
+    :update_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"nonce":         row.Nonce,
+			"product_id":    row.ProductID,
+			"item_type":     row.ItemType,
+			"item_id":       row.ItemID,
+			"notify_type":   row.NotifyType,
+			"token_symbol":  row.TokenSymbol,
+			"url":           row.URL,
+			"msg":           row.Msg,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"create_time":   row.CreateTime,
+			"update_time":   row.UpdateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTProductNotify 创建多个
+func SQLCreateManyTProductNotify(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProductNotify, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.Nonce,
+					row.ProductID,
+					row.ItemType,
+					row.ItemID,
+					row.NotifyType,
+					row.TokenSymbol,
+					row.URL,
+					row.Msg,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.CreateTime,
+					row.UpdateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.Nonce,
+					row.ProductID,
+					row.ItemType,
+					row.ItemID,
+					row.NotifyType,
+					row.TokenSymbol,
+					row.URL,
+					row.Msg,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.CreateTime,
+					row.UpdateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_product_notify ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    nonce,
+    product_id,
+    item_type,
+    item_id,
+    notify_type,
+    token_symbol,
+    url,
+    msg,
+    handle_status,
+    handle_msg,
+    create_time,
+    update_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTProductNotifyDuplicate 创建多个
+func SQLCreateManyTProductNotifyDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTProductNotify, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.Nonce,
+					row.ProductID,
+					row.ItemType,
+					row.ItemID,
+					row.NotifyType,
+					row.TokenSymbol,
+					row.URL,
+					row.Msg,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.CreateTime,
+					row.UpdateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.Nonce,
+					row.ProductID,
+					row.ItemType,
+					row.ItemID,
+					row.NotifyType,
+					row.TokenSymbol,
+					row.URL,
+					row.Msg,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.CreateTime,
+					row.UpdateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_product_notify ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    nonce,
+    product_id,
+    item_type,
+    item_id,
+    notify_type,
+    token_symbol,
+    url,
+    msg,
+    handle_status,
+    handle_msg,
+    create_time,
+    update_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTProductNotifyCol 根据id查询
+func SQLGetTProductNotifyCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTProductNotify, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_notify
+WHERE
+	id=:id`)
+
+	var row DBTProductNotify
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTProductNotifyColKV 根据id查询
+func SQLGetTProductNotifyColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTProductNotify, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_notify
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTProductNotify
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTProductNotifyCol 根据ids获取
+func SQLSelectTProductNotifyCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTProductNotify, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_notify
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTProductNotify
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTProductNotifyColKV 根据ids获取
+func SQLSelectTProductNotifyColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTProductNotify, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_product_notify
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTProductNotify
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTProductNotify 更新
+func SQLUpdateTProductNotify(ctx context.Context, tx mcommon.DbExeAble, row *DBTProductNotify) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_product_notify
+SET
+    nonce=:nonce,
+    product_id=:product_id,
+    item_type=:item_type,
+    item_id=:item_id,
+    notify_type=:notify_type,
+    token_symbol=:token_symbol,
+    url=:url,
+    msg=:msg,
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    create_time=:create_time,
+    update_time=:update_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":            row.ID,
+			"nonce":         row.Nonce,
+			"product_id":    row.ProductID,
+			"item_type":     row.ItemType,
+			"item_id":       row.ItemID,
+			"notify_type":   row.NotifyType,
+			"token_symbol":  row.TokenSymbol,
+			"url":           row.URL,
+			"msg":           row.Msg,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"create_time":   row.CreateTime,
+			"update_time":   row.UpdateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTProductNotify 删除
+func SQLDeleteTProductNotify(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_product_notify
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTSend 创建
+func SQLCreateTSend(ctx context.Context, tx mcommon.DbExeAble, row *DBTSend, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       chain,
+       related_type,
+       related_id,
+       token_id,
+       tx_id,
+       from_address,
+       to_address,
+       balance_real,
+       gas,
+       gas_estimate,
+       gas_used,
+       gas_price,
+       max_fee_per_gas,
+       max_priority_fee_per_gas,
+       nonce,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :chain,
+    :related_type,
+    :related_id,
+    :token_id,
+    :tx_id,
+    :from_address,
+    :to_address,
+    :balance_real,
+    :gas,
+    :gas_estimate,
+    :gas_used,
+    :gas_price,
+    :max_fee_per_gas,
+    :max_priority_fee_per_gas,
+    :nonce,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":                       row.ID,
+			"chain":                    row.Chain,
+			"related_type":             row.RelatedType,
+			"related_id":               row.RelatedID,
+			"token_id":                 row.TokenID,
+			"tx_id":                    row.TxID,
+			"from_address":             row.FromAddress,
+			"to_address":               row.ToAddress,
+			"balance_real":             row.BalanceReal,
+			"gas":                      row.Gas,
+			"gas_estimate":             row.GasEstimate,
+			"gas_used":                 row.GasUsed,
+			"gas_price":                row.GasPrice,
+			"max_fee_per_gas":          row.MaxFeePerGas,
+			"max_priority_fee_per_gas": row.MaxPriorityFeePerGas,
+			"nonce":                    row.Nonce,
+			"hex":                      row.Hex,
+			"create_time":              row.CreateTime,
+			"handle_status":            row.HandleStatus,
+			"handle_msg":               row.HandleMsg,
+			"handle_time":              row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTSendDuplicate 创建更新
+func SQLCreateTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSend, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       chain,
+       related_type,
+       related_id,
+       token_id,
+       tx_id,
+       from_address,
+       to_address,
+       balance_real,
+       gas,
+       gas_estimate,
+       gas_used,
+       gas_price,
+       max_fee_per_gas,
+       max_priority_fee_per_gas,
+       nonce,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :chain,
+    :related_type,
+    :related_id,
+    :token_id,
+    :tx_id,
+    :from_address,
+    :to_address,
+    :balance_real,
+    :gas,
+    :gas_estimate,
+    :gas_used,
+    :gas_price,
+    :max_fee_per_gas,
+    :max_priority_fee_per_gas,
+    :nonce,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":                       row.ID,
+			"chain":                    row.Chain,
+			"related_type":             row.RelatedType,
+			"related_id":               row.RelatedID,
+			"token_id":                 row.TokenID,
+			"tx_id":                    row.TxID,
+			"from_address":             row.FromAddress,
+			"to_address":               row.ToAddress,
+			"balance_real":             row.BalanceReal,
+			"gas":                      row.Gas,
+			"gas_estimate":             row.GasEstimate,
+			"gas_used":                 row.GasUsed,
+			"gas_price":                row.GasPrice,
+			"max_fee_per_gas":          row.MaxFeePerGas,
+			"max_priority_fee_per_gas": row.MaxPriorityFeePerGas,
+			"nonce":                    row.Nonce,
+			"hex":                      row.Hex,
+			"create_time":              row.CreateTime,
+			"handle_status":            row.HandleStatus,
+			"handle_msg":               row.HandleMsg,
+			"handle_time":              row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTSend 创建多个
+func SQLCreateManyTSend(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSend, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.Chain,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.Gas,
+					row.GasEstimate,
+					row.GasUsed,
+					row.GasPrice,
+					row.MaxFeePerGas,
+					row.MaxPriorityFeePerGas,
+					row.Nonce,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.Chain,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.Gas,
+					row.GasEstimate,
+					row.GasUsed,
+					row.GasPrice,
+					row.MaxFeePerGas,
+					row.MaxPriorityFeePerGas,
+					row.Nonce,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    chain,
+    related_type,
+    related_id,
+    token_id,
+    tx_id,
+    from_address,
+    to_address,
+    balance_real,
+    gas,
+    gas_estimate,
+    gas_used,
+    gas_price,
+    max_fee_per_gas,
+    max_priority_fee_per_gas,
+    nonce,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTSendDuplicate 创建多个
+func SQLCreateManyTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSend, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.Chain,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.Gas,
+					row.GasEstimate,
+					row.GasUsed,
+					row.GasPrice,
+					row.MaxFeePerGas,
+					row.MaxPriorityFeePerGas,
+					row.Nonce,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.Chain,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.Gas,
+					row.GasEstimate,
+					row.GasUsed,
+					row.GasPrice,
+					row.MaxFeePerGas,
+					row.MaxPriorityFeePerGas,
+					row.Nonce,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    chain,
+    related_type,
+    related_id,
+    token_id,
+    tx_id,
+    from_address,
+    to_address,
+    balance_real,
+    gas,
+    gas_estimate,
+    gas_used,
+    gas_price,
+    max_fee_per_gas,
+    max_priority_fee_per_gas,
+    nonce,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTSendCol 根据id查询
+func SQLGetTSendCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSend, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send
+WHERE
+	id=:id`)
+
+	var row DBTSend
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTSendColKV 根据id查询
+func SQLGetTSendColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSend, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTSend
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTSendCol 根据ids获取
+func SQLSelectTSendCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSend, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTSend
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTSendColKV 根据ids获取
+func SQLSelectTSendColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSend, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTSend
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTSend 更新
+func SQLUpdateTSend(ctx context.Context, tx mcommon.DbExeAble, row *DBTSend) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send
+SET
+    chain=:chain,
+    related_type=:related_type,
+    related_id=:related_id,
+    token_id=:token_id,
+    tx_id=:tx_id,
+    from_address=:from_address,
+    to_address=:to_address,
+    balance_real=:balance_real,
+    gas=:gas,
+    gas_estimate=:gas_estimate,
+    gas_used=:gas_used,
+    gas_price=:gas_price,
+    max_fee_per_gas=:max_fee_per_gas,
+    max_priority_fee_per_gas=:max_priority_fee_per_gas,
+    nonce=:nonce,
+    hex=:hex,
+    create_time=:create_time,
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":                       row.ID,
+			"chain":                    row.Chain,
+			"related_type":             row.RelatedType,
+			"related_id":               row.RelatedID,
+			"token_id":                 row.TokenID,
+			"tx_id":                    row.TxID,
+			"from_address":             row.FromAddress,
+			"to_address":               row.ToAddress,
+			"balance_real":             row.BalanceReal,
+			"gas":                      row.Gas,
+			"gas_estimate":             row.GasEstimate,
+			"gas_used":                 row.GasUsed,
+			"gas_price":                row.GasPrice,
+			"max_fee_per_gas":          row.MaxFeePerGas,
+			"max_priority_fee_per_gas": row.MaxPriorityFeePerGas,
+			"nonce":                    row.Nonce,
+			"hex":                      row.Hex,
+			"create_time":              row.CreateTime,
+			"handle_status":            row.HandleStatus,
+			"handle_msg":               row.HandleMsg,
+			"handle_time":              row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTSend 删除
+func SQLDeleteTSend(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_send
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTSendBtc 创建
+func SQLCreateTSendBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBtc, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_btc ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       related_type,
+       related_id,
+       token_id,
+       tx_id,
+       from_address,
+       to_address,
+       balance_real,
+       gas,
+       gas_price,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :related_type,
+    :related_id,
+    :token_id,
+    :tx_id,
+    :from_address,
+    :to_address,
+    :balance_real,
+    :gas,
+    :gas_price,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"related_type":  row.RelatedType,
+			"related_id":    row.RelatedID,
+			"token_id":      row.TokenID,
+			"tx_id":         row.TxID,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
+			"gas":           row.Gas,
+			"gas_price":     row.GasPrice,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTSendBtcDuplicate 创建更新
+func SQLCreateTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBtc, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send_btc ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       related_type,
+       related_id,
+       token_id,
+       tx_id,
+       from_address,
+       to_address,
+       balance_real,
+       gas,
+       gas_price,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :related_type,
+    :related_id,
+    :token_id,
+    :tx_id,
+    :from_address,
+    :to_address,
+    :balance_real,
+    :gas,
+    :gas_price,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"related_type":  row.RelatedType,
+			"related_id":    row.RelatedID,
+			"token_id":      row.TokenID,
+			"tx_id":         row.TxID,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
+			"gas":           row.Gas,
+			"gas_price":     row.GasPrice,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTSendBtc 创建多个
+func SQLCreateManyTSendBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendBtc, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.Gas,
+					row.GasPrice,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.Gas,
+					row.GasPrice,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_btc ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    related_type,
+    related_id,
+    token_id,
+    tx_id,
+    from_address,
+    to_address,
+    balance_real,
+    gas,
+    gas_price,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTSendBtcDuplicate 创建多个
+func SQLCreateManyTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendBtc, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.Gas,
+					row.GasPrice,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.RelatedType,
+					row.RelatedID,
+					row.TokenID,
+					row.TxID,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
+					row.Gas,
+					row.GasPrice,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send_btc ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    related_type,
+    related_id,
+    token_id,
+    tx_id,
+    from_address,
+    to_address,
+    balance_real,
+    gas,
+    gas_price,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTSendBtcCol 根据id查询
+func SQLGetTSendBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSendBtc, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_btc
+WHERE
+	id=:id`)
+
+	var row DBTSendBtc
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTSendBtcColKV 根据id查询
+func SQLGetTSendBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSendBtc, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_btc
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTSendBtc
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTSendBtcCol 根据ids获取
+func SQLSelectTSendBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSendBtc, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_btc
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTSendBtc
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTSendBtcColKV 根据ids获取
+func SQLSelectTSendBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSendBtc, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_btc
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTSendBtc
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTSendBtc 更新
+func SQLUpdateTSendBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBtc) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_btc
+SET
+    related_type=:related_type,
+    related_id=:related_id,
+    token_id=:token_id,
+    tx_id=:tx_id,
+    from_address=:from_address,
+    to_address=:to_address,
+    balance_real=:balance_real,
+    gas=:gas,
+    gas_price=:gas_price,
+    hex=:hex,
+    create_time=:create_time,
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_time=:handle_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":            row.ID,
+			"related_type":  row.RelatedType,
+			"related_id":    row.RelatedID,
+			"token_id":      row.TokenID,
+			"tx_id":         row.TxID,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
+			"gas":           row.Gas,
+			"gas_price":     row.GasPrice,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_time":   row.HandleTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTSendBtc 删除
+func SQLDeleteTSendBtc(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_send_btc
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTSendEos 创建
+func SQLCreateTSendEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEos, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_eos ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       withdraw_id,
+       tx_hash,
+       log_index,
+       from_address,
+       to_address,
+       memo,
+       balance_real,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_at
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :withdraw_id,
+    :tx_hash,
+    :log_index,
+    :from_address,
+    :to_address,
+    :memo,
+    :balance_real,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_at
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"withdraw_id":   row.WithdrawID,
+			"tx_hash":       row.TxHash,
+			"log_index":     row.LogIndex,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"memo":          row.Memo,
+			"balance_real":  row.BalanceReal,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_at":     row.HandleAt,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTSendEosDuplicate 创建更新
+func SQLCreateTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEos, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send_eos ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       withdraw_id,
+       tx_hash,
+       log_index,
+       from_address,
+       to_address,
+       memo,
+       balance_real,
+       hex,
+       create_time,
+       handle_status,
+       handle_msg,
+       handle_at
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :withdraw_id,
+    :tx_hash,
+    :log_index,
+    :from_address,
+    :to_address,
+    :memo,
+    :balance_real,
+    :hex,
+    :create_time,
+    :handle_status,
+    :handle_msg,
+    :handle_at
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":            row.ID,
+			"withdraw_id":   row.WithdrawID,
+			"tx_hash":       row.TxHash,
+			"log_index":     row.LogIndex,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"memo":          row.Memo,
+			"balance_real":  row.BalanceReal,
+			"hex":           row.Hex,
+			"create_time":   row.CreateTime,
+			"handle_status": row.HandleStatus,
+			"handle_msg":    row.HandleMsg,
+			"handle_at":     row.HandleAt,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTSendEos 创建多个
+func SQLCreateManyTSendEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendEos, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.WithdrawID,
+					row.TxHash,
+					row.LogIndex,
+					row.FromAddress,
+					row.ToAddress,
+					row.Memo,
+					row.BalanceReal,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleAt,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.WithdrawID,
+					row.TxHash,
+					row.LogIndex,
+					row.FromAddress,
+					row.ToAddress,
+					row.Memo,
+					row.BalanceReal,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleAt,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_eos ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    withdraw_id,
+    tx_hash,
+    log_index,
+    from_address,
+    to_address,
+    memo,
+    balance_real,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_at
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTSendEosDuplicate 创建多个
+func SQLCreateManyTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendEos, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.WithdrawID,
+					row.TxHash,
+					row.LogIndex,
+					row.FromAddress,
+					row.ToAddress,
+					row.Memo,
+					row.BalanceReal,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleAt,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.WithdrawID,
+					row.TxHash,
+					row.LogIndex,
+					row.FromAddress,
+					row.ToAddress,
+					row.Memo,
+					row.BalanceReal,
+					row.Hex,
+					row.CreateTime,
+					row.HandleStatus,
+					row.HandleMsg,
+					row.HandleAt,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send_eos ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    withdraw_id,
+    tx_hash,
+    log_index,
+    from_address,
+    to_address,
+    memo,
+    balance_real,
+    hex,
+    create_time,
+    handle_status,
+    handle_msg,
+    handle_at
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTSendEosCol 根据id查询
+func SQLGetTSendEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSendEos, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_eos
+WHERE
+	id=:id`)
+
+	var row DBTSendEos
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTSendEosColKV 根据id查询
+func SQLGetTSendEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSendEos, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_eos
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTSendEos
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTSendEosCol 根据ids获取
+func SQLSelectTSendEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSendEos, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_eos
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTSendEos
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTSendEosColKV 根据ids获取
+func SQLSelectTSendEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSendEos, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_eos
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTSendEos
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTSendEos 更新
+func SQLUpdateTSendEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEos) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_eos
+SET
+    withdraw_id=:withdraw_id,
+    tx_hash=:tx_hash,
+    log_index=:log_index,
+    from_address=:from_address,
+    to_address=:to_address,
+    memo=:memo,
+    balance_real=:balance_real,
+    hex=:hex,
+    create_time=:create_time,
+    handle_status=:handle_status,
+    handle_msg=:handle_msg,
+    handle_at=:handle_at
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":            row.ID,
+			"withdraw_id":   row.WithdrawID,
+			"tx_hash":       row.TxHash,
+			"log_index":     row.LogIndex,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"memo":          row.Memo,
+			"balance_real":  row.BalanceReal,
 			"hex":           row.Hex,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_time":   row.HandleTime,
+			"handle_at":     row.HandleAt,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTSendEos 删除
+func SQLDeleteTSendEos(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_send_eos
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTSendReplace 创建
+func SQLCreateTSendReplace(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendReplace, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_replace ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       send_id,
+       tx_id,
+       replace_tx_id,
+       nonce,
+       gas_price,
+       max_priority_fee_per_gas,
+       create_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :send_id,
+    :tx_id,
+    :replace_tx_id,
+    :nonce,
+    :gas_price,
+    :max_priority_fee_per_gas,
+    :create_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":                       row.ID,
+			"send_id":                  row.SendID,
+			"tx_id":                    row.TxID,
+			"replace_tx_id":            row.ReplaceTxID,
+			"nonce":                    row.Nonce,
+			"gas_price":                row.GasPrice,
+			"max_priority_fee_per_gas": row.MaxPriorityFeePerGas,
+			"create_time":              row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateTSendReplaceDuplicate 创建更新
+func SQLCreateTSendReplaceDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendReplace, updates []string) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send_replace ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       send_id,
+       tx_id,
+       replace_tx_id,
+       nonce,
+       gas_price,
+       max_priority_fee_per_gas,
+       create_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :send_id,
+    :tx_id,
+    :replace_tx_id,
+    :nonce,
+    :gas_price,
+    :max_priority_fee_per_gas,
+    :create_time
+) `)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":                       row.ID,
+			"send_id":                  row.SendID,
+			"tx_id":                    row.TxID,
+			"replace_tx_id":            row.ReplaceTxID,
+			"nonce":                    row.Nonce,
+			"gas_price":                row.GasPrice,
+			"max_priority_fee_per_gas": row.MaxPriorityFeePerGas,
+			"create_time":              row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return lastID, nil
+}
+
+// SQLCreateManyTSendReplace 创建多个
+func SQLCreateManyTSendReplace(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendReplace, isIgnore bool) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.SendID,
+					row.TxID,
+					row.ReplaceTxID,
+					row.Nonce,
+					row.GasPrice,
+					row.MaxPriorityFeePerGas,
+					row.CreateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.SendID,
+					row.TxID,
+					row.ReplaceTxID,
+					row.Nonce,
+					row.GasPrice,
+					row.MaxPriorityFeePerGas,
+					row.CreateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_replace ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    send_id,
+    tx_id,
+    replace_tx_id,
+    nonce,
+    gas_price,
+    max_priority_fee_per_gas,
+    create_time
+) VALUES
+    %s`)
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateManyTSendReplaceDuplicate 创建多个
+func SQLCreateManyTSendReplaceDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendReplace, updates []string) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var args []interface{}
+	if rows[0].ID > 0 {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.ID,
+					row.SendID,
+					row.TxID,
+					row.ReplaceTxID,
+					row.Nonce,
+					row.GasPrice,
+					row.MaxPriorityFeePerGas,
+					row.CreateTime,
+				},
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.SendID,
+					row.TxID,
+					row.ReplaceTxID,
+					row.Nonce,
+					row.GasPrice,
+					row.MaxPriorityFeePerGas,
+					row.CreateTime,
+				},
+			)
+		}
+	}
+	var count int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT INTO t_send_replace ( ")
+	if rows[0].ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+    send_id,
+    tx_id,
+    replace_tx_id,
+    nonce,
+    gas_price,
+    max_priority_fee_per_gas,
+    create_time
+) VALUES
+    %s`)
+	updatesLen := len(updates)
+	lastUpdateIndex := updatesLen - 1
+	if updatesLen > 0 {
+		query.WriteString("ON DUPLICATE KEY UPDATE\n")
+		for i, update := range updates {
+			query.WriteString(update)
+			query.WriteString("=VALUES(")
+			query.WriteString(update)
+			query.WriteString(")")
+			if i != lastUpdateIndex {
+				query.WriteString(",\n")
+			} else {
+				query.WriteString("\n")
+			}
+		}
+	}
+	count, err = mcommon.DbExecuteCountManyContent(
+		ctx,
+		tx,
+		query.String(),
+		len(rows),
+		args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLGetTSendReplaceCol 根据id查询
+func SQLGetTSendReplaceCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSendReplace, error) {
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_replace
+WHERE
+	id=:id`)
+
+	var row DBTSendReplace
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLGetTSendReplaceColKV 根据id查询
+func SQLGetTSendReplaceColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSendReplace, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_replace
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+
+	var row DBTSendReplace
+	ok, err := mcommon.DbGetNamedContent(
+		ctx,
+		tx,
+		&row,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &row, nil
+}
+
+// SQLSelectTSendReplaceCol 根据ids获取
+func SQLSelectTSendReplaceCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSendReplace, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_replace
+WHERE
+	id IN (:ids)`)
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+	var rows []*DBTSendReplace
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		mcommon.H{
+			"ids": ids,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLSelectTSendReplaceColKV 根据ids获取
+func SQLSelectTSendReplaceColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSendReplace, error) {
+	keysLen := len(keys)
+	if keysLen != len(values) {
+		return nil, fmt.Errorf("value len error")
+	}
+
+	query := strings.Builder{}
+	query.WriteString("SELECT\n")
+	query.WriteString(strings.Join(cols, ",\n"))
+	query.WriteString(`
+FROM
+	t_send_replace
+`)
+	if len(keys) > 0 {
+		query.WriteString("WHERE\n")
+	}
+	argMap := mcommon.H{}
+	for i, key := range keys {
+		if i != 0 {
+			query.WriteString("AND ")
+		}
+		value := values[i]
+		query.WriteString(key)
+		rt := reflect.TypeOf(value)
+		switch rt.Kind() {
+		case reflect.Slice:
+			s := reflect.ValueOf(value)
+			if s.Len() == 0 {
+				return nil, nil
+			}
+			query.WriteString(" IN (:")
+			query.WriteString(key)
+			query.WriteString(" )")
+		default:
+			query.WriteString("=:")
+			query.WriteString(key)
+		}
+		query.WriteString("\n")
+		argMap[key] = value
+	}
+	if len(orderBys) > 0 {
+		query.WriteString("\nORDER BY\n")
+		query.WriteString(strings.Join(orderBys, ",\n"))
+		query.WriteString("\n")
+	}
+	if len(limits) == 1 {
+		query.WriteString(fmt.Sprintf("LIMIT %d", limits[0]))
+	}
+	if len(limits) == 2 {
+		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
+	}
+
+	var rows []*DBTSendReplace
+	err := mcommon.DbSelectNamedContent(
+		ctx,
+		tx,
+		&rows,
+		query.String(),
+		argMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// SQLUpdateTSendReplace 更新
+func SQLUpdateTSendReplace(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendReplace) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`UPDATE
+	t_send_replace
+SET
+    send_id=:send_id,
+    tx_id=:tx_id,
+    replace_tx_id=:replace_tx_id,
+    nonce=:nonce,
+    gas_price=:gas_price,
+    max_priority_fee_per_gas=:max_priority_fee_per_gas,
+    create_time=:create_time
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id":                       row.ID,
+			"send_id":                  row.SendID,
+			"tx_id":                    row.TxID,
+			"replace_tx_id":            row.ReplaceTxID,
+			"nonce":                    row.Nonce,
+			"gas_price":                row.GasPrice,
+			"max_priority_fee_per_gas": row.MaxPriorityFeePerGas,
+			"create_time":              row.CreateTime,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLDeleteTSendReplace 删除
+func SQLDeleteTSendReplace(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+	count, err := mcommon.DbExecuteCountNamedContent(
+		ctx,
+		tx,
+		`DELETE
+FROM
+	t_send_replace
+WHERE
+	id=:id`,
+		mcommon.H{
+			"id": id,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SQLCreateTSendWithdraw 创建
+func SQLCreateTSendWithdraw(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendWithdraw, isIgnore bool) (int64, error) {
+	var lastID int64
+	var err error
+	query := strings.Builder{}
+	query.WriteString("INSERT ")
+	if isIgnore {
+		query.WriteString("IGNORE ")
+	}
+	query.WriteString("INTO t_send_withdraw ( ")
+	if row.ID > 0 {
+		query.WriteString("\nid,")
+	}
+	query.WriteString(`
+       send_id,
+       withdraw_id,
+       create_time
+) VALUES (`)
+	if row.ID > 0 {
+		query.WriteString("\n:id,")
+	}
+	query.WriteString(`
+    :send_id,
+    :withdraw_id,
+    :create_time
+)`)
+	lastID, err = mcommon.DbExecuteLastIDNamedContent(
+		ctx,
+		tx,
+		query.String(),
+		mcommon.H{
+			"id":          row.ID,
+			"send_id":     row.SendID,
+			"withdraw_id": row.WithdrawID,
+			"create_time": row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -5018,51 +12070,27 @@ func SQLCreateTSend(ctx context.Context, tx mcommon.DbExeAble, row *DBTSend, isI
 	return lastID, nil
 }
 
-// SQLCreateTSendDuplicate 创建更新
-func SQLCreateTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSend, updates []string) (int64, error) {
+// SQLCreateTSendWithdrawDuplicate 创建更新
+func SQLCreateTSendWithdrawDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendWithdraw, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_send ( ")
+	query.WriteString("INSERT INTO t_send_withdraw ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       related_type,
-       related_id,
-       token_id,
-       tx_id,
-       from_address,
-       to_address,
-       balance_real,
-       gas,
-       gas_price,
-       nonce,
-       hex,
-       create_time,
-       handle_status,
-       handle_msg,
-       handle_time
+       send_id,
+       withdraw_id,
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :related_type,
-    :related_id,
-    :token_id,
-    :tx_id,
-    :from_address,
-    :to_address,
-    :balance_real,
-    :gas,
-    :gas_price,
-    :nonce,
-    :hex,
-    :create_time,
-    :handle_status,
-    :handle_msg,
-    :handle_time
+    :send_id,
+    :withdraw_id,
+    :create_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -5085,22 +12113,10 @@ func SQLCreateTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBT
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":            row.ID,
-			"related_type":  row.RelatedType,
-			"related_id":    row.RelatedID,
-			"token_id":      row.TokenID,
-			"tx_id":         row.TxID,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
-			"gas":           row.Gas,
-			"gas_price":     row.GasPrice,
-			"nonce":         row.Nonce,
-			"hex":           row.Hex,
-			"create_time":   row.CreateTime,
-			"handle_status": row.HandleStatus,
-			"handle_msg":    row.HandleMsg,
-			"handle_time":   row.HandleTime,
+			"id":          row.ID,
+			"send_id":     row.SendID,
+			"withdraw_id": row.WithdrawID,
+			"create_time": row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -5109,8 +12125,8 @@ func SQLCreateTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBT
 	return lastID, nil
 }
 
-// SQLCreateManyTSend 创建多个
-func SQLCreateManyTSend(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSend, isIgnore bool) (int64, error) {
+// SQLCreateManyTSendWithdraw 创建多个
+func SQLCreateManyTSendWithdraw(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendWithdraw, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -5121,21 +12137,9 @@ func SQLCreateManyTSend(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSe
 				args,
 				[]interface{}{
 					row.ID,
-					row.RelatedType,
-					row.RelatedID,
-					row.TokenID,
-					row.TxID,
-					row.FromAddress,
-					row.ToAddress,
-					row.BalanceReal,
-					row.Gas,
-					row.GasPrice,
-					row.Nonce,
-					row.Hex,
+					row.SendID,
+					row.WithdrawID,
 					row.CreateTime,
-					row.HandleStatus,
-					row.HandleMsg,
-					row.HandleTime,
 				},
 			)
 		}
@@ -5144,21 +12148,9 @@ func SQLCreateManyTSend(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSe
 			args = append(
 				args,
 				[]interface{}{
-					row.RelatedType,
-					row.RelatedID,
-					row.TokenID,
-					row.TxID,
-					row.FromAddress,
-					row.ToAddress,
-					row.BalanceReal,
-					row.Gas,
-					row.GasPrice,
-					row.Nonce,
-					row.Hex,
+					row.SendID,
+					row.WithdrawID,
 					row.CreateTime,
-					row.HandleStatus,
-					row.HandleMsg,
-					row.HandleTime,
 				},
 			)
 		}
@@ -5170,26 +12162,14 @@ func SQLCreateManyTSend(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSe
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_send ( ")
+	query.WriteString("INTO t_send_withdraw ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    related_type,
-    related_id,
-    token_id,
-    tx_id,
-    from_address,
-    to_address,
-    balance_real,
-    gas,
-    gas_price,
-    nonce,
-    hex,
-    create_time,
-    handle_status,
-    handle_msg,
-    handle_time
+    send_id,
+    withdraw_id,
+    create_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -5205,8 +12185,8 @@ func SQLCreateManyTSend(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSe
 	return count, nil
 }
 
-// SQLCreateManyTSendDuplicate 创建多个
-func SQLCreateManyTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSend, updates []string) (int64, error) {
+// SQLCreateManyTSendWithdrawDuplicate 创建多个
+func SQLCreateManyTSendWithdrawDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendWithdraw, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -5217,21 +12197,9 @@ func SQLCreateManyTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows
 				args,
 				[]interface{}{
 					row.ID,
-					row.RelatedType,
-					row.RelatedID,
-					row.TokenID,
-					row.TxID,
-					row.FromAddress,
-					row.ToAddress,
-					row.BalanceReal,
-					row.Gas,
-					row.GasPrice,
-					row.Nonce,
-					row.Hex,
+					row.SendID,
+					row.WithdrawID,
 					row.CreateTime,
-					row.HandleStatus,
-					row.HandleMsg,
-					row.HandleTime,
 				},
 			)
 		}
@@ -5240,21 +12208,9 @@ func SQLCreateManyTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows
 			args = append(
 				args,
 				[]interface{}{
-					row.RelatedType,
-					row.RelatedID,
-					row.TokenID,
-					row.TxID,
-					row.FromAddress,
-					row.ToAddress,
-					row.BalanceReal,
-					row.Gas,
-					row.GasPrice,
-					row.Nonce,
-					row.Hex,
+					row.SendID,
+					row.WithdrawID,
 					row.CreateTime,
-					row.HandleStatus,
-					row.HandleMsg,
-					row.HandleTime,
 				},
 			)
 		}
@@ -5262,26 +12218,14 @@ func SQLCreateManyTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_send ( ")
+	query.WriteString("INSERT INTO t_send_withdraw ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    related_type,
-    related_id,
-    token_id,
-    tx_id,
-    from_address,
-    to_address,
-    balance_real,
-    gas,
-    gas_price,
-    nonce,
-    hex,
-    create_time,
-    handle_status,
-    handle_msg,
-    handle_time
+    send_id,
+    withdraw_id,
+    create_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -5313,18 +12257,18 @@ func SQLCreateManyTSendDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows
 	return count, nil
 }
 
-// SQLGetTSendCol 根据id查询
-func SQLGetTSendCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSend, error) {
+// SQLGetTSendWithdrawCol 根据id查询
+func SQLGetTSendWithdrawCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSendWithdraw, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send
+	t_send_withdraw
 WHERE
 	id=:id`)
 
-	var row DBTSend
+	var row DBTSendWithdraw
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -5343,8 +12287,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTSendColKV 根据id查询
-func SQLGetTSendColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSend, error) {
+// SQLGetTSendWithdrawColKV 根据id查询
+func SQLGetTSendWithdrawColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSendWithdraw, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -5355,7 +12299,7 @@ func SQLGetTSendColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string,
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send
+	t_send_withdraw
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -5385,7 +12329,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTSend
+	var row DBTSendWithdraw
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -5402,8 +12346,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTSendCol 根据ids获取
-func SQLSelectTSendCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSend, error) {
+// SQLSelectTSendWithdrawCol 根据ids获取
+func SQLSelectTSendWithdrawCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSendWithdraw, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -5412,7 +12356,7 @@ func SQLSelectTSendCol(ctx context.Context, tx mcommon.DbExeAble, cols []string,
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send
+	t_send_withdraw
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -5426,7 +12370,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTSend
+	var rows []*DBTSendWithdraw
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -5442,8 +12386,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTSendColKV 根据ids获取
-func SQLSelectTSendColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSend, error) {
+// SQLSelectTSendWithdrawColKV 根据ids获取
+func SQLSelectTSendWithdrawColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSendWithdraw, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -5454,7 +12398,7 @@ func SQLSelectTSendColKV(ctx context.Context, tx mcommon.DbExeAble, cols []strin
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send
+	t_send_withdraw
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -5495,7 +12439,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTSend
+	var rows []*DBTSendWithdraw
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -5509,48 +12453,24 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTSend 更新
-func SQLUpdateTSend(ctx context.Context, tx mcommon.DbExeAble, row *DBTSend) (int64, error) {
+// SQLUpdateTSendWithdraw 更新
+func SQLUpdateTSendWithdraw(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendWithdraw) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_send
+	t_send_withdraw
 SET
-    related_type=:related_type,
-    related_id=:related_id,
-    token_id=:token_id,
-    tx_id=:tx_id,
-    from_address=:from_address,
-    to_address=:to_address,
-    balance_real=:balance_real,
-    gas=:gas,
-    gas_price=:gas_price,
-    nonce=:nonce,
-    hex=:hex,
-    create_time=:create_time,
-    handle_status=:handle_status,
-    handle_msg=:handle_msg,
-    handle_time=:handle_time
+    send_id=:send_id,
+    withdraw_id=:withdraw_id,
+    create_time=:create_time
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id":            row.ID,
-			"related_type":  row.RelatedType,
-			"related_id":    row.RelatedID,
-			"token_id":      row.TokenID,
-			"tx_id":         row.TxID,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
-			"gas":           row.Gas,
-			"gas_price":     row.GasPrice,
-			"nonce":         row.Nonce,
-			"hex":           row.Hex,
-			"create_time":   row.CreateTime,
-			"handle_status": row.HandleStatus,
-			"handle_msg":    row.HandleMsg,
-			"handle_time":   row.HandleTime,
+			"id":          row.ID,
+			"send_id":     row.SendID,
+			"withdraw_id": row.WithdrawID,
+			"create_time": row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -5559,14 +12479,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTSend 删除
-func SQLDeleteTSend(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTSendWithdraw 删除
+func SQLDeleteTSendWithdraw(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_send
+	t_send_withdraw
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -5579,8 +12499,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTSendBtc 创建
-func SQLCreateTSendBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBtc, isIgnore bool) (int64, error) {
+// SQLCreateTTx 创建
+func SQLCreateTTx(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -5588,65 +12508,71 @@ func SQLCreateTSendBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBt
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_send_btc ( ")
+	query.WriteString("INTO t_tx ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       related_type,
-       related_id,
-       token_id,
+       chain,
+       product_id,
+       block_number,
+       block_hash,
        tx_id,
+       trace_address,
        from_address,
        to_address,
        balance_real,
-       gas,
-       gas_price,
-       hex,
        create_time,
        handle_status,
        handle_msg,
-       handle_time
+       handle_time,
+       org_status,
+       org_msg,
+       org_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :related_type,
-    :related_id,
-    :token_id,
+    :chain,
+    :product_id,
+    :block_number,
+    :block_hash,
     :tx_id,
+    :trace_address,
     :from_address,
     :to_address,
     :balance_real,
-    :gas,
-    :gas_price,
-    :hex,
     :create_time,
     :handle_status,
     :handle_msg,
-    :handle_time
+    :handle_time,
+    :org_status,
+    :org_msg,
+    :org_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
-		mcommon.H{
-			"id":            row.ID,
-			"related_type":  row.RelatedType,
-			"related_id":    row.RelatedID,
-			"token_id":      row.TokenID,
+		mcommon.H{
+			"id":            row.ID,
+			"chain":         row.Chain,
+			"product_id":    row.ProductID,
+			"block_number":  row.BlockNumber,
+			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
+			"trace_address": row.TraceAddress,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
 			"balance_real":  row.BalanceReal,
-			"gas":           row.Gas,
-			"gas_price":     row.GasPrice,
-			"hex":           row.Hex,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_time":   row.HandleTime,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -5655,49 +12581,53 @@ func SQLCreateTSendBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBt
 	return lastID, nil
 }
 
-// SQLCreateTSendBtcDuplicate 创建更新
-func SQLCreateTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBtc, updates []string) (int64, error) {
+// SQLCreateTTxDuplicate 创建更新
+func SQLCreateTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_send_btc ( ")
+	query.WriteString("INSERT INTO t_tx ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       related_type,
-       related_id,
-       token_id,
+       chain,
+       product_id,
+       block_number,
+       block_hash,
        tx_id,
+       trace_address,
        from_address,
        to_address,
        balance_real,
-       gas,
-       gas_price,
-       hex,
        create_time,
        handle_status,
        handle_msg,
-       handle_time
+       handle_time,
+       org_status,
+       org_msg,
+       org_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :related_type,
-    :related_id,
-    :token_id,
+    :chain,
+    :product_id,
+    :block_number,
+    :block_hash,
     :tx_id,
+    :trace_address,
     :from_address,
     :to_address,
     :balance_real,
-    :gas,
-    :gas_price,
-    :hex,
     :create_time,
     :handle_status,
     :handle_msg,
-    :handle_time
+    :handle_time,
+    :org_status,
+    :org_msg,
+    :org_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -5721,20 +12651,22 @@ func SQLCreateTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"related_type":  row.RelatedType,
-			"related_id":    row.RelatedID,
-			"token_id":      row.TokenID,
+			"chain":         row.Chain,
+			"product_id":    row.ProductID,
+			"block_number":  row.BlockNumber,
+			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
+			"trace_address": row.TraceAddress,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
 			"balance_real":  row.BalanceReal,
-			"gas":           row.Gas,
-			"gas_price":     row.GasPrice,
-			"hex":           row.Hex,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_time":   row.HandleTime,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -5743,8 +12675,8 @@ func SQLCreateTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 	return lastID, nil
 }
 
-// SQLCreateManyTSendBtc 创建多个
-func SQLCreateManyTSendBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendBtc, isIgnore bool) (int64, error) {
+// SQLCreateManyTTx 创建多个
+func SQLCreateManyTTx(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTx, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -5755,20 +12687,22 @@ func SQLCreateManyTSendBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 				args,
 				[]interface{}{
 					row.ID,
-					row.RelatedType,
-					row.RelatedID,
-					row.TokenID,
+					row.Chain,
+					row.ProductID,
+					row.BlockNumber,
+					row.BlockHash,
 					row.TxID,
+					row.TraceAddress,
 					row.FromAddress,
 					row.ToAddress,
 					row.BalanceReal,
-					row.Gas,
-					row.GasPrice,
-					row.Hex,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
 				},
 			)
 		}
@@ -5777,20 +12711,22 @@ func SQLCreateManyTSendBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 			args = append(
 				args,
 				[]interface{}{
-					row.RelatedType,
-					row.RelatedID,
-					row.TokenID,
+					row.Chain,
+					row.ProductID,
+					row.BlockNumber,
+					row.BlockHash,
 					row.TxID,
+					row.TraceAddress,
 					row.FromAddress,
 					row.ToAddress,
 					row.BalanceReal,
-					row.Gas,
-					row.GasPrice,
-					row.Hex,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
 				},
 			)
 		}
@@ -5802,25 +12738,27 @@ func SQLCreateManyTSendBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_send_btc ( ")
+	query.WriteString("INTO t_tx ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    related_type,
-    related_id,
-    token_id,
+    chain,
+    product_id,
+    block_number,
+    block_hash,
     tx_id,
+    trace_address,
     from_address,
     to_address,
     balance_real,
-    gas,
-    gas_price,
-    hex,
     create_time,
     handle_status,
     handle_msg,
-    handle_time
+    handle_time,
+    org_status,
+    org_msg,
+    org_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -5836,8 +12774,8 @@ func SQLCreateManyTSendBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	return count, nil
 }
 
-// SQLCreateManyTSendBtcDuplicate 创建多个
-func SQLCreateManyTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendBtc, updates []string) (int64, error) {
+// SQLCreateManyTTxDuplicate 创建多个
+func SQLCreateManyTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTx, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -5848,20 +12786,22 @@ func SQLCreateManyTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 				args,
 				[]interface{}{
 					row.ID,
-					row.RelatedType,
-					row.RelatedID,
-					row.TokenID,
+					row.Chain,
+					row.ProductID,
+					row.BlockNumber,
+					row.BlockHash,
 					row.TxID,
+					row.TraceAddress,
 					row.FromAddress,
 					row.ToAddress,
 					row.BalanceReal,
-					row.Gas,
-					row.GasPrice,
-					row.Hex,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
 				},
 			)
 		}
@@ -5870,20 +12810,22 @@ func SQLCreateManyTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 			args = append(
 				args,
 				[]interface{}{
-					row.RelatedType,
-					row.RelatedID,
-					row.TokenID,
+					row.Chain,
+					row.ProductID,
+					row.BlockNumber,
+					row.BlockHash,
 					row.TxID,
+					row.TraceAddress,
 					row.FromAddress,
 					row.ToAddress,
 					row.BalanceReal,
-					row.Gas,
-					row.GasPrice,
-					row.Hex,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
 				},
 			)
 		}
@@ -5891,25 +12833,27 @@ func SQLCreateManyTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_send_btc ( ")
+	query.WriteString("INSERT INTO t_tx ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    related_type,
-    related_id,
-    token_id,
+    chain,
+    product_id,
+    block_number,
+    block_hash,
     tx_id,
+    trace_address,
     from_address,
     to_address,
     balance_real,
-    gas,
-    gas_price,
-    hex,
     create_time,
     handle_status,
     handle_msg,
-    handle_time
+    handle_time,
+    org_status,
+    org_msg,
+    org_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -5941,18 +12885,18 @@ func SQLCreateManyTSendBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	return count, nil
 }
 
-// SQLGetTSendBtcCol 根据id查询
-func SQLGetTSendBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSendBtc, error) {
+// SQLGetTTxCol 根据id查询
+func SQLGetTTxCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTx, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send_btc
+	t_tx
 WHERE
 	id=:id`)
 
-	var row DBTSendBtc
+	var row DBTTx
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -5971,8 +12915,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTSendBtcColKV 根据id查询
-func SQLGetTSendBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSendBtc, error) {
+// SQLGetTTxColKV 根据id查询
+func SQLGetTTxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTx, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -5983,7 +12927,7 @@ func SQLGetTSendBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []strin
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send_btc
+	t_tx
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -6013,7 +12957,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTSendBtc
+	var row DBTTx
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -6030,8 +12974,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTSendBtcCol 根据ids获取
-func SQLSelectTSendBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSendBtc, error) {
+// SQLSelectTTxCol 根据ids获取
+func SQLSelectTTxCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTx, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -6040,7 +12984,7 @@ func SQLSelectTSendBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []stri
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send_btc
+	t_tx
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -6054,7 +12998,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTSendBtc
+	var rows []*DBTTx
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -6070,8 +13014,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTSendBtcColKV 根据ids获取
-func SQLSelectTSendBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSendBtc, error) {
+// SQLSelectTTxColKV 根据ids获取
+func SQLSelectTTxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTx, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -6082,7 +13026,7 @@ func SQLSelectTSendBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []st
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send_btc
+	t_tx
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -6123,7 +13067,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTSendBtc
+	var rows []*DBTTx
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -6137,46 +13081,50 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTSendBtc 更新
-func SQLUpdateTSendBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendBtc) (int64, error) {
+// SQLUpdateTTx 更新
+func SQLUpdateTTx(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_send_btc
+	t_tx
 SET
-    related_type=:related_type,
-    related_id=:related_id,
-    token_id=:token_id,
+    chain=:chain,
+    product_id=:product_id,
+    block_number=:block_number,
+    block_hash=:block_hash,
     tx_id=:tx_id,
+    trace_address=:trace_address,
     from_address=:from_address,
     to_address=:to_address,
     balance_real=:balance_real,
-    gas=:gas,
-    gas_price=:gas_price,
-    hex=:hex,
     create_time=:create_time,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
-    handle_time=:handle_time
+    handle_time=:handle_time,
+    org_status=:org_status,
+    org_msg=:org_msg,
+    org_time=:org_time
 WHERE
 	id=:id`,
 		mcommon.H{
 			"id":            row.ID,
-			"related_type":  row.RelatedType,
-			"related_id":    row.RelatedID,
-			"token_id":      row.TokenID,
+			"chain":         row.Chain,
+			"product_id":    row.ProductID,
+			"block_number":  row.BlockNumber,
+			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
+			"trace_address": row.TraceAddress,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
 			"balance_real":  row.BalanceReal,
-			"gas":           row.Gas,
-			"gas_price":     row.GasPrice,
-			"hex":           row.Hex,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_time":   row.HandleTime,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -6185,14 +13133,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTSendBtc 删除
-func SQLDeleteTSendBtc(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTx 删除
+func SQLDeleteTTx(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_send_btc
+	t_tx
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -6205,8 +13153,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTSendEos 创建
-func SQLCreateTSendEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEos, isIgnore bool) (int64, error) {
+// SQLCreateTTxBtc 创建
+func SQLCreateTTxBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -6214,40 +13162,38 @@ func SQLCreateTSendEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEo
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_send_eos ( ")
+	query.WriteString("INTO t_tx_btc ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       withdraw_id,
-       tx_hash,
-       log_index,
-       from_address,
-       to_address,
-       memo,
-       balance_real,
-       hex,
+       product_id,
+       block_number,
+       block_hash,
+       tx_id,
+       vout_n,
+       vout_address,
+       vout_value,
        create_time,
        handle_status,
        handle_msg,
-       handle_at
+       handle_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :withdraw_id,
-    :tx_hash,
-    :log_index,
-    :from_address,
-    :to_address,
-    :memo,
-    :balance_real,
-    :hex,
+    :product_id,
+    :block_number,
+    :block_hash,
+    :tx_id,
+    :vout_n,
+    :vout_address,
+    :vout_value,
     :create_time,
     :handle_status,
     :handle_msg,
-    :handle_at
+    :handle_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
@@ -6255,18 +13201,17 @@ func SQLCreateTSendEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEo
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"withdraw_id":   row.WithdrawID,
-			"tx_hash":       row.TxHash,
-			"log_index":     row.LogIndex,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"memo":          row.Memo,
-			"balance_real":  row.BalanceReal,
-			"hex":           row.Hex,
+			"product_id":    row.ProductID,
+			"block_number":  row.BlockNumber,
+			"block_hash":    row.BlockHash,
+			"tx_id":         row.TxID,
+			"vout_n":        row.VoutN,
+			"vout_address":  row.VoutAddress,
+			"vout_value":    row.VoutValue,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_at":     row.HandleAt,
+			"handle_time":   row.HandleTime,
 		},
 	)
 	if err != nil {
@@ -6275,45 +13220,43 @@ func SQLCreateTSendEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEo
 	return lastID, nil
 }
 
-// SQLCreateTSendEosDuplicate 创建更新
-func SQLCreateTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEos, updates []string) (int64, error) {
+// SQLCreateTTxBtcDuplicate 创建更新
+func SQLCreateTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_send_eos ( ")
+	query.WriteString("INSERT INTO t_tx_btc ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       withdraw_id,
-       tx_hash,
-       log_index,
-       from_address,
-       to_address,
-       memo,
-       balance_real,
-       hex,
+       product_id,
+       block_number,
+       block_hash,
+       tx_id,
+       vout_n,
+       vout_address,
+       vout_value,
        create_time,
        handle_status,
        handle_msg,
-       handle_at
+       handle_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :withdraw_id,
-    :tx_hash,
-    :log_index,
-    :from_address,
-    :to_address,
-    :memo,
-    :balance_real,
-    :hex,
+    :product_id,
+    :block_number,
+    :block_hash,
+    :tx_id,
+    :vout_n,
+    :vout_address,
+    :vout_value,
     :create_time,
     :handle_status,
     :handle_msg,
-    :handle_at
+    :handle_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -6337,18 +13280,17 @@ func SQLCreateTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"withdraw_id":   row.WithdrawID,
-			"tx_hash":       row.TxHash,
-			"log_index":     row.LogIndex,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"memo":          row.Memo,
-			"balance_real":  row.BalanceReal,
-			"hex":           row.Hex,
+			"product_id":    row.ProductID,
+			"block_number":  row.BlockNumber,
+			"block_hash":    row.BlockHash,
+			"tx_id":         row.TxID,
+			"vout_n":        row.VoutN,
+			"vout_address":  row.VoutAddress,
+			"vout_value":    row.VoutValue,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_at":     row.HandleAt,
+			"handle_time":   row.HandleTime,
 		},
 	)
 	if err != nil {
@@ -6357,8 +13299,8 @@ func SQLCreateTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 	return lastID, nil
 }
 
-// SQLCreateManyTSendEos 创建多个
-func SQLCreateManyTSendEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendEos, isIgnore bool) (int64, error) {
+// SQLCreateManyTTxBtc 创建多个
+func SQLCreateManyTTxBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtc, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -6369,18 +13311,17 @@ func SQLCreateManyTSendEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 				args,
 				[]interface{}{
 					row.ID,
-					row.WithdrawID,
-					row.TxHash,
-					row.LogIndex,
-					row.FromAddress,
-					row.ToAddress,
-					row.Memo,
-					row.BalanceReal,
-					row.Hex,
+					row.ProductID,
+					row.BlockNumber,
+					row.BlockHash,
+					row.TxID,
+					row.VoutN,
+					row.VoutAddress,
+					row.VoutValue,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleAt,
+					row.HandleTime,
 				},
 			)
 		}
@@ -6389,18 +13330,17 @@ func SQLCreateManyTSendEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 			args = append(
 				args,
 				[]interface{}{
-					row.WithdrawID,
-					row.TxHash,
-					row.LogIndex,
-					row.FromAddress,
-					row.ToAddress,
-					row.Memo,
-					row.BalanceReal,
-					row.Hex,
+					row.ProductID,
+					row.BlockNumber,
+					row.BlockHash,
+					row.TxID,
+					row.VoutN,
+					row.VoutAddress,
+					row.VoutValue,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleAt,
+					row.HandleTime,
 				},
 			)
 		}
@@ -6412,23 +13352,22 @@ func SQLCreateManyTSendEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_send_eos ( ")
+	query.WriteString("INTO t_tx_btc ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    withdraw_id,
-    tx_hash,
-    log_index,
-    from_address,
-    to_address,
-    memo,
-    balance_real,
-    hex,
+    product_id,
+    block_number,
+    block_hash,
+    tx_id,
+    vout_n,
+    vout_address,
+    vout_value,
     create_time,
     handle_status,
     handle_msg,
-    handle_at
+    handle_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -6444,8 +13383,8 @@ func SQLCreateManyTSendEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	return count, nil
 }
 
-// SQLCreateManyTSendEosDuplicate 创建多个
-func SQLCreateManyTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTSendEos, updates []string) (int64, error) {
+// SQLCreateManyTTxBtcDuplicate 创建多个
+func SQLCreateManyTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtc, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -6456,18 +13395,17 @@ func SQLCreateManyTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 				args,
 				[]interface{}{
 					row.ID,
-					row.WithdrawID,
-					row.TxHash,
-					row.LogIndex,
-					row.FromAddress,
-					row.ToAddress,
-					row.Memo,
-					row.BalanceReal,
-					row.Hex,
+					row.ProductID,
+					row.BlockNumber,
+					row.BlockHash,
+					row.TxID,
+					row.VoutN,
+					row.VoutAddress,
+					row.VoutValue,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleAt,
+					row.HandleTime,
 				},
 			)
 		}
@@ -6476,18 +13414,17 @@ func SQLCreateManyTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 			args = append(
 				args,
 				[]interface{}{
-					row.WithdrawID,
-					row.TxHash,
-					row.LogIndex,
-					row.FromAddress,
-					row.ToAddress,
-					row.Memo,
-					row.BalanceReal,
-					row.Hex,
+					row.ProductID,
+					row.BlockNumber,
+					row.BlockHash,
+					row.TxID,
+					row.VoutN,
+					row.VoutAddress,
+					row.VoutValue,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleAt,
+					row.HandleTime,
 				},
 			)
 		}
@@ -6495,23 +13432,22 @@ func SQLCreateManyTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_send_eos ( ")
+	query.WriteString("INSERT INTO t_tx_btc ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    withdraw_id,
-    tx_hash,
-    log_index,
-    from_address,
-    to_address,
-    memo,
-    balance_real,
-    hex,
+    product_id,
+    block_number,
+    block_hash,
+    tx_id,
+    vout_n,
+    vout_address,
+    vout_value,
     create_time,
     handle_status,
     handle_msg,
-    handle_at
+    handle_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -6543,18 +13479,18 @@ func SQLCreateManyTSendEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	return count, nil
 }
 
-// SQLGetTSendEosCol 根据id查询
-func SQLGetTSendEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTSendEos, error) {
+// SQLGetTTxBtcCol 根据id查询
+func SQLGetTTxBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxBtc, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send_eos
+	t_tx_btc
 WHERE
 	id=:id`)
 
-	var row DBTSendEos
+	var row DBTTxBtc
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -6573,8 +13509,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTSendEosColKV 根据id查询
-func SQLGetTSendEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTSendEos, error) {
+// SQLGetTTxBtcColKV 根据id查询
+func SQLGetTTxBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxBtc, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -6585,7 +13521,7 @@ func SQLGetTSendEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []strin
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send_eos
+	t_tx_btc
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -6615,7 +13551,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTSendEos
+	var row DBTTxBtc
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -6632,8 +13568,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTSendEosCol 根据ids获取
-func SQLSelectTSendEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTSendEos, error) {
+// SQLSelectTTxBtcCol 根据ids获取
+func SQLSelectTTxBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxBtc, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -6642,7 +13578,7 @@ func SQLSelectTSendEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []stri
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send_eos
+	t_tx_btc
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -6656,7 +13592,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTSendEos
+	var rows []*DBTTxBtc
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -6672,8 +13608,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTSendEosColKV 根据ids获取
-func SQLSelectTSendEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTSendEos, error) {
+// SQLSelectTTxBtcColKV 根据ids获取
+func SQLSelectTTxBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxBtc, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -6684,7 +13620,7 @@ func SQLSelectTSendEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []st
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_send_eos
+	t_tx_btc
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -6725,7 +13661,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTSendEos
+	var rows []*DBTTxBtc
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -6739,42 +13675,40 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTSendEos 更新
-func SQLUpdateTSendEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTSendEos) (int64, error) {
+// SQLUpdateTTxBtc 更新
+func SQLUpdateTTxBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_send_eos
+	t_tx_btc
 SET
-    withdraw_id=:withdraw_id,
-    tx_hash=:tx_hash,
-    log_index=:log_index,
-    from_address=:from_address,
-    to_address=:to_address,
-    memo=:memo,
-    balance_real=:balance_real,
-    hex=:hex,
+    product_id=:product_id,
+    block_number=:block_number,
+    block_hash=:block_hash,
+    tx_id=:tx_id,
+    vout_n=:vout_n,
+    vout_address=:vout_address,
+    vout_value=:vout_value,
     create_time=:create_time,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
-    handle_at=:handle_at
+    handle_time=:handle_time
 WHERE
 	id=:id`,
 		mcommon.H{
 			"id":            row.ID,
-			"withdraw_id":   row.WithdrawID,
-			"tx_hash":       row.TxHash,
-			"log_index":     row.LogIndex,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"memo":          row.Memo,
-			"balance_real":  row.BalanceReal,
-			"hex":           row.Hex,
+			"product_id":    row.ProductID,
+			"block_number":  row.BlockNumber,
+			"block_hash":    row.BlockHash,
+			"tx_id":         row.TxID,
+			"vout_n":        row.VoutN,
+			"vout_address":  row.VoutAddress,
+			"vout_value":    row.VoutValue,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_at":     row.HandleAt,
+			"handle_time":   row.HandleTime,
 		},
 	)
 	if err != nil {
@@ -6783,14 +13717,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTSendEos 删除
-func SQLDeleteTSendEos(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTxBtc 删除
+func SQLDeleteTTxBtc(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_send_eos
+	t_tx_btc
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -6803,8 +13737,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTTx 创建
-func SQLCreateTTx(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx, isIgnore bool) (int64, error) {
+// SQLCreateTTxBtcToken 创建
+func SQLCreateTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcToken, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -6812,40 +13746,50 @@ func SQLCreateTTx(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx, isIgnor
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx ( ")
+	query.WriteString("INTO t_tx_btc_token ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
        product_id,
+       token_index,
+       token_symbol,
+       block_number,
+       block_hash,
        tx_id,
        from_address,
        to_address,
-       balance_real,
-       create_time,
+       value,
+       blocktime,
+       create_at,
        handle_status,
        handle_msg,
-       handle_time,
+       handle_at,
        org_status,
        org_msg,
-       org_time
+       org_at
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
     :product_id,
+    :token_index,
+    :token_symbol,
+    :block_number,
+    :block_hash,
     :tx_id,
     :from_address,
     :to_address,
-    :balance_real,
-    :create_time,
+    :value,
+    :blocktime,
+    :create_at,
     :handle_status,
     :handle_msg,
-    :handle_time,
+    :handle_at,
     :org_status,
     :org_msg,
-    :org_time
+    :org_at
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
@@ -6854,17 +13798,22 @@ func SQLCreateTTx(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx, isIgnor
 		mcommon.H{
 			"id":            row.ID,
 			"product_id":    row.ProductID,
+			"token_index":   row.TokenIndex,
+			"token_symbol":  row.TokenSymbol,
+			"block_number":  row.BlockNumber,
+			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
-			"create_time":   row.CreateTime,
+			"value":         row.Value,
+			"blocktime":     row.Blocktime,
+			"create_at":     row.CreateAt,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_time":   row.HandleTime,
+			"handle_at":     row.HandleAt,
 			"org_status":    row.OrgStatus,
 			"org_msg":       row.OrgMsg,
-			"org_time":      row.OrgTime,
+			"org_at":        row.OrgAt,
 		},
 	)
 	if err != nil {
@@ -6873,45 +13822,55 @@ func SQLCreateTTx(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx, isIgnor
 	return lastID, nil
 }
 
-// SQLCreateTTxDuplicate 创建更新
-func SQLCreateTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx, updates []string) (int64, error) {
+// SQLCreateTTxBtcTokenDuplicate 创建更新
+func SQLCreateTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcToken, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx ( ")
+	query.WriteString("INSERT INTO t_tx_btc_token ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
        product_id,
+       token_index,
+       token_symbol,
+       block_number,
+       block_hash,
        tx_id,
        from_address,
        to_address,
-       balance_real,
-       create_time,
+       value,
+       blocktime,
+       create_at,
        handle_status,
        handle_msg,
-       handle_time,
+       handle_at,
        org_status,
        org_msg,
-       org_time
+       org_at
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
     :product_id,
+    :token_index,
+    :token_symbol,
+    :block_number,
+    :block_hash,
     :tx_id,
     :from_address,
     :to_address,
-    :balance_real,
-    :create_time,
+    :value,
+    :blocktime,
+    :create_at,
     :handle_status,
     :handle_msg,
-    :handle_time,
+    :handle_at,
     :org_status,
     :org_msg,
-    :org_time
+    :org_at
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -6936,17 +13895,22 @@ func SQLCreateTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx
 		mcommon.H{
 			"id":            row.ID,
 			"product_id":    row.ProductID,
+			"token_index":   row.TokenIndex,
+			"token_symbol":  row.TokenSymbol,
+			"block_number":  row.BlockNumber,
+			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
-			"create_time":   row.CreateTime,
+			"value":         row.Value,
+			"blocktime":     row.Blocktime,
+			"create_at":     row.CreateAt,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_time":   row.HandleTime,
+			"handle_at":     row.HandleAt,
 			"org_status":    row.OrgStatus,
 			"org_msg":       row.OrgMsg,
-			"org_time":      row.OrgTime,
+			"org_at":        row.OrgAt,
 		},
 	)
 	if err != nil {
@@ -6955,8 +13919,8 @@ func SQLCreateTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx
 	return lastID, nil
 }
 
-// SQLCreateManyTTx 创建多个
-func SQLCreateManyTTx(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTx, isIgnore bool) (int64, error) {
+// SQLCreateManyTTxBtcToken 创建多个
+func SQLCreateManyTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcToken, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -6968,17 +13932,22 @@ func SQLCreateManyTTx(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTx,
 				[]interface{}{
 					row.ID,
 					row.ProductID,
+					row.TokenIndex,
+					row.TokenSymbol,
+					row.BlockNumber,
+					row.BlockHash,
 					row.TxID,
 					row.FromAddress,
 					row.ToAddress,
-					row.BalanceReal,
-					row.CreateTime,
+					row.Value,
+					row.Blocktime,
+					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleTime,
+					row.HandleAt,
 					row.OrgStatus,
 					row.OrgMsg,
-					row.OrgTime,
+					row.OrgAt,
 				},
 			)
 		}
@@ -6988,17 +13957,22 @@ func SQLCreateManyTTx(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTx,
 				args,
 				[]interface{}{
 					row.ProductID,
+					row.TokenIndex,
+					row.TokenSymbol,
+					row.BlockNumber,
+					row.BlockHash,
 					row.TxID,
 					row.FromAddress,
 					row.ToAddress,
-					row.BalanceReal,
-					row.CreateTime,
+					row.Value,
+					row.Blocktime,
+					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleTime,
+					row.HandleAt,
 					row.OrgStatus,
 					row.OrgMsg,
-					row.OrgTime,
+					row.OrgAt,
 				},
 			)
 		}
@@ -7010,23 +13984,28 @@ func SQLCreateManyTTx(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTx,
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx ( ")
+	query.WriteString("INTO t_tx_btc_token ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
     product_id,
+    token_index,
+    token_symbol,
+    block_number,
+    block_hash,
     tx_id,
     from_address,
     to_address,
-    balance_real,
-    create_time,
+    value,
+    blocktime,
+    create_at,
     handle_status,
     handle_msg,
-    handle_time,
+    handle_at,
     org_status,
     org_msg,
-    org_time
+    org_at
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -7042,8 +14021,8 @@ func SQLCreateManyTTx(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTx,
 	return count, nil
 }
 
-// SQLCreateManyTTxDuplicate 创建多个
-func SQLCreateManyTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTx, updates []string) (int64, error) {
+// SQLCreateManyTTxBtcTokenDuplicate 创建多个
+func SQLCreateManyTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcToken, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -7055,17 +14034,22 @@ func SQLCreateManyTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows [
 				[]interface{}{
 					row.ID,
 					row.ProductID,
+					row.TokenIndex,
+					row.TokenSymbol,
+					row.BlockNumber,
+					row.BlockHash,
 					row.TxID,
 					row.FromAddress,
 					row.ToAddress,
-					row.BalanceReal,
-					row.CreateTime,
+					row.Value,
+					row.Blocktime,
+					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleTime,
+					row.HandleAt,
 					row.OrgStatus,
 					row.OrgMsg,
-					row.OrgTime,
+					row.OrgAt,
 				},
 			)
 		}
@@ -7075,17 +14059,22 @@ func SQLCreateManyTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows [
 				args,
 				[]interface{}{
 					row.ProductID,
+					row.TokenIndex,
+					row.TokenSymbol,
+					row.BlockNumber,
+					row.BlockHash,
 					row.TxID,
 					row.FromAddress,
 					row.ToAddress,
-					row.BalanceReal,
-					row.CreateTime,
+					row.Value,
+					row.Blocktime,
+					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
-					row.HandleTime,
+					row.HandleAt,
 					row.OrgStatus,
 					row.OrgMsg,
-					row.OrgTime,
+					row.OrgAt,
 				},
 			)
 		}
@@ -7093,23 +14082,28 @@ func SQLCreateManyTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows [
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx ( ")
+	query.WriteString("INSERT INTO t_tx_btc_token ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
     product_id,
+    token_index,
+    token_symbol,
+    block_number,
+    block_hash,
     tx_id,
     from_address,
     to_address,
-    balance_real,
-    create_time,
+    value,
+    blocktime,
+    create_at,
     handle_status,
     handle_msg,
-    handle_time,
+    handle_at,
     org_status,
     org_msg,
-    org_time
+    org_at
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -7141,18 +14135,18 @@ func SQLCreateManyTTxDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows [
 	return count, nil
 }
 
-// SQLGetTTxCol 根据id查询
-func SQLGetTTxCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTx, error) {
+// SQLGetTTxBtcTokenCol 根据id查询
+func SQLGetTTxBtcTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxBtcToken, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx
+	t_tx_btc_token
 WHERE
 	id=:id`)
 
-	var row DBTTx
+	var row DBTTxBtcToken
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -7171,8 +14165,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTTxColKV 根据id查询
-func SQLGetTTxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTx, error) {
+// SQLGetTTxBtcTokenColKV 根据id查询
+func SQLGetTTxBtcTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxBtcToken, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -7183,7 +14177,7 @@ func SQLGetTTxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, ke
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx
+	t_tx_btc_token
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -7213,7 +14207,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTTx
+	var row DBTTxBtcToken
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -7230,8 +14224,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTTxCol 根据ids获取
-func SQLSelectTTxCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTx, error) {
+// SQLSelectTTxBtcTokenCol 根据ids获取
+func SQLSelectTTxBtcTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxBtcToken, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -7240,7 +14234,7 @@ func SQLSelectTTxCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, i
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx
+	t_tx_btc_token
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -7254,7 +14248,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTTx
+	var rows []*DBTTxBtcToken
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -7270,8 +14264,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTTxColKV 根据ids获取
-func SQLSelectTTxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTx, error) {
+// SQLSelectTTxBtcTokenColKV 根据ids获取
+func SQLSelectTTxBtcTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxBtcToken, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -7282,7 +14276,7 @@ func SQLSelectTTxColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string,
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx
+	t_tx_btc_token
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -7323,7 +14317,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTTx
+	var rows []*DBTTxBtcToken
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -7337,42 +14331,52 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTTx 更新
-func SQLUpdateTTx(ctx context.Context, tx mcommon.DbExeAble, row *DBTTx) (int64, error) {
+// SQLUpdateTTxBtcToken 更新
+func SQLUpdateTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcToken) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_tx
+	t_tx_btc_token
 SET
     product_id=:product_id,
+    token_index=:token_index,
+    token_symbol=:token_symbol,
+    block_number=:block_number,
+    block_hash=:block_hash,
     tx_id=:tx_id,
     from_address=:from_address,
     to_address=:to_address,
-    balance_real=:balance_real,
-    create_time=:create_time,
+    value=:value,
+    blocktime=:blocktime,
+    create_at=:create_at,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
-    handle_time=:handle_time,
+    handle_at=:handle_at,
     org_status=:org_status,
     org_msg=:org_msg,
-    org_time=:org_time
+    org_at=:org_at
 WHERE
 	id=:id`,
 		mcommon.H{
 			"id":            row.ID,
 			"product_id":    row.ProductID,
+			"token_index":   row.TokenIndex,
+			"token_symbol":  row.TokenSymbol,
+			"block_number":  row.BlockNumber,
+			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
-			"create_time":   row.CreateTime,
+			"value":         row.Value,
+			"blocktime":     row.Blocktime,
+			"create_at":     row.CreateAt,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
-			"handle_time":   row.HandleTime,
+			"handle_at":     row.HandleAt,
 			"org_status":    row.OrgStatus,
 			"org_msg":       row.OrgMsg,
-			"org_time":      row.OrgTime,
+			"org_at":        row.OrgAt,
 		},
 	)
 	if err != nil {
@@ -7381,14 +14385,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTTx 删除
-func SQLDeleteTTx(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTxBtcToken 删除
+func SQLDeleteTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_tx
+	t_tx_btc_token
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -7401,8 +14405,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTTxBtc 创建
-func SQLCreateTTxBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc, isIgnore bool) (int64, error) {
+// SQLCreateTTxBtcUxto 创建
+func SQLCreateTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcUxto, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -7410,18 +14414,21 @@ func SQLCreateTTxBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc, i
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_btc ( ")
+	query.WriteString("INTO t_tx_btc_uxto ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       product_id,
+       uxto_type,
        block_hash,
        tx_id,
        vout_n,
        vout_address,
        vout_value,
+       vout_script,
        create_time,
+       spend_tx_id,
+       spend_n,
        handle_status,
        handle_msg,
        handle_time
@@ -7430,13 +14437,16 @@ func SQLCreateTTxBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc, i
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :product_id,
+    :uxto_type,
     :block_hash,
     :tx_id,
     :vout_n,
     :vout_address,
     :vout_value,
+    :vout_script,
     :create_time,
+    :spend_tx_id,
+    :spend_n,
     :handle_status,
     :handle_msg,
     :handle_time
@@ -7447,13 +14457,16 @@ func SQLCreateTTxBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc, i
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"product_id":    row.ProductID,
+			"uxto_type":     row.UxtoType,
 			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
 			"vout_n":        row.VoutN,
 			"vout_address":  row.VoutAddress,
 			"vout_value":    row.VoutValue,
+			"vout_script":   row.VoutScript,
 			"create_time":   row.CreateTime,
+			"spend_tx_id":   row.SpendTxID,
+			"spend_n":       row.SpendN,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_time":   row.HandleTime,
@@ -7465,23 +14478,26 @@ func SQLCreateTTxBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc, i
 	return lastID, nil
 }
 
-// SQLCreateTTxBtcDuplicate 创建更新
-func SQLCreateTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc, updates []string) (int64, error) {
+// SQLCreateTTxBtcUxtoDuplicate 创建更新
+func SQLCreateTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcUxto, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_btc ( ")
+	query.WriteString("INSERT INTO t_tx_btc_uxto ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       product_id,
+       uxto_type,
        block_hash,
        tx_id,
        vout_n,
        vout_address,
        vout_value,
+       vout_script,
        create_time,
+       spend_tx_id,
+       spend_n,
        handle_status,
        handle_msg,
        handle_time
@@ -7490,13 +14506,16 @@ func SQLCreateTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DB
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :product_id,
+    :uxto_type,
     :block_hash,
     :tx_id,
     :vout_n,
     :vout_address,
     :vout_value,
+    :vout_script,
     :create_time,
+    :spend_tx_id,
+    :spend_n,
     :handle_status,
     :handle_msg,
     :handle_time
@@ -7523,13 +14542,16 @@ func SQLCreateTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DB
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"product_id":    row.ProductID,
+			"uxto_type":     row.UxtoType,
 			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
 			"vout_n":        row.VoutN,
 			"vout_address":  row.VoutAddress,
 			"vout_value":    row.VoutValue,
+			"vout_script":   row.VoutScript,
 			"create_time":   row.CreateTime,
+			"spend_tx_id":   row.SpendTxID,
+			"spend_n":       row.SpendN,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_time":   row.HandleTime,
@@ -7541,8 +14563,8 @@ func SQLCreateTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DB
 	return lastID, nil
 }
 
-// SQLCreateManyTTxBtc 创建多个
-func SQLCreateManyTTxBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtc, isIgnore bool) (int64, error) {
+// SQLCreateManyTTxBtcUxto 创建多个
+func SQLCreateManyTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcUxto, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -7553,13 +14575,16 @@ func SQLCreateManyTTxBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTT
 				args,
 				[]interface{}{
 					row.ID,
-					row.ProductID,
+					row.UxtoType,
 					row.BlockHash,
 					row.TxID,
 					row.VoutN,
 					row.VoutAddress,
 					row.VoutValue,
+					row.VoutScript,
 					row.CreateTime,
+					row.SpendTxID,
+					row.SpendN,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
@@ -7571,13 +14596,16 @@ func SQLCreateManyTTxBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTT
 			args = append(
 				args,
 				[]interface{}{
-					row.ProductID,
+					row.UxtoType,
 					row.BlockHash,
 					row.TxID,
 					row.VoutN,
 					row.VoutAddress,
 					row.VoutValue,
+					row.VoutScript,
 					row.CreateTime,
+					row.SpendTxID,
+					row.SpendN,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
@@ -7592,18 +14620,21 @@ func SQLCreateManyTTxBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTT
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_btc ( ")
+	query.WriteString("INTO t_tx_btc_uxto ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    product_id,
+    uxto_type,
     block_hash,
     tx_id,
     vout_n,
     vout_address,
     vout_value,
+    vout_script,
     create_time,
+    spend_tx_id,
+    spend_n,
     handle_status,
     handle_msg,
     handle_time
@@ -7622,8 +14653,8 @@ func SQLCreateManyTTxBtc(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTT
 	return count, nil
 }
 
-// SQLCreateManyTTxBtcDuplicate 创建多个
-func SQLCreateManyTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtc, updates []string) (int64, error) {
+// SQLCreateManyTTxBtcUxtoDuplicate 创建多个
+func SQLCreateManyTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcUxto, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -7634,13 +14665,16 @@ func SQLCreateManyTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 				args,
 				[]interface{}{
 					row.ID,
-					row.ProductID,
+					row.UxtoType,
 					row.BlockHash,
 					row.TxID,
 					row.VoutN,
 					row.VoutAddress,
 					row.VoutValue,
+					row.VoutScript,
 					row.CreateTime,
+					row.SpendTxID,
+					row.SpendN,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
@@ -7652,13 +14686,16 @@ func SQLCreateManyTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 			args = append(
 				args,
 				[]interface{}{
-					row.ProductID,
+					row.UxtoType,
 					row.BlockHash,
 					row.TxID,
 					row.VoutN,
 					row.VoutAddress,
 					row.VoutValue,
+					row.VoutScript,
 					row.CreateTime,
+					row.SpendTxID,
+					row.SpendN,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
@@ -7669,18 +14706,21 @@ func SQLCreateManyTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_btc ( ")
+	query.WriteString("INSERT INTO t_tx_btc_uxto ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    product_id,
+    uxto_type,
     block_hash,
     tx_id,
     vout_n,
     vout_address,
     vout_value,
+    vout_script,
     create_time,
+    spend_tx_id,
+    spend_n,
     handle_status,
     handle_msg,
     handle_time
@@ -7715,18 +14755,18 @@ func SQLCreateManyTTxBtcDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 	return count, nil
 }
 
-// SQLGetTTxBtcCol 根据id查询
-func SQLGetTTxBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxBtc, error) {
+// SQLGetTTxBtcUxtoCol 根据id查询
+func SQLGetTTxBtcUxtoCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxBtcUxto, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc
+	t_tx_btc_uxto
 WHERE
 	id=:id`)
 
-	var row DBTTxBtc
+	var row DBTTxBtcUxto
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -7745,8 +14785,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTTxBtcColKV 根据id查询
-func SQLGetTTxBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxBtc, error) {
+// SQLGetTTxBtcUxtoColKV 根据id查询
+func SQLGetTTxBtcUxtoColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxBtcUxto, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -7757,7 +14797,7 @@ func SQLGetTTxBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string,
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc
+	t_tx_btc_uxto
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -7787,7 +14827,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTTxBtc
+	var row DBTTxBtcUxto
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -7804,8 +14844,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTTxBtcCol 根据ids获取
-func SQLSelectTTxBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxBtc, error) {
+// SQLSelectTTxBtcUxtoCol 根据ids获取
+func SQLSelectTTxBtcUxtoCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxBtcUxto, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -7814,7 +14854,7 @@ func SQLSelectTTxBtcCol(ctx context.Context, tx mcommon.DbExeAble, cols []string
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc
+	t_tx_btc_uxto
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -7828,7 +14868,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTTxBtc
+	var rows []*DBTTxBtcUxto
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -7844,8 +14884,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTTxBtcColKV 根据ids获取
-func SQLSelectTTxBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxBtc, error) {
+// SQLSelectTTxBtcUxtoColKV 根据ids获取
+func SQLSelectTTxBtcUxtoColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxBtcUxto, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -7856,7 +14896,7 @@ func SQLSelectTTxBtcColKV(ctx context.Context, tx mcommon.DbExeAble, cols []stri
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc
+	t_tx_btc_uxto
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -7897,7 +14937,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTTxBtc
+	var rows []*DBTTxBtcUxto
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -7911,21 +14951,24 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTTxBtc 更新
-func SQLUpdateTTxBtc(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtc) (int64, error) {
+// SQLUpdateTTxBtcUxto 更新
+func SQLUpdateTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcUxto) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_tx_btc
+	t_tx_btc_uxto
 SET
-    product_id=:product_id,
+    uxto_type=:uxto_type,
     block_hash=:block_hash,
     tx_id=:tx_id,
     vout_n=:vout_n,
     vout_address=:vout_address,
     vout_value=:vout_value,
+    vout_script=:vout_script,
     create_time=:create_time,
+    spend_tx_id=:spend_tx_id,
+    spend_n=:spend_n,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
     handle_time=:handle_time
@@ -7933,13 +14976,16 @@ WHERE
 	id=:id`,
 		mcommon.H{
 			"id":            row.ID,
-			"product_id":    row.ProductID,
+			"uxto_type":     row.UxtoType,
 			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
 			"vout_n":        row.VoutN,
 			"vout_address":  row.VoutAddress,
 			"vout_value":    row.VoutValue,
+			"vout_script":   row.VoutScript,
 			"create_time":   row.CreateTime,
+			"spend_tx_id":   row.SpendTxID,
+			"spend_n":       row.SpendN,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_time":   row.HandleTime,
@@ -7951,14 +14997,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTTxBtc 删除
-func SQLDeleteTTxBtc(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTxBtcUxto 删除
+func SQLDeleteTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_tx_btc
+	t_tx_btc_uxto
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -7971,8 +15017,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTTxBtcToken 创建
-func SQLCreateTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcToken, isIgnore bool) (int64, error) {
+// SQLCreateTTxEos 创建
+func SQLCreateTTxEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -7980,48 +15026,42 @@ func SQLCreateTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxB
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_btc_token ( ")
+	query.WriteString("INTO t_tx_eos ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
        product_id,
-       token_index,
-       token_symbol,
+       block_number,
        block_hash,
-       tx_id,
+       tx_hash,
+       log_index,
        from_address,
        to_address,
-       value,
-       blocktime,
+       memo,
+       balance_real,
        create_at,
        handle_status,
        handle_msg,
-       handle_at,
-       org_status,
-       org_msg,
-       org_at
+       handle_at
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
     :product_id,
-    :token_index,
-    :token_symbol,
+    :block_number,
     :block_hash,
-    :tx_id,
+    :tx_hash,
+    :log_index,
     :from_address,
     :to_address,
-    :value,
-    :blocktime,
+    :memo,
+    :balance_real,
     :create_at,
     :handle_status,
     :handle_msg,
-    :handle_at,
-    :org_status,
-    :org_msg,
-    :org_at
+    :handle_at
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
@@ -8030,21 +15070,18 @@ func SQLCreateTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxB
 		mcommon.H{
 			"id":            row.ID,
 			"product_id":    row.ProductID,
-			"token_index":   row.TokenIndex,
-			"token_symbol":  row.TokenSymbol,
+			"block_number":  row.BlockNumber,
 			"block_hash":    row.BlockHash,
-			"tx_id":         row.TxID,
+			"tx_hash":       row.TxHash,
+			"log_index":     row.LogIndex,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"value":         row.Value,
-			"blocktime":     row.Blocktime,
+			"memo":          row.Memo,
+			"balance_real":  row.BalanceReal,
 			"create_at":     row.CreateAt,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_at":     row.HandleAt,
-			"org_status":    row.OrgStatus,
-			"org_msg":       row.OrgMsg,
-			"org_at":        row.OrgAt,
 		},
 	)
 	if err != nil {
@@ -8053,53 +15090,47 @@ func SQLCreateTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxB
 	return lastID, nil
 }
 
-// SQLCreateTTxBtcTokenDuplicate 创建更新
-func SQLCreateTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcToken, updates []string) (int64, error) {
+// SQLCreateTTxEosDuplicate 创建更新
+func SQLCreateTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_btc_token ( ")
+	query.WriteString("INSERT INTO t_tx_eos ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
        product_id,
-       token_index,
-       token_symbol,
+       block_number,
        block_hash,
-       tx_id,
+       tx_hash,
+       log_index,
        from_address,
        to_address,
-       value,
-       blocktime,
+       memo,
+       balance_real,
        create_at,
        handle_status,
        handle_msg,
-       handle_at,
-       org_status,
-       org_msg,
-       org_at
+       handle_at
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
     :product_id,
-    :token_index,
-    :token_symbol,
+    :block_number,
     :block_hash,
-    :tx_id,
+    :tx_hash,
+    :log_index,
     :from_address,
     :to_address,
-    :value,
-    :blocktime,
+    :memo,
+    :balance_real,
     :create_at,
     :handle_status,
     :handle_msg,
-    :handle_at,
-    :org_status,
-    :org_msg,
-    :org_at
+    :handle_at
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -8124,21 +15155,18 @@ func SQLCreateTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, ro
 		mcommon.H{
 			"id":            row.ID,
 			"product_id":    row.ProductID,
-			"token_index":   row.TokenIndex,
-			"token_symbol":  row.TokenSymbol,
+			"block_number":  row.BlockNumber,
 			"block_hash":    row.BlockHash,
-			"tx_id":         row.TxID,
+			"tx_hash":       row.TxHash,
+			"log_index":     row.LogIndex,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"value":         row.Value,
-			"blocktime":     row.Blocktime,
+			"memo":          row.Memo,
+			"balance_real":  row.BalanceReal,
 			"create_at":     row.CreateAt,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_at":     row.HandleAt,
-			"org_status":    row.OrgStatus,
-			"org_msg":       row.OrgMsg,
-			"org_at":        row.OrgAt,
 		},
 	)
 	if err != nil {
@@ -8147,8 +15175,8 @@ func SQLCreateTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, ro
 	return lastID, nil
 }
 
-// SQLCreateManyTTxBtcToken 创建多个
-func SQLCreateManyTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcToken, isIgnore bool) (int64, error) {
+// SQLCreateManyTTxEos 创建多个
+func SQLCreateManyTTxEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxEos, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -8160,21 +15188,18 @@ func SQLCreateManyTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, rows []
 				[]interface{}{
 					row.ID,
 					row.ProductID,
-					row.TokenIndex,
-					row.TokenSymbol,
+					row.BlockNumber,
 					row.BlockHash,
-					row.TxID,
+					row.TxHash,
+					row.LogIndex,
 					row.FromAddress,
 					row.ToAddress,
-					row.Value,
-					row.Blocktime,
+					row.Memo,
+					row.BalanceReal,
 					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleAt,
-					row.OrgStatus,
-					row.OrgMsg,
-					row.OrgAt,
 				},
 			)
 		}
@@ -8184,21 +15209,18 @@ func SQLCreateManyTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, rows []
 				args,
 				[]interface{}{
 					row.ProductID,
-					row.TokenIndex,
-					row.TokenSymbol,
+					row.BlockNumber,
 					row.BlockHash,
-					row.TxID,
+					row.TxHash,
+					row.LogIndex,
 					row.FromAddress,
 					row.ToAddress,
-					row.Value,
-					row.Blocktime,
+					row.Memo,
+					row.BalanceReal,
 					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleAt,
-					row.OrgStatus,
-					row.OrgMsg,
-					row.OrgAt,
 				},
 			)
 		}
@@ -8210,27 +15232,24 @@ func SQLCreateManyTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, rows []
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_btc_token ( ")
+	query.WriteString("INTO t_tx_eos ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
     product_id,
-    token_index,
-    token_symbol,
+    block_number,
     block_hash,
-    tx_id,
+    tx_hash,
+    log_index,
     from_address,
     to_address,
-    value,
-    blocktime,
+    memo,
+    balance_real,
     create_at,
     handle_status,
     handle_msg,
-    handle_at,
-    org_status,
-    org_msg,
-    org_at
+    handle_at
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -8246,8 +15265,8 @@ func SQLCreateManyTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, rows []
 	return count, nil
 }
 
-// SQLCreateManyTTxBtcTokenDuplicate 创建多个
-func SQLCreateManyTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcToken, updates []string) (int64, error) {
+// SQLCreateManyTTxEosDuplicate 创建多个
+func SQLCreateManyTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxEos, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -8259,21 +15278,18 @@ func SQLCreateManyTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble
 				[]interface{}{
 					row.ID,
 					row.ProductID,
-					row.TokenIndex,
-					row.TokenSymbol,
+					row.BlockNumber,
 					row.BlockHash,
-					row.TxID,
+					row.TxHash,
+					row.LogIndex,
 					row.FromAddress,
 					row.ToAddress,
-					row.Value,
-					row.Blocktime,
+					row.Memo,
+					row.BalanceReal,
 					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleAt,
-					row.OrgStatus,
-					row.OrgMsg,
-					row.OrgAt,
 				},
 			)
 		}
@@ -8283,21 +15299,18 @@ func SQLCreateManyTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble
 				args,
 				[]interface{}{
 					row.ProductID,
-					row.TokenIndex,
-					row.TokenSymbol,
+					row.BlockNumber,
 					row.BlockHash,
-					row.TxID,
+					row.TxHash,
+					row.LogIndex,
 					row.FromAddress,
 					row.ToAddress,
-					row.Value,
-					row.Blocktime,
+					row.Memo,
+					row.BalanceReal,
 					row.CreateAt,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleAt,
-					row.OrgStatus,
-					row.OrgMsg,
-					row.OrgAt,
 				},
 			)
 		}
@@ -8305,27 +15318,24 @@ func SQLCreateManyTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_btc_token ( ")
+	query.WriteString("INSERT INTO t_tx_eos ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
     product_id,
-    token_index,
-    token_symbol,
+    block_number,
     block_hash,
-    tx_id,
+    tx_hash,
+    log_index,
     from_address,
     to_address,
-    value,
-    blocktime,
+    memo,
+    balance_real,
     create_at,
     handle_status,
     handle_msg,
-    handle_at,
-    org_status,
-    org_msg,
-    org_at
+    handle_at
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -8357,18 +15367,18 @@ func SQLCreateManyTTxBtcTokenDuplicate(ctx context.Context, tx mcommon.DbExeAble
 	return count, nil
 }
 
-// SQLGetTTxBtcTokenCol 根据id查询
-func SQLGetTTxBtcTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxBtcToken, error) {
+// SQLGetTTxEosCol 根据id查询
+func SQLGetTTxEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxEos, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc_token
+	t_tx_eos
 WHERE
 	id=:id`)
 
-	var row DBTTxBtcToken
+	var row DBTTxEos
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -8387,8 +15397,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTTxBtcTokenColKV 根据id查询
-func SQLGetTTxBtcTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxBtcToken, error) {
+// SQLGetTTxEosColKV 根据id查询
+func SQLGetTTxEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxEos, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -8399,7 +15409,7 @@ func SQLGetTTxBtcTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []st
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc_token
+	t_tx_eos
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -8429,7 +15439,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTTxBtcToken
+	var row DBTTxEos
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -8446,8 +15456,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTTxBtcTokenCol 根据ids获取
-func SQLSelectTTxBtcTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxBtcToken, error) {
+// SQLSelectTTxEosCol 根据ids获取
+func SQLSelectTTxEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxEos, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -8456,7 +15466,7 @@ func SQLSelectTTxBtcTokenCol(ctx context.Context, tx mcommon.DbExeAble, cols []s
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc_token
+	t_tx_eos
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -8470,7 +15480,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTTxBtcToken
+	var rows []*DBTTxEos
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -8486,8 +15496,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTTxBtcTokenColKV 根据ids获取
-func SQLSelectTTxBtcTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxBtcToken, error) {
+// SQLSelectTTxEosColKV 根据ids获取
+func SQLSelectTTxEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxEos, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -8498,7 +15508,7 @@ func SQLSelectTTxBtcTokenColKV(ctx context.Context, tx mcommon.DbExeAble, cols [
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc_token
+	t_tx_eos
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -8539,7 +15549,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTTxBtcToken
+	var rows []*DBTTxEos
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -8553,50 +15563,44 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTTxBtcToken 更新
-func SQLUpdateTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcToken) (int64, error) {
+// SQLUpdateTTxEos 更新
+func SQLUpdateTTxEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_tx_btc_token
+	t_tx_eos
 SET
     product_id=:product_id,
-    token_index=:token_index,
-    token_symbol=:token_symbol,
+    block_number=:block_number,
     block_hash=:block_hash,
-    tx_id=:tx_id,
+    tx_hash=:tx_hash,
+    log_index=:log_index,
     from_address=:from_address,
     to_address=:to_address,
-    value=:value,
-    blocktime=:blocktime,
+    memo=:memo,
+    balance_real=:balance_real,
     create_at=:create_at,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
-    handle_at=:handle_at,
-    org_status=:org_status,
-    org_msg=:org_msg,
-    org_at=:org_at
+    handle_at=:handle_at
 WHERE
 	id=:id`,
 		mcommon.H{
 			"id":            row.ID,
 			"product_id":    row.ProductID,
-			"token_index":   row.TokenIndex,
-			"token_symbol":  row.TokenSymbol,
+			"block_number":  row.BlockNumber,
 			"block_hash":    row.BlockHash,
-			"tx_id":         row.TxID,
+			"tx_hash":       row.TxHash,
+			"log_index":     row.LogIndex,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"value":         row.Value,
-			"blocktime":     row.Blocktime,
+			"memo":          row.Memo,
+			"balance_real":  row.BalanceReal,
 			"create_at":     row.CreateAt,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_at":     row.HandleAt,
-			"org_status":    row.OrgStatus,
-			"org_msg":       row.OrgMsg,
-			"org_at":        row.OrgAt,
 		},
 	)
 	if err != nil {
@@ -8605,14 +15609,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTTxBtcToken 删除
-func SQLDeleteTTxBtcToken(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTxEos 删除
+func SQLDeleteTTxEos(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_tx_btc_token
+	t_tx_eos
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -8625,8 +15629,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTTxBtcUxto 创建
-func SQLCreateTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcUxto, isIgnore bool) (int64, error) {
+// SQLCreateTTxErc20 创建
+func SQLCreateTTxErc20(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc20, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -8634,42 +15638,48 @@ func SQLCreateTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBt
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_btc_uxto ( ")
+	query.WriteString("INTO t_tx_erc20 ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       uxto_type,
+       chain,
+       token_id,
+       product_id,
+       block_number,
        block_hash,
        tx_id,
-       vout_n,
-       vout_address,
-       vout_value,
-       vout_script,
+       from_address,
+       to_address,
+       balance_real,
        create_time,
-       spend_tx_id,
-       spend_n,
        handle_status,
        handle_msg,
-       handle_time
+       handle_time,
+       org_status,
+       org_msg,
+       org_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :uxto_type,
+    :chain,
+    :token_id,
+    :product_id,
+    :block_number,
     :block_hash,
     :tx_id,
-    :vout_n,
-    :vout_address,
-    :vout_value,
-    :vout_script,
+    :from_address,
+    :to_address,
+    :balance_real,
     :create_time,
-    :spend_tx_id,
-    :spend_n,
     :handle_status,
     :handle_msg,
-    :handle_time
+    :handle_time,
+    :org_status,
+    :org_msg,
+    :org_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
@@ -8677,19 +15687,22 @@ func SQLCreateTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBt
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"uxto_type":     row.UxtoType,
+			"chain":         row.Chain,
+			"token_id":      row.TokenID,
+			"product_id":    row.ProductID,
+			"block_number":  row.BlockNumber,
 			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
-			"vout_n":        row.VoutN,
-			"vout_address":  row.VoutAddress,
-			"vout_value":    row.VoutValue,
-			"vout_script":   row.VoutScript,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
 			"create_time":   row.CreateTime,
-			"spend_tx_id":   row.SpendTxID,
-			"spend_n":       row.SpendN,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_time":   row.HandleTime,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -8698,47 +15711,53 @@ func SQLCreateTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBt
 	return lastID, nil
 }
 
-// SQLCreateTTxBtcUxtoDuplicate 创建更新
-func SQLCreateTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcUxto, updates []string) (int64, error) {
+// SQLCreateTTxErc20Duplicate 创建更新
+func SQLCreateTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc20, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_btc_uxto ( ")
+	query.WriteString("INSERT INTO t_tx_erc20 ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       uxto_type,
+       chain,
+       token_id,
+       product_id,
+       block_number,
        block_hash,
        tx_id,
-       vout_n,
-       vout_address,
-       vout_value,
-       vout_script,
+       from_address,
+       to_address,
+       balance_real,
        create_time,
-       spend_tx_id,
-       spend_n,
        handle_status,
        handle_msg,
-       handle_time
+       handle_time,
+       org_status,
+       org_msg,
+       org_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :uxto_type,
+    :chain,
+    :token_id,
+    :product_id,
+    :block_number,
     :block_hash,
     :tx_id,
-    :vout_n,
-    :vout_address,
-    :vout_value,
-    :vout_script,
+    :from_address,
+    :to_address,
+    :balance_real,
     :create_time,
-    :spend_tx_id,
-    :spend_n,
     :handle_status,
     :handle_msg,
-    :handle_time
+    :handle_time,
+    :org_status,
+    :org_msg,
+    :org_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -8762,19 +15781,22 @@ func SQLCreateTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"uxto_type":     row.UxtoType,
+			"chain":         row.Chain,
+			"token_id":      row.TokenID,
+			"product_id":    row.ProductID,
+			"block_number":  row.BlockNumber,
 			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
-			"vout_n":        row.VoutN,
-			"vout_address":  row.VoutAddress,
-			"vout_value":    row.VoutValue,
-			"vout_script":   row.VoutScript,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
 			"create_time":   row.CreateTime,
-			"spend_tx_id":   row.SpendTxID,
-			"spend_n":       row.SpendN,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_time":   row.HandleTime,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -8783,8 +15805,8 @@ func SQLCreateTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 	return lastID, nil
 }
 
-// SQLCreateManyTTxBtcUxto 创建多个
-func SQLCreateManyTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcUxto, isIgnore bool) (int64, error) {
+// SQLCreateManyTTxErc20 创建多个
+func SQLCreateManyTTxErc20(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxErc20, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -8795,19 +15817,22 @@ func SQLCreateManyTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, rows []*
 				args,
 				[]interface{}{
 					row.ID,
-					row.UxtoType,
+					row.Chain,
+					row.TokenID,
+					row.ProductID,
+					row.BlockNumber,
 					row.BlockHash,
 					row.TxID,
-					row.VoutN,
-					row.VoutAddress,
-					row.VoutValue,
-					row.VoutScript,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
 					row.CreateTime,
-					row.SpendTxID,
-					row.SpendN,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
 				},
 			)
 		}
@@ -8816,19 +15841,22 @@ func SQLCreateManyTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, rows []*
 			args = append(
 				args,
 				[]interface{}{
-					row.UxtoType,
+					row.Chain,
+					row.TokenID,
+					row.ProductID,
+					row.BlockNumber,
 					row.BlockHash,
 					row.TxID,
-					row.VoutN,
-					row.VoutAddress,
-					row.VoutValue,
-					row.VoutScript,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
 					row.CreateTime,
-					row.SpendTxID,
-					row.SpendN,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
 				},
 			)
 		}
@@ -8840,24 +15868,27 @@ func SQLCreateManyTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, rows []*
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_btc_uxto ( ")
+	query.WriteString("INTO t_tx_erc20 ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    uxto_type,
+    chain,
+    token_id,
+    product_id,
+    block_number,
     block_hash,
     tx_id,
-    vout_n,
-    vout_address,
-    vout_value,
-    vout_script,
+    from_address,
+    to_address,
+    balance_real,
     create_time,
-    spend_tx_id,
-    spend_n,
     handle_status,
     handle_msg,
-    handle_time
+    handle_time,
+    org_status,
+    org_msg,
+    org_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -8873,8 +15904,8 @@ func SQLCreateManyTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, rows []*
 	return count, nil
 }
 
-// SQLCreateManyTTxBtcUxtoDuplicate 创建多个
-func SQLCreateManyTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxBtcUxto, updates []string) (int64, error) {
+// SQLCreateManyTTxErc20Duplicate 创建多个
+func SQLCreateManyTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxErc20, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -8885,19 +15916,22 @@ func SQLCreateManyTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 				args,
 				[]interface{}{
 					row.ID,
-					row.UxtoType,
+					row.Chain,
+					row.TokenID,
+					row.ProductID,
+					row.BlockNumber,
 					row.BlockHash,
 					row.TxID,
-					row.VoutN,
-					row.VoutAddress,
-					row.VoutValue,
-					row.VoutScript,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
 					row.CreateTime,
-					row.SpendTxID,
-					row.SpendN,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
 				},
 			)
 		}
@@ -8906,19 +15940,22 @@ func SQLCreateManyTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 			args = append(
 				args,
 				[]interface{}{
-					row.UxtoType,
+					row.Chain,
+					row.TokenID,
+					row.ProductID,
+					row.BlockNumber,
 					row.BlockHash,
 					row.TxID,
-					row.VoutN,
-					row.VoutAddress,
-					row.VoutValue,
-					row.VoutScript,
+					row.FromAddress,
+					row.ToAddress,
+					row.BalanceReal,
 					row.CreateTime,
-					row.SpendTxID,
-					row.SpendN,
 					row.HandleStatus,
 					row.HandleMsg,
 					row.HandleTime,
+					row.OrgStatus,
+					row.OrgMsg,
+					row.OrgTime,
 				},
 			)
 		}
@@ -8926,24 +15963,27 @@ func SQLCreateManyTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_btc_uxto ( ")
+	query.WriteString("INSERT INTO t_tx_erc20 ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    uxto_type,
+    chain,
+    token_id,
+    product_id,
+    block_number,
     block_hash,
     tx_id,
-    vout_n,
-    vout_address,
-    vout_value,
-    vout_script,
+    from_address,
+    to_address,
+    balance_real,
     create_time,
-    spend_tx_id,
-    spend_n,
     handle_status,
     handle_msg,
-    handle_time
+    handle_time,
+    org_status,
+    org_msg,
+    org_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -8975,18 +16015,18 @@ func SQLCreateManyTTxBtcUxtoDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 	return count, nil
 }
 
-// SQLGetTTxBtcUxtoCol 根据id查询
-func SQLGetTTxBtcUxtoCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxBtcUxto, error) {
+// SQLGetTTxErc20Col 根据id查询
+func SQLGetTTxErc20Col(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxErc20, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc_uxto
+	t_tx_erc20
 WHERE
 	id=:id`)
 
-	var row DBTTxBtcUxto
+	var row DBTTxErc20
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -9005,8 +16045,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTTxBtcUxtoColKV 根据id查询
-func SQLGetTTxBtcUxtoColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxBtcUxto, error) {
+// SQLGetTTxErc20ColKV 根据id查询
+func SQLGetTTxErc20ColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxErc20, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -9017,7 +16057,7 @@ func SQLGetTTxBtcUxtoColKV(ctx context.Context, tx mcommon.DbExeAble, cols []str
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc_uxto
+	t_tx_erc20
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -9047,7 +16087,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTTxBtcUxto
+	var row DBTTxErc20
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -9064,8 +16104,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTTxBtcUxtoCol 根据ids获取
-func SQLSelectTTxBtcUxtoCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxBtcUxto, error) {
+// SQLSelectTTxErc20Col 根据ids获取
+func SQLSelectTTxErc20Col(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxErc20, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -9074,7 +16114,7 @@ func SQLSelectTTxBtcUxtoCol(ctx context.Context, tx mcommon.DbExeAble, cols []st
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc_uxto
+	t_tx_erc20
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -9088,7 +16128,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTTxBtcUxto
+	var rows []*DBTTxErc20
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -9104,8 +16144,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTTxBtcUxtoColKV 根据ids获取
-func SQLSelectTTxBtcUxtoColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxBtcUxto, error) {
+// SQLSelectTTxErc20ColKV 根据ids获取
+func SQLSelectTTxErc20ColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxErc20, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -9116,7 +16156,7 @@ func SQLSelectTTxBtcUxtoColKV(ctx context.Context, tx mcommon.DbExeAble, cols []
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_btc_uxto
+	t_tx_erc20
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -9157,7 +16197,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTTxBtcUxto
+	var rows []*DBTTxErc20
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -9171,44 +16211,50 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTTxBtcUxto 更新
-func SQLUpdateTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxBtcUxto) (int64, error) {
+// SQLUpdateTTxErc20 更新
+func SQLUpdateTTxErc20(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc20) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_tx_btc_uxto
+	t_tx_erc20
 SET
-    uxto_type=:uxto_type,
+    chain=:chain,
+    token_id=:token_id,
+    product_id=:product_id,
+    block_number=:block_number,
     block_hash=:block_hash,
     tx_id=:tx_id,
-    vout_n=:vout_n,
-    vout_address=:vout_address,
-    vout_value=:vout_value,
-    vout_script=:vout_script,
+    from_address=:from_address,
+    to_address=:to_address,
+    balance_real=:balance_real,
     create_time=:create_time,
-    spend_tx_id=:spend_tx_id,
-    spend_n=:spend_n,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
-    handle_time=:handle_time
+    handle_time=:handle_time,
+    org_status=:org_status,
+    org_msg=:org_msg,
+    org_time=:org_time
 WHERE
 	id=:id`,
 		mcommon.H{
 			"id":            row.ID,
-			"uxto_type":     row.UxtoType,
+			"chain":         row.Chain,
+			"token_id":      row.TokenID,
+			"product_id":    row.ProductID,
+			"block_number":  row.BlockNumber,
 			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
-			"vout_n":        row.VoutN,
-			"vout_address":  row.VoutAddress,
-			"vout_value":    row.VoutValue,
-			"vout_script":   row.VoutScript,
+			"from_address":  row.FromAddress,
+			"to_address":    row.ToAddress,
+			"balance_real":  row.BalanceReal,
 			"create_time":   row.CreateTime,
-			"spend_tx_id":   row.SpendTxID,
-			"spend_n":       row.SpendN,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
 			"handle_time":   row.HandleTime,
+			"org_status":    row.OrgStatus,
+			"org_msg":       row.OrgMsg,
+			"org_time":      row.OrgTime,
 		},
 	)
 	if err != nil {
@@ -9217,14 +16263,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTTxBtcUxto 删除
-func SQLDeleteTTxBtcUxto(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTxErc20 删除
+func SQLDeleteTTxErc20(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_tx_btc_uxto
+	t_tx_erc20
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -9237,8 +16283,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTTxEos 创建
-func SQLCreateTTxEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos, isIgnore bool) (int64, error) {
+// SQLCreateTTxErc20OrgDiff 创建
+func SQLCreateTTxErc20OrgDiff(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc20OrgDiff, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -9246,56 +16292,44 @@ func SQLCreateTTxEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos, i
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_eos ( ")
+	query.WriteString("INTO t_tx_erc20_org_diff ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       product_id,
-       tx_hash,
-       log_index,
-       from_address,
-       to_address,
-       memo,
-       balance_real,
-       create_at,
-       handle_status,
-       handle_msg,
-       handle_at
+       token_id,
+       address,
+       tx_id,
+       credited_balance,
+       real_balance,
+       diff_balance,
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :product_id,
-    :tx_hash,
-    :log_index,
-    :from_address,
-    :to_address,
-    :memo,
-    :balance_real,
-    :create_at,
-    :handle_status,
-    :handle_msg,
-    :handle_at
+    :token_id,
+    :address,
+    :tx_id,
+    :credited_balance,
+    :real_balance,
+    :diff_balance,
+    :create_time
 )`)
 	lastID, err = mcommon.DbExecuteLastIDNamedContent(
 		ctx,
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":            row.ID,
-			"product_id":    row.ProductID,
-			"tx_hash":       row.TxHash,
-			"log_index":     row.LogIndex,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"memo":          row.Memo,
-			"balance_real":  row.BalanceReal,
-			"create_at":     row.CreateAt,
-			"handle_status": row.HandleStatus,
-			"handle_msg":    row.HandleMsg,
-			"handle_at":     row.HandleAt,
+			"id":               row.ID,
+			"token_id":         row.TokenID,
+			"address":          row.Address,
+			"tx_id":            row.TxID,
+			"credited_balance": row.CreditedBalance,
+			"real_balance":     row.RealBalance,
+			"diff_balance":     row.DiffBalance,
+			"create_time":      row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -9304,43 +16338,35 @@ func SQLCreateTTxEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos, i
 	return lastID, nil
 }
 
-// SQLCreateTTxEosDuplicate 创建更新
-func SQLCreateTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos, updates []string) (int64, error) {
+// SQLCreateTTxErc20OrgDiffDuplicate 创建更新
+func SQLCreateTTxErc20OrgDiffDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc20OrgDiff, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_eos ( ")
+	query.WriteString("INSERT INTO t_tx_erc20_org_diff ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       product_id,
-       tx_hash,
-       log_index,
-       from_address,
-       to_address,
-       memo,
-       balance_real,
-       create_at,
-       handle_status,
-       handle_msg,
-       handle_at
+       token_id,
+       address,
+       tx_id,
+       credited_balance,
+       real_balance,
+       diff_balance,
+       create_time
 ) VALUES (`)
 	if row.ID > 0 {
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :product_id,
-    :tx_hash,
-    :log_index,
-    :from_address,
-    :to_address,
-    :memo,
-    :balance_real,
-    :create_at,
-    :handle_status,
-    :handle_msg,
-    :handle_at
+    :token_id,
+    :address,
+    :tx_id,
+    :credited_balance,
+    :real_balance,
+    :diff_balance,
+    :create_time
 ) `)
 	updatesLen := len(updates)
 	lastUpdateIndex := updatesLen - 1
@@ -9363,18 +16389,14 @@ func SQLCreateTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DB
 		tx,
 		query.String(),
 		mcommon.H{
-			"id":            row.ID,
-			"product_id":    row.ProductID,
-			"tx_hash":       row.TxHash,
-			"log_index":     row.LogIndex,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"memo":          row.Memo,
-			"balance_real":  row.BalanceReal,
-			"create_at":     row.CreateAt,
-			"handle_status": row.HandleStatus,
-			"handle_msg":    row.HandleMsg,
-			"handle_at":     row.HandleAt,
+			"id":               row.ID,
+			"token_id":         row.TokenID,
+			"address":          row.Address,
+			"tx_id":            row.TxID,
+			"credited_balance": row.CreditedBalance,
+			"real_balance":     row.RealBalance,
+			"diff_balance":     row.DiffBalance,
+			"create_time":      row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -9383,8 +16405,8 @@ func SQLCreateTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DB
 	return lastID, nil
 }
 
-// SQLCreateManyTTxEos 创建多个
-func SQLCreateManyTTxEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxEos, isIgnore bool) (int64, error) {
+// SQLCreateManyTTxErc20OrgDiff 创建多个
+func SQLCreateManyTTxErc20OrgDiff(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxErc20OrgDiff, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -9395,36 +16417,28 @@ func SQLCreateManyTTxEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTT
 				args,
 				[]interface{}{
 					row.ID,
-					row.ProductID,
-					row.TxHash,
-					row.LogIndex,
-					row.FromAddress,
-					row.ToAddress,
-					row.Memo,
-					row.BalanceReal,
-					row.CreateAt,
-					row.HandleStatus,
-					row.HandleMsg,
-					row.HandleAt,
+					row.TokenID,
+					row.Address,
+					row.TxID,
+					row.CreditedBalance,
+					row.RealBalance,
+					row.DiffBalance,
+					row.CreateTime,
 				},
-			)
-		}
-	} else {
-		for _, row := range rows {
-			args = append(
-				args,
-				[]interface{}{
-					row.ProductID,
-					row.TxHash,
-					row.LogIndex,
-					row.FromAddress,
-					row.ToAddress,
-					row.Memo,
-					row.BalanceReal,
-					row.CreateAt,
-					row.HandleStatus,
-					row.HandleMsg,
-					row.HandleAt,
+			)
+		}
+	} else {
+		for _, row := range rows {
+			args = append(
+				args,
+				[]interface{}{
+					row.TokenID,
+					row.Address,
+					row.TxID,
+					row.CreditedBalance,
+					row.RealBalance,
+					row.DiffBalance,
+					row.CreateTime,
 				},
 			)
 		}
@@ -9436,22 +16450,18 @@ func SQLCreateManyTTxEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTT
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_eos ( ")
+	query.WriteString("INTO t_tx_erc20_org_diff ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    product_id,
-    tx_hash,
-    log_index,
-    from_address,
-    to_address,
-    memo,
-    balance_real,
-    create_at,
-    handle_status,
-    handle_msg,
-    handle_at
+    token_id,
+    address,
+    tx_id,
+    credited_balance,
+    real_balance,
+    diff_balance,
+    create_time
 ) VALUES
     %s`)
 	count, err = mcommon.DbExecuteCountManyContent(
@@ -9467,8 +16477,8 @@ func SQLCreateManyTTxEos(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTT
 	return count, nil
 }
 
-// SQLCreateManyTTxEosDuplicate 创建多个
-func SQLCreateManyTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxEos, updates []string) (int64, error) {
+// SQLCreateManyTTxErc20OrgDiffDuplicate 创建多个
+func SQLCreateManyTTxErc20OrgDiffDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxErc20OrgDiff, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -9479,17 +16489,13 @@ func SQLCreateManyTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 				args,
 				[]interface{}{
 					row.ID,
-					row.ProductID,
-					row.TxHash,
-					row.LogIndex,
-					row.FromAddress,
-					row.ToAddress,
-					row.Memo,
-					row.BalanceReal,
-					row.CreateAt,
-					row.HandleStatus,
-					row.HandleMsg,
-					row.HandleAt,
+					row.TokenID,
+					row.Address,
+					row.TxID,
+					row.CreditedBalance,
+					row.RealBalance,
+					row.DiffBalance,
+					row.CreateTime,
 				},
 			)
 		}
@@ -9498,17 +16504,13 @@ func SQLCreateManyTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 			args = append(
 				args,
 				[]interface{}{
-					row.ProductID,
-					row.TxHash,
-					row.LogIndex,
-					row.FromAddress,
-					row.ToAddress,
-					row.Memo,
-					row.BalanceReal,
-					row.CreateAt,
-					row.HandleStatus,
-					row.HandleMsg,
-					row.HandleAt,
+					row.TokenID,
+					row.Address,
+					row.TxID,
+					row.CreditedBalance,
+					row.RealBalance,
+					row.DiffBalance,
+					row.CreateTime,
 				},
 			)
 		}
@@ -9516,22 +16518,18 @@ func SQLCreateManyTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_eos ( ")
+	query.WriteString("INSERT INTO t_tx_erc20_org_diff ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    product_id,
-    tx_hash,
-    log_index,
-    from_address,
-    to_address,
-    memo,
-    balance_real,
-    create_at,
-    handle_status,
-    handle_msg,
-    handle_at
+    token_id,
+    address,
+    tx_id,
+    credited_balance,
+    real_balance,
+    diff_balance,
+    create_time
 ) VALUES
     %s`)
 	updatesLen := len(updates)
@@ -9563,18 +16561,18 @@ func SQLCreateManyTTxEosDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 	return count, nil
 }
 
-// SQLGetTTxEosCol 根据id查询
-func SQLGetTTxEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxEos, error) {
+// SQLGetTTxErc20OrgDiffCol 根据id查询
+func SQLGetTTxErc20OrgDiffCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxErc20OrgDiff, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_eos
+	t_tx_erc20_org_diff
 WHERE
 	id=:id`)
 
-	var row DBTTxEos
+	var row DBTTxErc20OrgDiff
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -9593,8 +16591,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTTxEosColKV 根据id查询
-func SQLGetTTxEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxEos, error) {
+// SQLGetTTxErc20OrgDiffColKV 根据id查询
+func SQLGetTTxErc20OrgDiffColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxErc20OrgDiff, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -9605,7 +16603,7 @@ func SQLGetTTxEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string,
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_eos
+	t_tx_erc20_org_diff
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -9635,7 +16633,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTTxEos
+	var row DBTTxErc20OrgDiff
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -9652,8 +16650,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTTxEosCol 根据ids获取
-func SQLSelectTTxEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxEos, error) {
+// SQLSelectTTxErc20OrgDiffCol 根据ids获取
+func SQLSelectTTxErc20OrgDiffCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxErc20OrgDiff, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -9662,7 +16660,7 @@ func SQLSelectTTxEosCol(ctx context.Context, tx mcommon.DbExeAble, cols []string
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_eos
+	t_tx_erc20_org_diff
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -9676,7 +16674,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTTxEos
+	var rows []*DBTTxErc20OrgDiff
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -9692,8 +16690,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTTxEosColKV 根据ids获取
-func SQLSelectTTxEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxEos, error) {
+// SQLSelectTTxErc20OrgDiffColKV 根据ids获取
+func SQLSelectTTxErc20OrgDiffColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxErc20OrgDiff, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -9704,7 +16702,7 @@ func SQLSelectTTxEosColKV(ctx context.Context, tx mcommon.DbExeAble, cols []stri
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_eos
+	t_tx_erc20_org_diff
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -9745,7 +16743,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTTxEos
+	var rows []*DBTTxErc20OrgDiff
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -9759,40 +16757,32 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTTxEos 更新
-func SQLUpdateTTxEos(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxEos) (int64, error) {
+// SQLUpdateTTxErc20OrgDiff 更新
+func SQLUpdateTTxErc20OrgDiff(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc20OrgDiff) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_tx_eos
+	t_tx_erc20_org_diff
 SET
-    product_id=:product_id,
-    tx_hash=:tx_hash,
-    log_index=:log_index,
-    from_address=:from_address,
-    to_address=:to_address,
-    memo=:memo,
-    balance_real=:balance_real,
-    create_at=:create_at,
-    handle_status=:handle_status,
-    handle_msg=:handle_msg,
-    handle_at=:handle_at
+    token_id=:token_id,
+    address=:address,
+    tx_id=:tx_id,
+    credited_balance=:credited_balance,
+    real_balance=:real_balance,
+    diff_balance=:diff_balance,
+    create_time=:create_time
 WHERE
 	id=:id`,
 		mcommon.H{
-			"id":            row.ID,
-			"product_id":    row.ProductID,
-			"tx_hash":       row.TxHash,
-			"log_index":     row.LogIndex,
-			"from_address":  row.FromAddress,
-			"to_address":    row.ToAddress,
-			"memo":          row.Memo,
-			"balance_real":  row.BalanceReal,
-			"create_at":     row.CreateAt,
-			"handle_status": row.HandleStatus,
-			"handle_msg":    row.HandleMsg,
-			"handle_at":     row.HandleAt,
+			"id":               row.ID,
+			"token_id":         row.TokenID,
+			"address":          row.Address,
+			"tx_id":            row.TxID,
+			"credited_balance": row.CreditedBalance,
+			"real_balance":     row.RealBalance,
+			"diff_balance":     row.DiffBalance,
+			"create_time":      row.CreateTime,
 		},
 	)
 	if err != nil {
@@ -9801,14 +16791,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTTxEos 删除
-func SQLDeleteTTxEos(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTxErc20OrgDiff 删除
+func SQLDeleteTTxErc20OrgDiff(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_tx_eos
+	t_tx_erc20_org_diff
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -9821,8 +16811,8 @@ WHERE
 	return count, nil
 }
 
-// SQLCreateTTxErc20 创建
-func SQLCreateTTxErc20(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc20, isIgnore bool) (int64, error) {
+// SQLCreateTTxNft 创建
+func SQLCreateTTxNft(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxNft, isIgnore bool) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
@@ -9830,17 +16820,22 @@ func SQLCreateTTxErc20(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc2
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_erc20 ( ")
+	query.WriteString("INTO t_tx_nft ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       token_id,
+       chain,
+       nft_id,
        product_id,
+       block_number,
+       block_hash,
        tx_id,
+       log_index,
        from_address,
        to_address,
-       balance_real,
+       token_id,
+       amount,
        create_time,
        handle_status,
        handle_msg,
@@ -9853,12 +16848,17 @@ func SQLCreateTTxErc20(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc2
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :token_id,
+    :chain,
+    :nft_id,
     :product_id,
+    :block_number,
+    :block_hash,
     :tx_id,
+    :log_index,
     :from_address,
     :to_address,
-    :balance_real,
+    :token_id,
+    :amount,
     :create_time,
     :handle_status,
     :handle_msg,
@@ -9873,12 +16873,17 @@ func SQLCreateTTxErc20(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc2
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"token_id":      row.TokenID,
+			"chain":         row.Chain,
+			"nft_id":        row.NftID,
 			"product_id":    row.ProductID,
+			"block_number":  row.BlockNumber,
+			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
+			"log_index":     row.LogIndex,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
+			"token_id":      row.TokenID,
+			"amount":        row.Amount,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
@@ -9894,22 +16899,27 @@ func SQLCreateTTxErc20(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc2
 	return lastID, nil
 }
 
-// SQLCreateTTxErc20Duplicate 创建更新
-func SQLCreateTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc20, updates []string) (int64, error) {
+// SQLCreateTTxNftDuplicate 创建更新
+func SQLCreateTTxNftDuplicate(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxNft, updates []string) (int64, error) {
 	var lastID int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_erc20 ( ")
+	query.WriteString("INSERT INTO t_tx_nft ( ")
 	if row.ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-       token_id,
+       chain,
+       nft_id,
        product_id,
+       block_number,
+       block_hash,
        tx_id,
+       log_index,
        from_address,
        to_address,
-       balance_real,
+       token_id,
+       amount,
        create_time,
        handle_status,
        handle_msg,
@@ -9922,12 +16932,17 @@ func SQLCreateTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 		query.WriteString("\n:id,")
 	}
 	query.WriteString(`
-    :token_id,
+    :chain,
+    :nft_id,
     :product_id,
+    :block_number,
+    :block_hash,
     :tx_id,
+    :log_index,
     :from_address,
     :to_address,
-    :balance_real,
+    :token_id,
+    :amount,
     :create_time,
     :handle_status,
     :handle_msg,
@@ -9958,12 +16973,17 @@ func SQLCreateTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 		query.String(),
 		mcommon.H{
 			"id":            row.ID,
-			"token_id":      row.TokenID,
+			"chain":         row.Chain,
+			"nft_id":        row.NftID,
 			"product_id":    row.ProductID,
+			"block_number":  row.BlockNumber,
+			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
+			"log_index":     row.LogIndex,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
+			"token_id":      row.TokenID,
+			"amount":        row.Amount,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
@@ -9979,8 +16999,8 @@ func SQLCreateTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, row *
 	return lastID, nil
 }
 
-// SQLCreateManyTTxErc20 创建多个
-func SQLCreateManyTTxErc20(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxErc20, isIgnore bool) (int64, error) {
+// SQLCreateManyTTxNft 创建多个
+func SQLCreateManyTTxNft(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxNft, isIgnore bool) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -9991,12 +17011,17 @@ func SQLCreateManyTTxErc20(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 				args,
 				[]interface{}{
 					row.ID,
-					row.TokenID,
+					row.Chain,
+					row.NftID,
 					row.ProductID,
+					row.BlockNumber,
+					row.BlockHash,
 					row.TxID,
+					row.LogIndex,
 					row.FromAddress,
 					row.ToAddress,
-					row.BalanceReal,
+					row.TokenID,
+					row.Amount,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
@@ -10012,12 +17037,17 @@ func SQLCreateManyTTxErc20(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 			args = append(
 				args,
 				[]interface{}{
-					row.TokenID,
+					row.Chain,
+					row.NftID,
 					row.ProductID,
+					row.BlockNumber,
+					row.BlockHash,
 					row.TxID,
+					row.LogIndex,
 					row.FromAddress,
 					row.ToAddress,
-					row.BalanceReal,
+					row.TokenID,
+					row.Amount,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
@@ -10036,17 +17066,22 @@ func SQLCreateManyTTxErc20(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	if isIgnore {
 		query.WriteString("IGNORE ")
 	}
-	query.WriteString("INTO t_tx_erc20 ( ")
+	query.WriteString("INTO t_tx_nft ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    token_id,
+    chain,
+    nft_id,
     product_id,
+    block_number,
+    block_hash,
     tx_id,
+    log_index,
     from_address,
     to_address,
-    balance_real,
+    token_id,
+    amount,
     create_time,
     handle_status,
     handle_msg,
@@ -10069,8 +17104,8 @@ func SQLCreateManyTTxErc20(ctx context.Context, tx mcommon.DbExeAble, rows []*DB
 	return count, nil
 }
 
-// SQLCreateManyTTxErc20Duplicate 创建多个
-func SQLCreateManyTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxErc20, updates []string) (int64, error) {
+// SQLCreateManyTTxNftDuplicate 创建多个
+func SQLCreateManyTTxNftDuplicate(ctx context.Context, tx mcommon.DbExeAble, rows []*DBTTxNft, updates []string) (int64, error) {
 	if len(rows) == 0 {
 		return 0, nil
 	}
@@ -10081,12 +17116,17 @@ func SQLCreateManyTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, r
 				args,
 				[]interface{}{
 					row.ID,
-					row.TokenID,
+					row.Chain,
+					row.NftID,
 					row.ProductID,
+					row.BlockNumber,
+					row.BlockHash,
 					row.TxID,
+					row.LogIndex,
 					row.FromAddress,
 					row.ToAddress,
-					row.BalanceReal,
+					row.TokenID,
+					row.Amount,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
@@ -10102,12 +17142,17 @@ func SQLCreateManyTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, r
 			args = append(
 				args,
 				[]interface{}{
-					row.TokenID,
+					row.Chain,
+					row.NftID,
 					row.ProductID,
+					row.BlockNumber,
+					row.BlockHash,
 					row.TxID,
+					row.LogIndex,
 					row.FromAddress,
 					row.ToAddress,
-					row.BalanceReal,
+					row.TokenID,
+					row.Amount,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
@@ -10122,17 +17167,22 @@ func SQLCreateManyTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	var count int64
 	var err error
 	query := strings.Builder{}
-	query.WriteString("INSERT INTO t_tx_erc20 ( ")
+	query.WriteString("INSERT INTO t_tx_nft ( ")
 	if rows[0].ID > 0 {
 		query.WriteString("\nid,")
 	}
 	query.WriteString(`
-    token_id,
+    chain,
+    nft_id,
     product_id,
+    block_number,
+    block_hash,
     tx_id,
+    log_index,
     from_address,
     to_address,
-    balance_real,
+    token_id,
+    amount,
     create_time,
     handle_status,
     handle_msg,
@@ -10171,18 +17221,18 @@ func SQLCreateManyTTxErc20Duplicate(ctx context.Context, tx mcommon.DbExeAble, r
 	return count, nil
 }
 
-// SQLGetTTxErc20Col 根据id查询
-func SQLGetTTxErc20Col(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxErc20, error) {
+// SQLGetTTxNftCol 根据id查询
+func SQLGetTTxNftCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, id int64) (*DBTTxNft, error) {
 	query := strings.Builder{}
 	query.WriteString("SELECT\n")
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_erc20
+	t_tx_nft
 WHERE
 	id=:id`)
 
-	var row DBTTxErc20
+	var row DBTTxNft
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -10201,8 +17251,8 @@ WHERE
 	return &row, nil
 }
 
-// SQLGetTTxErc20ColKV 根据id查询
-func SQLGetTTxErc20ColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxErc20, error) {
+// SQLGetTTxNftColKV 根据id查询
+func SQLGetTTxNftColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}) (*DBTTxNft, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -10213,7 +17263,7 @@ func SQLGetTTxErc20ColKV(ctx context.Context, tx mcommon.DbExeAble, cols []strin
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_erc20
+	t_tx_nft
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -10243,7 +17293,7 @@ FROM
 		argMap[key] = value
 	}
 
-	var row DBTTxErc20
+	var row DBTTxNft
 	ok, err := mcommon.DbGetNamedContent(
 		ctx,
 		tx,
@@ -10260,8 +17310,8 @@ FROM
 	return &row, nil
 }
 
-// SQLSelectTTxErc20Col 根据ids获取
-func SQLSelectTTxErc20Col(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxErc20, error) {
+// SQLSelectTTxNftCol 根据ids获取
+func SQLSelectTTxNftCol(ctx context.Context, tx mcommon.DbExeAble, cols []string, ids []int64, orderBys []string, limits []int64) ([]*DBTTxNft, error) {
 	if len(ids) == 0 {
 		return nil, nil
 	}
@@ -10270,7 +17320,7 @@ func SQLSelectTTxErc20Col(ctx context.Context, tx mcommon.DbExeAble, cols []stri
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_erc20
+	t_tx_nft
 WHERE
 	id IN (:ids)`)
 	if len(orderBys) > 0 {
@@ -10284,7 +17334,7 @@ WHERE
 	if len(limits) == 2 {
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
-	var rows []*DBTTxErc20
+	var rows []*DBTTxNft
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -10300,8 +17350,8 @@ WHERE
 	return rows, nil
 }
 
-// SQLSelectTTxErc20ColKV 根据ids获取
-func SQLSelectTTxErc20ColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxErc20, error) {
+// SQLSelectTTxNftColKV 根据ids获取
+func SQLSelectTTxNftColKV(ctx context.Context, tx mcommon.DbExeAble, cols []string, keys []string, values []interface{}, orderBys []string, limits []int64) ([]*DBTTxNft, error) {
 	keysLen := len(keys)
 	if keysLen != len(values) {
 		return nil, fmt.Errorf("value len error")
@@ -10312,7 +17362,7 @@ func SQLSelectTTxErc20ColKV(ctx context.Context, tx mcommon.DbExeAble, cols []st
 	query.WriteString(strings.Join(cols, ",\n"))
 	query.WriteString(`
 FROM
-	t_tx_erc20
+	t_tx_nft
 `)
 	if len(keys) > 0 {
 		query.WriteString("WHERE\n")
@@ -10353,7 +17403,7 @@ FROM
 		query.WriteString(fmt.Sprintf("LIMIT %d,%d", limits[0], limits[1]))
 	}
 
-	var rows []*DBTTxErc20
+	var rows []*DBTTxNft
 	err := mcommon.DbSelectNamedContent(
 		ctx,
 		tx,
@@ -10367,20 +17417,25 @@ FROM
 	return rows, nil
 }
 
-// SQLUpdateTTxErc20 更新
-func SQLUpdateTTxErc20(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxErc20) (int64, error) {
+// SQLUpdateTTxNft 更新
+func SQLUpdateTTxNft(ctx context.Context, tx mcommon.DbExeAble, row *DBTTxNft) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`UPDATE
-	t_tx_erc20
+	t_tx_nft
 SET
-    token_id=:token_id,
+    chain=:chain,
+    nft_id=:nft_id,
     product_id=:product_id,
+    block_number=:block_number,
+    block_hash=:block_hash,
     tx_id=:tx_id,
+    log_index=:log_index,
     from_address=:from_address,
     to_address=:to_address,
-    balance_real=:balance_real,
+    token_id=:token_id,
+    amount=:amount,
     create_time=:create_time,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
@@ -10392,12 +17447,17 @@ WHERE
 	id=:id`,
 		mcommon.H{
 			"id":            row.ID,
-			"token_id":      row.TokenID,
+			"chain":         row.Chain,
+			"nft_id":        row.NftID,
 			"product_id":    row.ProductID,
+			"block_number":  row.BlockNumber,
+			"block_hash":    row.BlockHash,
 			"tx_id":         row.TxID,
+			"log_index":     row.LogIndex,
 			"from_address":  row.FromAddress,
 			"to_address":    row.ToAddress,
-			"balance_real":  row.BalanceReal,
+			"token_id":      row.TokenID,
+			"amount":        row.Amount,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
@@ -10413,14 +17473,14 @@ WHERE
 	return count, nil
 }
 
-// SQLDeleteTTxErc20 删除
-func SQLDeleteTTxErc20(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
+// SQLDeleteTTxNft 删除
+func SQLDeleteTTxNft(ctx context.Context, tx mcommon.DbExeAble, id int64) (int64, error) {
 	count, err := mcommon.DbExecuteCountNamedContent(
 		ctx,
 		tx,
 		`DELETE
 FROM
-	t_tx_erc20
+	t_tx_nft
 WHERE
 	id=:id`,
 		mcommon.H{
@@ -10453,7 +17513,11 @@ func SQLCreateTWithdraw(ctx context.Context, tx mcommon.DbExeAble, row *DBTWithd
        memo,
        symbol,
        balance_real,
+       token_id,
        tx_hash,
+       fee,
+       block_number,
+       block_hash,
        create_time,
        handle_status,
        handle_msg,
@@ -10469,7 +17533,11 @@ func SQLCreateTWithdraw(ctx context.Context, tx mcommon.DbExeAble, row *DBTWithd
     :memo,
     :symbol,
     :balance_real,
+    :token_id,
     :tx_hash,
+    :fee,
+    :block_number,
+    :block_hash,
     :create_time,
     :handle_status,
     :handle_msg,
@@ -10487,7 +17555,11 @@ func SQLCreateTWithdraw(ctx context.Context, tx mcommon.DbExeAble, row *DBTWithd
 			"memo":          row.Memo,
 			"symbol":        row.Symbol,
 			"balance_real":  row.BalanceReal,
+			"token_id":      row.TokenID,
 			"tx_hash":       row.TxHash,
+			"fee":           row.Fee,
+			"block_number":  row.BlockNumber,
+			"block_hash":    row.BlockHash,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
@@ -10516,7 +17588,11 @@ func SQLCreateTWithdrawDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
        memo,
        symbol,
        balance_real,
+       token_id,
        tx_hash,
+       fee,
+       block_number,
+       block_hash,
        create_time,
        handle_status,
        handle_msg,
@@ -10532,7 +17608,11 @@ func SQLCreateTWithdrawDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
     :memo,
     :symbol,
     :balance_real,
+    :token_id,
     :tx_hash,
+    :fee,
+    :block_number,
+    :block_hash,
     :create_time,
     :handle_status,
     :handle_msg,
@@ -10566,7 +17646,11 @@ func SQLCreateTWithdrawDuplicate(ctx context.Context, tx mcommon.DbExeAble, row
 			"memo":          row.Memo,
 			"symbol":        row.Symbol,
 			"balance_real":  row.BalanceReal,
+			"token_id":      row.TokenID,
 			"tx_hash":       row.TxHash,
+			"fee":           row.Fee,
+			"block_number":  row.BlockNumber,
+			"block_hash":    row.BlockHash,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
@@ -10597,7 +17681,11 @@ func SQLCreateManyTWithdraw(ctx context.Context, tx mcommon.DbExeAble, rows []*D
 					row.Memo,
 					row.Symbol,
 					row.BalanceReal,
+					row.TokenID,
 					row.TxHash,
+					row.Fee,
+					row.BlockNumber,
+					row.BlockHash,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
@@ -10616,7 +17704,11 @@ func SQLCreateManyTWithdraw(ctx context.Context, tx mcommon.DbExeAble, rows []*D
 					row.Memo,
 					row.Symbol,
 					row.BalanceReal,
+					row.TokenID,
 					row.TxHash,
+					row.Fee,
+					row.BlockNumber,
+					row.BlockHash,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
@@ -10643,7 +17735,11 @@ func SQLCreateManyTWithdraw(ctx context.Context, tx mcommon.DbExeAble, rows []*D
     memo,
     symbol,
     balance_real,
+    token_id,
     tx_hash,
+    fee,
+    block_number,
+    block_hash,
     create_time,
     handle_status,
     handle_msg,
@@ -10681,7 +17777,11 @@ func SQLCreateManyTWithdrawDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 					row.Memo,
 					row.Symbol,
 					row.BalanceReal,
+					row.TokenID,
 					row.TxHash,
+					row.Fee,
+					row.BlockNumber,
+					row.BlockHash,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
@@ -10700,7 +17800,11 @@ func SQLCreateManyTWithdrawDuplicate(ctx context.Context, tx mcommon.DbExeAble,
 					row.Memo,
 					row.Symbol,
 					row.BalanceReal,
+					row.TokenID,
 					row.TxHash,
+					row.Fee,
+					row.BlockNumber,
+					row.BlockHash,
 					row.CreateTime,
 					row.HandleStatus,
 					row.HandleMsg,
@@ -10723,7 +17827,11 @@ func SQLCreateManyTWithdrawDuplicate(ctx context.Context, tx mcommon.DbExeAble,
     memo,
     symbol,
     balance_real,
+    token_id,
     tx_hash,
+    fee,
+    block_number,
+    block_hash,
     create_time,
     handle_status,
     handle_msg,
@@ -10969,7 +18077,11 @@ SET
     memo=:memo,
     symbol=:symbol,
     balance_real=:balance_real,
+    token_id=:token_id,
     tx_hash=:tx_hash,
+    fee=:fee,
+    block_number=:block_number,
+    block_hash=:block_hash,
     create_time=:create_time,
     handle_status=:handle_status,
     handle_msg=:handle_msg,
@@ -10984,7 +18096,11 @@ WHERE
 			"memo":          row.Memo,
 			"symbol":        row.Symbol,
 			"balance_real":  row.BalanceReal,
+			"token_id":      row.TokenID,
 			"tx_hash":       row.TxHash,
+			"fee":           row.Fee,
+			"block_number":  row.BlockNumber,
+			"block_hash":    row.BlockHash,
 			"create_time":   row.CreateTime,
 			"handle_status": row.HandleStatus,
 			"handle_msg":    row.HandleMsg,
@@ -1,8 +1,10 @@
 package omniclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"time"
 
 	"github.com/moremorefun/mcommon"
@@ -128,30 +130,49 @@ func InitClient(omniRPCHost, omniRPCUser, omniRPCPwd string) {
 	locOmniRPCPwd = omniRPCPwd
 }
 
-func doReq(method string, arqs []interface{}, resp interface{}) error {
-	_, body, errs := gorequest.New().SetBasicAuth(locOmniRPCUser, locOmniRPCPwd).Timeout(time.Minute * 5).Post(rpcURI).Send(StRPCReq{
+func doReq(ctx context.Context, method string, arqs []interface{}, resp interface{}) error {
+	agent := gorequest.New().SetBasicAuth(locOmniRPCUser, locOmniRPCPwd).Timeout(time.Minute * 5).Post(rpcURI).Send(StRPCReq{
 		Jsonrpc: "1.0",
 		ID:      mcommon.GetUUIDStr(),
 		Method:  method,
 		Params:  arqs,
-	}).EndBytes()
-	if errs != nil {
-		return errs[0]
+	})
+	body, err := doAgentReq(ctx, agent)
+	if err != nil {
+		return err
 	}
-	err := json.Unmarshal(body, resp)
+	err = json.Unmarshal(body, resp)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// doAgentReq 发送请求，ctx 取消时中断请求
+func doAgentReq(ctx context.Context, agent *gorequest.SuperAgent) ([]byte, error) {
+	if len(agent.Errors) > 0 {
+		return nil, agent.Errors[0]
+	}
+	req, err := agent.MakeRequest()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := agent.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return ioutil.ReadAll(resp.Body)
+}
+
 // RPCGetBlockCount 获取block number
-func RPCGetBlockCount() (int64, error) {
+func RPCGetBlockCount(ctx context.Context) (int64, error) {
 	resp := struct {
 		StRPCResp
 		Result int64 `json:"result"`
 	}{}
 	err := doReq(
+		ctx,
 		"getblockcount",
 		nil,
 		&resp,
@@ -166,12 +187,13 @@ func RPCGetBlockCount() (int64, error) {
 }
 
 // RPCGetBlockHash 获取block hash
-func RPCGetBlockHash(blockHeight int64) (string, error) {
+func RPCGetBlockHash(ctx context.Context, blockHeight int64) (string, error) {
 	resp := struct {
 		StRPCResp
 		Result string `json:"result"`
 	}{}
 	err := doReq(
+		ctx,
 		"getblockhash",
 		[]interface{}{blockHeight},
 		&resp,
@@ -186,12 +208,13 @@ func RPCGetBlockHash(blockHeight int64) (string, error) {
 }
 
 // RPCGetBlockVerbose 获取block 内容
-func RPCGetBlockVerbose(blockHash string) (*StBlockResult, error) {
+func RPCGetBlockVerbose(ctx context.Context, blockHash string) (*StBlockResult, error) {
 	resp := struct {
 		StRPCResp
 		Result *StBlockResult `json:"result"`
 	}{}
 	err := doReq(
+		ctx,
 		"getblock",
 		[]interface{}{blockHash, 2},
 		&resp,
@@ -206,12 +229,13 @@ func RPCGetBlockVerbose(blockHash string) (*StBlockResult, error) {
 }
 
 // RPCGetRawTransactionVerbose 获取tx
-func RPCGetRawTransactionVerbose(txHash string) (*StTxResult, error) {
+func RPCGetRawTransactionVerbose(ctx context.Context, txHash string) (*StTxResult, error) {
 	resp := struct {
 		StRPCResp
 		Result *StTxResult `json:"result"`
 	}{}
 	err := doReq(
+		ctx,
 		"getrawtransaction",
 		[]interface{}{txHash, 1},
 		&resp,
@@ -226,12 +250,13 @@ func RPCGetRawTransactionVerbose(txHash string) (*StTxResult, error) {
 }
 
 // RPCDecodeRawTransaction 解析tx
-func RPCDecodeRawTransaction(txHex string) (*StTxResult, error) {
+func RPCDecodeRawTransaction(ctx context.Context, txHex string) (*StTxResult, error) {
 	resp := struct {
 		StRPCResp
 		Result *StTxResult `json:"result"`
 	}{}
 	err := doReq(
+		ctx,
 		"decoderawtransaction",
 		[]interface{}{txHex},
 		&resp,
@@ -246,12 +271,13 @@ func RPCDecodeRawTransaction(txHex string) (*StTxResult, error) {
 }
 
 // RPCSendRawTransaction 发送tx
-func RPCSendRawTransaction(txHex string) (*string, error) {
+func RPCSendRawTransaction(ctx context.Context, txHex string) (*string, error) {
 	resp := struct {
 		StRPCResp
 		Result *string `json:"result"`
 	}{}
 	err := doReq(
+		ctx,
 		"sendrawtransaction",
 		[]interface{}{txHex},
 		&resp,
@@ -266,12 +292,13 @@ func RPCSendRawTransaction(txHex string) (*string, error) {
 }
 
 // RPCOmniListBlockTransactions 检测交易
-func RPCOmniListBlockTransactions(blockNumber int64) ([]string, error) {
+func RPCOmniListBlockTransactions(ctx context.Context, blockNumber int64) ([]string, error) {
 	resp := struct {
 		StRPCResp
 		Result []string `json:"result"`
 	}{}
 	err := doReq(
+		ctx,
 		"omni_listblocktransactions",
 		[]interface{}{blockNumber},
 		&resp,
@@ -286,12 +313,13 @@ func RPCOmniListBlockTransactions(blockNumber int64) ([]string, error) {
 }
 
 // RPCOmniGetTransaction 查询交易
-func RPCOmniGetTransaction(txHash string) (*StOmniTx, error) {
+func RPCOmniGetTransaction(ctx context.Context, txHash string) (*StOmniTx, error) {
 	resp := struct {
 		StRPCResp
 		Result *StOmniTx `json:"result"`
 	}{}
 	err := doReq(
+		ctx,
 		"omni_gettransaction",
 		[]interface{}{txHash},
 		&resp,
@@ -306,12 +334,13 @@ func RPCOmniGetTransaction(txHash string) (*StOmniTx, error) {
 }
 
 // RPCOmniGetBalance 查询交易
-func RPCOmniGetBalance(address string, tokenIndex int64) (*StOmniBalanceResult, error) {
+func RPCOmniGetBalance(ctx context.Context, address string, tokenIndex int64) (*StOmniBalanceResult, error) {
 	resp := struct {
 		StRPCResp
 		Result *StOmniBalanceResult `json:"result"`
 	}{}
 	err := doReq(
+		ctx,
 		"omni_getbalance",
 		[]interface{}{address, tokenIndex},
 		&resp,
@@ -39,4 +39,16 @@ const (
 
 	ErrorSymbolNotSupport    = -10
 	ErrorSymbolNotSupportMsg = "symbol not support"
+
+	ErrorNoPermission    = -11
+	ErrorNoPermissionMsg = "no permission"
+
+	ErrorNoNotify    = -12
+	ErrorNoNotifyMsg = "no notify"
+
+	ErrorTokenWrong    = -13
+	ErrorTokenWrongMsg = "token error"
+
+	ErrorTokenIDWrong    = -14
+	ErrorTokenIDWrongMsg = "token id error"
 )
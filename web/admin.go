@@ -0,0 +1,359 @@
+package web
+
+import (
+	"errors"
+	"go-dc-wallet/app"
+	"go-dc-wallet/ethclient"
+	"go-dc-wallet/heth"
+	"go-dc-wallet/hnotify"
+	"go-dc-wallet/model"
+	"go-dc-wallet/value"
+	"go-dc-wallet/xenv"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/moremorefun/mcommon"
+	"github.com/shopspring/decimal"
+)
+
+func postAdminNotifyList(c *gin.Context) {
+	var req struct {
+		ProductID    int64  `json:"product_id" binding:"omitempty"`
+		HandleStatus *int64 `json:"handle_status" binding:"omitempty"`
+		ItemType     int64  `json:"item_type" binding:"omitempty"`
+		ItemID       int64  `json:"item_id" binding:"omitempty"`
+		NotifyType   int64  `json:"notify_type" binding:"omitempty"`
+		StartTime    int64  `json:"start_time" binding:"omitempty"`
+		EndTime      int64  `json:"end_time" binding:"omitempty"`
+		Limit        int64  `json:"limit" binding:"omitempty"`
+	}
+	err := c.ShouldBindBodyWith(&req, binding.JSON)
+	if err != nil {
+		mcommon.Log.Warnf("req args error: %#v", err)
+		mcommon.GinFillBindError(c, err)
+		return
+	}
+	filter := &app.StNotifyFilter{
+		ProductID:    req.ProductID,
+		HandleStatus: -1,
+		ItemType:     req.ItemType,
+		ItemID:       req.ItemID,
+		NotifyType:   req.NotifyType,
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+		Limit:        req.Limit,
+	}
+	if req.HandleStatus != nil {
+		filter.HandleStatus = *req.HandleStatus
+	}
+	if filter.Limit <= 0 || filter.Limit > 500 {
+		filter.Limit = 50
+	}
+	notifyRows, err := hnotify.List(
+		c,
+		xenv.DbCon,
+		filter,
+	)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":   mcommon.ErrorSuccess,
+		"err_msg": mcommon.ErrorSuccessMsg,
+		"data":    notifyRows,
+	})
+}
+
+func postAdminNotifyDetail(c *gin.Context) {
+	var req struct {
+		ID int64 `json:"id" binding:"required"`
+	}
+	err := c.ShouldBindBodyWith(&req, binding.JSON)
+	if err != nil {
+		mcommon.Log.Warnf("req args error: %#v", err)
+		mcommon.GinFillBindError(c, err)
+		return
+	}
+	notifyRows, err := hnotify.List(
+		c,
+		xenv.DbCon,
+		&app.StNotifyFilter{
+			ID:           req.ID,
+			HandleStatus: -1,
+		},
+	)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	if len(notifyRows) == 0 {
+		mcommon.GinDoRespErr(
+			c,
+			value.ErrorNoNotify,
+			value.ErrorNoNotifyMsg,
+			nil,
+		)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":   mcommon.ErrorSuccess,
+		"err_msg": mcommon.ErrorSuccessMsg,
+		"data":    notifyRows[0],
+	})
+}
+
+func postAdminNotifyReplay(c *gin.Context) {
+	var req struct {
+		IDs  []int64 `json:"ids" binding:"required,min=1,max=500"`
+		Mode string  `json:"mode" binding:"required,oneof=original resign regenerate"`
+	}
+	err := c.ShouldBindBodyWith(&req, binding.JSON)
+	if err != nil {
+		mcommon.Log.Warnf("req args error: %#v", err)
+		mcommon.GinFillBindError(c, err)
+		return
+	}
+	count, err := hnotify.Replay(
+		c,
+		xenv.DbCon,
+		req.IDs,
+		req.Mode,
+	)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":   mcommon.ErrorSuccess,
+		"err_msg": mcommon.ErrorSuccessMsg,
+		"count":   count,
+	})
+}
+
+func postAdminLockList(c *gin.Context) {
+	lockRows, err := app.GetLockHolders(
+		c,
+		xenv.DbCon,
+	)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":   mcommon.ErrorSuccess,
+		"err_msg": mcommon.ErrorSuccessMsg,
+		"data":    lockRows,
+	})
+}
+
+func postAdminJobRunList(c *gin.Context) {
+	var req struct {
+		Name          string `json:"name" binding:"omitempty"`
+		StartTime     int64  `json:"start_time" binding:"omitempty"`
+		EndTime       int64  `json:"end_time" binding:"omitempty"`
+		IsHideSkipped bool   `json:"is_hide_skipped" binding:"omitempty"`
+		IsErrOnly     bool   `json:"is_err_only" binding:"omitempty"`
+		Limit         int64  `json:"limit" binding:"omitempty"`
+	}
+	err := c.ShouldBindBodyWith(&req, binding.JSON)
+	if err != nil {
+		mcommon.Log.Warnf("req args error: %#v", err)
+		mcommon.GinFillBindError(c, err)
+		return
+	}
+	filter := &app.StJobRunFilter{
+		Name:          req.Name,
+		StartTime:     req.StartTime,
+		EndTime:       req.EndTime,
+		IsHideSkipped: req.IsHideSkipped,
+		IsErrOnly:     req.IsErrOnly,
+		Limit:         req.Limit,
+	}
+	if filter.Limit <= 0 || filter.Limit > 500 {
+		filter.Limit = 50
+	}
+	runRows, err := app.SQLSelectTAppJobRunColByFilter(
+		c,
+		xenv.DbCon,
+		model.DBColTAppJobRunAll,
+		filter,
+	)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":   mcommon.ErrorSuccess,
+		"err_msg": mcommon.ErrorSuccessMsg,
+		"data":    runRows,
+	})
+}
+
+func postAdminTokenInfo(c *gin.Context) {
+	var req struct {
+		TokenAddress string `json:"token_address" binding:"required"`
+		Chain        string `json:"chain" binding:"omitempty"`
+	}
+	err := c.ShouldBindBodyWith(&req, binding.JSON)
+	if err != nil {
+		mcommon.Log.Warnf("req args error: %#v", err)
+		mcommon.GinFillBindError(c, err)
+		return
+	}
+	if !xenv.Cfg.EthEnable {
+		mcommon.GinDoRespErr(
+			c,
+			value.ErrorSymbolNotSupport,
+			value.ErrorSymbolNotSupportMsg,
+			nil,
+		)
+		return
+	}
+	if !heth.IsValidAddress(req.TokenAddress) {
+		mcommon.GinDoRespErr(
+			c,
+			value.ErrorAddressWrong,
+			value.ErrorAddressWrongMsg,
+			nil,
+		)
+		return
+	}
+	info, err := heth.GetTokenInfo(
+		ethclient.WithChain(c, req.Chain),
+		req.TokenAddress,
+	)
+	if err != nil {
+		if errors.Is(err, heth.ErrTokenNoCode) {
+			mcommon.GinDoRespErr(
+				c,
+				value.ErrorTokenWrong,
+				err.Error(),
+				nil,
+			)
+			return
+		}
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":   mcommon.ErrorSuccess,
+		"err_msg": mcommon.ErrorSuccessMsg,
+		"data":    tokenInfoResp(info),
+	})
+}
+
+func postAdminTokenAdd(c *gin.Context) {
+	var req struct {
+		TokenAddress   string `json:"token_address" binding:"required"`
+		TokenSymbol    string `json:"token_symbol" binding:"omitempty"`
+		ColdAddress    string `json:"cold_address" binding:"required"`
+		HotAddress     string `json:"hot_address" binding:"omitempty"`
+		OrgMinBalance  string `json:"org_min_balance" binding:"omitempty"`
+		OrgRealBalance int64  `json:"org_real_balance" binding:"omitempty,oneof=0 1"`
+		Chain          string `json:"chain" binding:"omitempty"`
+	}
+	err := c.ShouldBindBodyWith(&req, binding.JSON)
+	if err != nil {
+		mcommon.Log.Warnf("req args error: %#v", err)
+		mcommon.GinFillBindError(c, err)
+		return
+	}
+	if !xenv.Cfg.EthEnable {
+		mcommon.GinDoRespErr(
+			c,
+			value.ErrorSymbolNotSupport,
+			value.ErrorSymbolNotSupportMsg,
+			nil,
+		)
+		return
+	}
+	isAddressWrong := !heth.IsValidAddress(req.TokenAddress) || !heth.IsValidAddress(req.ColdAddress)
+	if req.HotAddress != "" {
+		_, err = heth.ParseHotAddresses(req.HotAddress)
+		if err != nil {
+			isAddressWrong = true
+		}
+	}
+	if isAddressWrong {
+		mcommon.GinDoRespErr(
+			c,
+			value.ErrorAddressWrong,
+			value.ErrorAddressWrongMsg,
+			nil,
+		)
+		return
+	}
+	if req.OrgMinBalance != "" {
+		_, err = decimal.NewFromString(req.OrgMinBalance)
+		if err != nil {
+			mcommon.GinDoRespErr(
+				c,
+				value.ErrorBalanceFormat,
+				value.ErrorBalanceFormatMsg,
+				nil,
+			)
+			return
+		}
+	}
+	tokenRow, info, err := heth.AddToken(
+		ethclient.WithChain(c, req.Chain),
+		&heth.StTokenAdd{
+			TokenAddress:   req.TokenAddress,
+			TokenSymbol:    req.TokenSymbol,
+			ColdAddress:    req.ColdAddress,
+			HotAddress:     req.HotAddress,
+			OrgMinBalance:  req.OrgMinBalance,
+			OrgRealBalance: req.OrgRealBalance,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, heth.ErrColdAddressEmpty) {
+			mcommon.GinDoRespErr(
+				c,
+				value.ErrorAddressWrong,
+				value.ErrorAddressWrongMsg,
+				nil,
+			)
+			return
+		}
+		if errors.Is(err, heth.ErrTokenNoCode) || errors.Is(err, heth.ErrTokenExist) {
+			mcommon.GinDoRespErr(
+				c,
+				value.ErrorTokenWrong,
+				err.Error(),
+				nil,
+			)
+			return
+		}
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"error":   mcommon.ErrorSuccess,
+		"err_msg": mcommon.ErrorSuccessMsg,
+		"data": gin.H{
+			"token": tokenRow,
+			"info":  tokenInfoResp(info),
+		},
+	})
+}
+
+// tokenInfoResp 链上token信息，发行量以字符串返回避免精度丢失
+func tokenInfoResp(info *ethclient.StTokenInfo) gin.H {
+	return gin.H{
+		"address":      info.Address,
+		"name":         info.Name,
+		"symbol":       info.Symbol,
+		"decimals":     info.Decimals,
+		"total_supply": info.TotalSupply.String(),
+	}
+}
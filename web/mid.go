@@ -1,6 +1,7 @@
 package web
 
 import (
+	"crypto/subtle"
 	"encoding/json"
 	"go-dc-wallet/model"
 	"go-dc-wallet/value"
@@ -146,3 +147,43 @@ func productReq(c *gin.Context) {
 	}
 	c.Set("product_id", productRow.ID)
 }
+
+func adminReq(c *gin.Context) {
+	// 未配置token时不开放管理接口
+	if xenv.Cfg.AdminToken == "" {
+		mcommon.GinDoRespErr(
+			c,
+			value.ErrorNoPermission,
+			value.ErrorNoPermissionMsg,
+			nil,
+		)
+		c.Abort()
+		return
+	}
+	// 对比ip白名单
+	if len(xenv.Cfg.AdminWhitelistIP) > 0 {
+		if !strings.Contains(xenv.Cfg.AdminWhitelistIP, c.ClientIP()) {
+			mcommon.Log.Warnf("admin no in ip list of: %s", c.ClientIP())
+			mcommon.GinDoRespErr(
+				c,
+				value.ErrorIPLimit,
+				value.ErrorIPLimitMsg,
+				nil,
+			)
+			c.Abort()
+			return
+		}
+	}
+	token := c.GetHeader("X-Admin-Token")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(xenv.Cfg.AdminToken)) != 1 {
+		mcommon.Log.Warnf("admin token error of: %s", c.ClientIP())
+		mcommon.GinDoRespErr(
+			c,
+			value.ErrorNoPermission,
+			value.ErrorNoPermissionMsg,
+			nil,
+		)
+		c.Abort()
+		return
+	}
+}
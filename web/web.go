@@ -10,6 +10,7 @@ import (
 	"go-dc-wallet/model"
 	"go-dc-wallet/value"
 	"go-dc-wallet/xenv"
+	"math/big"
 	"net/http"
 	"regexp"
 	"strings"
@@ -28,11 +29,19 @@ import (
 func Start(r *gin.Engine) {
 	r.POST("/api/address", productReq, postAddress)
 	r.POST("/api/withdraw", productReq, postWithdraw)
+
+	r.POST("/admin/notify/list", adminReq, postAdminNotifyList)
+	r.POST("/admin/notify/detail", adminReq, postAdminNotifyDetail)
+	r.POST("/admin/notify/replay", adminReq, postAdminNotifyReplay)
+	r.POST("/admin/lock/list", adminReq, postAdminLockList)
+	r.POST("/admin/job/run/list", adminReq, postAdminJobRunList)
+	r.POST("/admin/token/info", adminReq, postAdminTokenInfo)
+	r.POST("/admin/token/add", adminReq, postAdminTokenAdd)
 }
 
 func postAddress(c *gin.Context) {
 	var req struct {
-		Symbol string `json:"symbol" binding:"required" validate:"oneof=eth btc eos"`
+		Symbol string `json:"symbol" binding:"required"`
 	}
 	err := c.ShouldBindBodyWith(&req, binding.JSON)
 	if err != nil {
@@ -138,6 +147,7 @@ func postWithdraw(c *gin.Context) {
 		Address   string `json:"address" binding:"required"`
 		Balance   string `json:"balance" binding:"required"`
 		Memo      string `json:"memo" binding:"omitempty"`
+		TokenID   string `json:"token_id" binding:"omitempty"`
 	}
 	err := c.ShouldBindBodyWith(&req, binding.JSON)
 	if err != nil {
@@ -157,6 +167,14 @@ func postWithdraw(c *gin.Context) {
 	tokenDecimalsMap := make(map[string]int64)
 	ethSymbols := []string{heth.CoinSymbol}
 	tokenDecimalsMap[heth.CoinSymbol] = 18
+	// 其他evm链的原生币
+	for _, chain := range heth.GetChains() {
+		if chain.CoinSymbol == heth.CoinSymbol {
+			continue
+		}
+		ethSymbols = append(ethSymbols, chain.CoinSymbol)
+		tokenDecimalsMap[chain.CoinSymbol] = 18
+	}
 	// 获取所有eth代币币种
 	tokenRows, err := model.SQLSelectTAppConfigTokenColKV(
 		c,
@@ -181,6 +199,32 @@ func postWithdraw(c *gin.Context) {
 		ethSymbols = append(ethSymbols, tokenRow.TokenSymbol)
 		tokenDecimalsMap[tokenRow.TokenSymbol] = tokenRow.TokenDecimals
 	}
+	// 获取所有nft币种，数量没有小数
+	nftStandardMap := make(map[string]int64)
+	nftRows, err := model.SQLSelectTAppConfigNftColKV(
+		c,
+		xenv.DbCon,
+		[]string{
+			model.DBColTAppConfigNftTokenSymbol,
+			model.DBColTAppConfigNftTokenStandard,
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		mcommon.Log.Errorf("err: [%T] %s", err, err.Error())
+		mcommon.GinDoRespInternalErr(c)
+		return
+	}
+	for _, nftRow := range nftRows {
+		nftRow.TokenSymbol = strings.ToLower(nftRow.TokenSymbol)
+
+		ethSymbols = append(ethSymbols, nftRow.TokenSymbol)
+		tokenDecimalsMap[nftRow.TokenSymbol] = 0
+		nftStandardMap[nftRow.TokenSymbol] = nftRow.TokenStandard
+	}
 	// btc 信息
 	btcSymbols := []string{hbtc.CoinSymbol}
 	tokenDecimalsMap[hbtc.CoinSymbol] = 8
@@ -261,7 +305,33 @@ func postWithdraw(c *gin.Context) {
 			)
 			return
 		}
-
+		nftStandard, ok := nftStandardMap[req.Symbol]
+		if ok {
+			// 验证 token id
+			tokenID, ok := new(big.Int).SetString(req.TokenID, 10)
+			if !ok || tokenID.Sign() < 0 || tokenID.BitLen() > 256 {
+				mcommon.GinDoRespErr(
+					c,
+					value.ErrorTokenIDWrong,
+					value.ErrorTokenIDWrongMsg,
+					nil,
+				)
+				return
+			}
+			req.TokenID = tokenID.String()
+			if nftStandard == heth.NftStandard721 && !balanceObj.Equal(decimal.NewFromInt(1)) {
+				mcommon.GinDoRespErr(
+					c,
+					value.ErrorBalanceFormat,
+					value.ErrorBalanceFormatMsg,
+					nil,
+				)
+				return
+			}
+			req.Balance = balanceObj.String()
+		} else {
+			req.TokenID = ""
+		}
 	} else if mcommon.IsStringInSlice(btcSymbols, req.Symbol) {
 		// 验证地址
 		_, err := btcutil.DecodeAddress(
@@ -281,6 +351,7 @@ func postWithdraw(c *gin.Context) {
 		// eos
 		// 验证地址
 		_, err := eosclient.RPCChainGetAccount(
+			c,
 			req.Address,
 		)
 		if err != nil {
@@ -312,6 +383,7 @@ func postWithdraw(c *gin.Context) {
 			Memo:         req.Memo,
 			Symbol:       req.Symbol,
 			BalanceReal:  req.Balance,
+			TokenID:      req.TokenID,
 			TxHash:       "",
 			CreateTime:   now,
 			HandleStatus: 0,
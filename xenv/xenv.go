@@ -30,7 +30,8 @@ type config struct {
 
 	BtcNetworkType string `env:"BTC-NETWORK-TYPE" default:"btc"`
 
-	EthRPC string `env:"ETH_RPC"`
+	EthRPC   string `env:"ETH_RPC"`
+	EthWsRPC string `env:"ETH_WS_RPC"`
 
 	OmniRPCHost string `env:"OMNI_RPC_HOST"`
 	OmniRPCUser string `env:"OMNI_RPC_USER"`
@@ -40,6 +41,9 @@ type config struct {
 	EthEnable bool   `env:"ETH_ENABLE"`
 	EosEnable bool   `env:"EOS_ENABLE"`
 	BtcEnable bool   `env:"BTC_ENABLE"`
+
+	AdminToken       string `env:"ADMIN_TOKEN"`
+	AdminWhitelistIP string `env:"ADMIN_WHITELIST_IP"`
 }
 
 // Cfg ..